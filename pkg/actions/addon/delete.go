@@ -52,11 +52,9 @@ func (a *Manager) Delete(ctx context.Context, addon *api.Addon) error {
 		logger.Info("deleted addon: %s", addon.Name)
 	}
 
-	stack, err := a.stackManager.DescribeStack(ctx, &manager.Stack{StackName: aws.String(a.makeAddonName(addon.Name))})
+	stack, _, err := a.stackManager.DescribeStackIfExists(ctx, &manager.Stack{StackName: aws.String(a.makeAddonName(addon.Name))})
 	if err != nil {
-		if !manager.IsStackDoesNotExistError(err) {
-			return fmt.Errorf("failed to get stack: %w", err)
-		}
+		return fmt.Errorf("failed to get stack: %w", err)
 	}
 	if stack != nil {
 		logger.Info("deleting associated IAM stacks")
@@ -9,10 +9,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	awseks "github.com/aws/aws-sdk-go/service/eks"
-	"github.com/aws/smithy-go"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	"github.com/pkg/errors"
 
 	"github.com/weaveworks/eksctl/pkg/actions/addon"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -48,7 +46,7 @@ var _ = Describe("Delete", func() {
 				ClusterName: aws.String("my-cluster"),
 			}).Return(&awseks.DeleteAddonOutput{}, nil)
 
-			fakeStackManager.DescribeStackReturns(&types.Stack{StackName: aws.String("eksctl-my-cluster-addon-my-addon")}, nil)
+			fakeStackManager.DescribeStackIfExistsReturns(&types.Stack{StackName: aws.String("eksctl-my-cluster-addon-my-addon")}, true, nil)
 
 			err := manager.Delete(context.TODO(), &api.Addon{
 				Name: "my-addon",
@@ -82,7 +80,7 @@ var _ = Describe("Delete", func() {
 					ClusterName: aws.String("my-cluster"),
 				}).Return(&awseks.DeleteAddonOutput{}, nil)
 
-				fakeStackManager.DescribeStackReturns(nil, fmt.Errorf("foo"))
+				fakeStackManager.DescribeStackIfExistsReturns(nil, false, fmt.Errorf("foo"))
 
 				err := manager.Delete(context.TODO(), &api.Addon{
 					Name: "my-addon",
@@ -100,9 +98,9 @@ var _ = Describe("Delete", func() {
 				}).Return(&awseks.DeleteAddonOutput{}, nil)
 
 				fakeStackManager.DeleteStackBySpecReturns(nil, fmt.Errorf("foo"))
-				fakeStackManager.DescribeStackReturns(&types.Stack{
+				fakeStackManager.DescribeStackIfExistsReturns(&types.Stack{
 					StackName: aws.String("eksctl-my-cluster-addon-my-addon"),
-				}, nil)
+				}, true, nil)
 
 				err := manager.Delete(context.TODO(), &api.Addon{
 					Name: "my-addon",
@@ -122,9 +120,7 @@ var _ = Describe("Delete", func() {
 					ClusterName: aws.String("my-cluster"),
 				}).Return(&awseks.DeleteAddonOutput{}, nil)
 
-				fakeStackManager.DescribeStackReturns(nil, errors.Wrap(&smithy.OperationError{
-					Err: fmt.Errorf("ValidationError"),
-				}, "nope"))
+				fakeStackManager.DescribeStackIfExistsReturns(nil, false, nil)
 
 				err := manager.Delete(context.TODO(), &api.Addon{
 					Name: "my-addon",
@@ -143,7 +139,7 @@ var _ = Describe("Delete", func() {
 					ClusterName: aws.String("my-cluster"),
 				}).Return(&awseks.DeleteAddonOutput{}, awserr.New(awseks.ErrCodeResourceNotFoundException, "", nil))
 
-				fakeStackManager.DescribeStackReturns(&types.Stack{StackName: aws.String("eksctl-my-cluster-addon-my-addon")}, nil)
+				fakeStackManager.DescribeStackIfExistsReturns(&types.Stack{StackName: aws.String("eksctl-my-cluster-addon-my-addon")}, true, nil)
 
 				err := manager.Delete(context.TODO(), &api.Addon{
 					Name: "my-addon",
@@ -164,9 +160,7 @@ var _ = Describe("Delete", func() {
 					ClusterName: aws.String("my-cluster"),
 				}).Return(&awseks.DeleteAddonOutput{}, awserr.New(awseks.ErrCodeResourceNotFoundException, "", nil))
 
-				fakeStackManager.DescribeStackReturns(nil, errors.Wrap(&smithy.OperationError{
-					Err: fmt.Errorf("ValidationError"),
-				}, "nope"))
+				fakeStackManager.DescribeStackIfExistsReturns(nil, false, nil)
 				err := manager.Delete(context.TODO(), &api.Addon{
 					Name: "my-addon",
 				})
@@ -86,11 +86,9 @@ func (a *Manager) Update(ctx context.Context, addon *api.Addon, wait bool) error
 
 func (a *Manager) updateWithNewPolicies(ctx context.Context, addon *api.Addon) (string, error) {
 	stackName := a.makeAddonName(addon.Name)
-	stack, err := a.stackManager.DescribeStack(ctx, &manager.Stack{StackName: aws.String(stackName)})
+	stack, _, err := a.stackManager.DescribeStackIfExists(ctx, &manager.Stack{StackName: aws.String(stackName)})
 	if err != nil {
-		if manager.IsStackDoesNotExistError(err) {
-			return "", fmt.Errorf("failed to get stack: %w", err)
-		}
+		return "", fmt.Errorf("failed to get stack: %w", err)
 	}
 
 	namespace, serviceAccount := a.getKnownServiceAccountLocation(addon)
@@ -264,7 +264,7 @@ var _ = Describe("Update", func() {
 			When("attachPolicyARNs is configured", func() {
 				When("its an update to an existing cloudformation", func() {
 					It("updates the stack", func() {
-						fakeStackManager.DescribeStackReturns(&manager.Stack{
+						stack := &manager.Stack{
 							StackName: aws.String("eksctl-my-cluster-addon-vpc-cni"),
 							Outputs: []types.Output{
 								{
@@ -272,7 +272,9 @@ var _ = Describe("Update", func() {
 									OutputKey:   aws.String(outputs.IAMServiceAccountRoleName),
 								},
 							},
-						}, nil)
+						}
+						fakeStackManager.DescribeStackIfExistsReturns(stack, true, nil)
+						fakeStackManager.DescribeStackReturns(stack, nil)
 
 						err := addonManager.Update(context.TODO(), &api.Addon{
 							Name:             "vpc-cni",
@@ -331,7 +333,7 @@ var _ = Describe("Update", func() {
 			When("attachPolicy is configured", func() {
 				When("its an update to an existing cloudformation", func() {
 					It("updates the stack", func() {
-						fakeStackManager.DescribeStackReturns(&manager.Stack{
+						stack := &manager.Stack{
 							StackName: aws.String("eksctl-my-cluster-addon-vpc-cni"),
 							Outputs: []types.Output{
 								{
@@ -339,7 +341,9 @@ var _ = Describe("Update", func() {
 									OutputKey:   aws.String(outputs.IAMServiceAccountRoleName),
 								},
 							},
-						}, nil)
+						}
+						fakeStackManager.DescribeStackIfExistsReturns(stack, true, nil)
+						fakeStackManager.DescribeStackReturns(stack, nil)
 
 						err := addonManager.Update(context.TODO(), &api.Addon{
 							Name:    "vpc-cni",
@@ -401,7 +405,7 @@ var _ = Describe("Update", func() {
 			When("wellKnownPolicies are configured", func() {
 				When("its an update to an existing cloudformation", func() {
 					It("updates the stack", func() {
-						fakeStackManager.DescribeStackReturns(&manager.Stack{
+						stack := &manager.Stack{
 							StackName: aws.String("eksctl-my-cluster-addon-vpc-cni"),
 							Outputs: []types.Output{
 								{
@@ -409,7 +413,9 @@ var _ = Describe("Update", func() {
 									OutputKey:   aws.String(outputs.IAMServiceAccountRoleName),
 								},
 							},
-						}, nil)
+						}
+						fakeStackManager.DescribeStackIfExistsReturns(stack, true, nil)
+						fakeStackManager.DescribeStackReturns(stack, nil)
 
 						err := addonManager.Update(context.TODO(), &api.Addon{
 							Name:    "vpc-cni",
@@ -73,7 +73,7 @@ var _ = Describe("Labels", func() {
 			BeforeEach(func() {
 				returnedLabels = map[string]*string{"k1": aws.String("v1")}
 				err := &smithy.OperationError{
-					Err: errors.New("ValidationError"),
+					Err: &smithy.GenericAPIError{Code: "ValidationError", Message: "Stack with id eksctl-foo-nodegroup-bar does not exist"},
 				}
 				fakeManagedService.GetLabelsReturns(nil, perrors.Wrapf(err, "omg %s", "what"))
 			})
@@ -135,7 +135,7 @@ var _ = Describe("Labels", func() {
 			BeforeEach(func() {
 				eksLabels = map[string]*string{"k1": aws.String("v1")}
 				err := &smithy.OperationError{
-					Err: errors.New("ValidationError"),
+					Err: &smithy.GenericAPIError{Code: "ValidationError", Message: "Stack with id eksctl-foo-nodegroup-bar does not exist"},
 				}
 				fakeManagedService.UpdateLabelsReturns(perrors.Wrapf(err, "omg %s", "what"))
 			})
@@ -197,7 +197,7 @@ var _ = Describe("Labels", func() {
 			BeforeEach(func() {
 				eksLabels = []*string{aws.String("k1")}
 				err := &smithy.OperationError{
-					Err: errors.New("ValidationError"),
+					Err: &smithy.GenericAPIError{Code: "ValidationError", Message: "Stack with id eksctl-foo-nodegroup-bar does not exist"},
 				}
 				fakeManagedService.UpdateLabelsReturns(perrors.Wrapf(err, "omg %s", "what"))
 			})
@@ -49,11 +49,9 @@ func (h *irsaHelper) IsSupported(ctx context.Context) (bool, error) {
 func (h *irsaHelper) CreateOrUpdate(ctx context.Context, sa *api.ClusterIAMServiceAccount) error {
 	serviceAccounts := []*api.ClusterIAMServiceAccount{sa}
 	name := makeIAMServiceAccountStackName(h.clusterName, sa.Namespace, sa.Name)
-	stack, err := h.stackManager.DescribeStack(ctx, &manager.Stack{StackName: &name})
+	stack, _, err := h.stackManager.DescribeStackIfExists(ctx, &manager.Stack{StackName: &name})
 	if err != nil {
-		if !manager.IsStackDoesNotExistError(err) {
-			return errors.Wrapf(err, "error checking if iamserviceaccount %s/%s exists", sa.Namespace, sa.Name)
-		}
+		return errors.Wrapf(err, "error checking if iamserviceaccount %s/%s exists", sa.Namespace, sa.Name)
 	}
 	if stack == nil {
 		err = h.irsaManager.CreateIAMServiceAccount(serviceAccounts, false)
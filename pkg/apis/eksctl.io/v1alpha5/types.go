@@ -208,6 +208,9 @@ const (
 	// EksctlVersionTag defines the version of eksctl which is used to provision or update EKS cluster
 	EksctlVersionTag = "alpha.eksctl.io/eksctl-version"
 
+	// DeploymentIDTag defines the deployment id, e.g. a git commit, which provisioned or last updated a stack
+	DeploymentIDTag = "alpha.eksctl.io/deployment-id"
+
 	// ClusterNameTag defines the tag of the cluster name
 	ClusterNameTag = "alpha.eksctl.io/cluster-name"
 
@@ -667,6 +670,7 @@ type ClusterProvider interface {
 	STS() awsapi.STS
 	STSPresigner() STSPresigner
 	EC2() awsapi.EC2
+	S3() awsapi.S3
 }
 
 // STSPresigner defines the method to pre-sign GetCallerIdentity requests to add a proper header required by EKS for
@@ -1318,6 +1322,10 @@ type NodeGroupBase struct {
 	// Applied to the EKS Nodegroup resource and to the EC2 instances (managed)
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+	// Applied to the CloudFormation stack of the nodegroup instead of `tags`, which
+	// still apply to the nodegroup's resources. When unset, `tags` are applied to the stack
+	// +optional
+	StackTags map[string]string `json:"stackTags,omitempty"`
 	// +optional
 	IAM *NodeGroupIAM `json:"iam,omitempty"`
 
@@ -1085,6 +1085,13 @@ func (in *NodeGroupBase) DeepCopyInto(out *NodeGroupBase) {
 			(*out)[key] = val
 		}
 	}
+	if in.StackTags != nil {
+		in, out := &in.StackTags, &out.StackTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.IAM != nil {
 		in, out := &in.IAM, &out.IAM
 		*out = new(NodeGroupIAM)
@@ -9,3 +9,4 @@ package generate
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh elasticloadbalancingv2 ELBV2
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh ssm SSM
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh iam IAM
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh s3 S3
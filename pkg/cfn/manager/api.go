@@ -2,7 +2,9 @@ package manager
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
 	"time"
@@ -17,10 +19,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
 	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
@@ -31,6 +35,14 @@ const (
 	mappingsRootPath  = "Mappings"
 	ourStackRegexFmt  = "^(eksctl|EKS)-%s-((cluster|nodegroup-.+|addon-.+|fargate|karpenter)|(VPC|ServiceRole|ControlPlane|DefaultNodeGroup))$"
 	clusterStackRegex = "eksctl-.*-cluster"
+	cniPolicyName     = "AmazonEKS_CNI_Policy"
+
+	// defaultDescribeConcurrency is the default number of concurrent describe requests
+	defaultDescribeConcurrency = 10
+	// defaultDeleteConcurrency is the default number of stacks deleted concurrently
+	defaultDeleteConcurrency = 5
+	// defaultUpdateConcurrency is the default number of stacks updated concurrently
+	defaultUpdateConcurrency = 5
 )
 
 var (
@@ -41,6 +53,9 @@ var (
 // Stack represents the CloudFormation stack
 type Stack = types.Stack
 
+// StackEvent is an event of a CloudFormation stack
+type StackEvent = types.StackEvent
+
 // StackInfo hold the stack along with template and resources
 type StackInfo struct {
 	Stack     *Stack
@@ -80,12 +95,48 @@ type StackCollection struct {
 	region          string
 	waitTimeout     time.Duration
 	sharedTags      []types.Tag
+
+	clusterStackCache clusterStackCache
+
+	// describeConcurrency is the maximum number of describe requests issued concurrently
+	describeConcurrency int
+	// nodeGroupResourcesPollInterval and nodeGroupResourcesWaitTimeout control polling for the resources of a
+	// managed nodegroup, which EKS may report only some time after the nodegroup was created
+	nodeGroupResourcesPollInterval time.Duration
+	nodeGroupResourcesWaitTimeout  time.Duration
+	// deleteWaitInterval overrides the interval at which stacks are polled while waiting for their deletion
+	deleteWaitInterval time.Duration
+	// stackSetOperationPollInterval is the interval at which stack set operations are polled until they complete
+	stackSetOperationPollInterval time.Duration
+	// autoScalingGroupPollInterval is the interval at which autoscaling groups are polled while waiting for their instances
+	autoScalingGroupPollInterval time.Duration
+	// nodeGroupStacksPollInterval is the interval at which nodegroup stacks are polled by WaitForNodeGroupStacks
+	nodeGroupStacksPollInterval time.Duration
+	// deploymentID is recorded in the deployment id tag of the stacks that are created or updated
+	deploymentID string
+	// stackNamer names the stacks of the cluster
+	stackNamer StackNamer
+	// s3API and stsAPI are used to upload templates too large to be passed inline to S3
+	s3API         awsapi.S3
+	stsAPI        awsapi.STS
+	templateStore templateStore
 }
 
 func newTag(key, value string) types.Tag {
 	return types.Tag{Key: &key, Value: &value}
 }
 
+// withTag returns a copy of tags in which the tag with the given key is set to value
+func withTag(tags []types.Tag, key, value string) []types.Tag {
+	result := make([]types.Tag, 0, len(tags)+1)
+	for _, t := range tags {
+		if aws.StringValue(t.Key) != key {
+			result = append(result, t)
+		}
+	}
+	return append(result, newTag(key, value))
+}
+
 // NewStackCollection creates a stack manager for a single cluster
 func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) StackManager {
 	tags := []types.Tag{
@@ -105,23 +156,52 @@ func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) S
 		iamAPI:            provider.IAM(),
 		cloudTrailAPI:     provider.CloudTrail(),
 		asgAPI:            provider.ASG(),
+		stsAPI:            provider.STS(),
+		s3API:             provider.S3(),
 		disableRollback:   provider.CloudFormationDisableRollback(),
 		roleARN:           provider.CloudFormationRoleARN(),
 		region:            provider.Region(),
 		waitTimeout:       provider.WaitTimeout(),
+
+		describeConcurrency:            defaultDescribeConcurrency,
+		nodeGroupResourcesPollInterval: 5 * time.Second,
+		nodeGroupResourcesWaitTimeout:  30 * time.Second,
+		stackSetOperationPollInterval:  15 * time.Second,
+		autoScalingGroupPollInterval:   15 * time.Second,
+		nodeGroupStacksPollInterval:    30 * time.Second,
+		stackNamer:                     DefaultStackNamer{},
 	}
 }
 
 // DoCreateStackRequest requests the creation of a CloudFormation stack
 func (c *StackCollection) DoCreateStackRequest(ctx context.Context, i *Stack, templateData TemplateData, tags, parameters map[string]string, withIAM bool, withNamedIAM bool) error {
+	return c.doCreateStackRequest(ctx, i, templateData, tags, parameters, makeStackCapabilities(withIAM, withNamedIAM, nil))
+}
+
+func (c *StackCollection) doCreateStackRequest(ctx context.Context, i *Stack, templateData TemplateData, tags, parameters map[string]string, capabilities []types.Capability) error {
+	templateData, deleteTemplate, err := c.resolveTemplateData(ctx, *i.StackName, templateData)
+	if err != nil {
+		return err
+	}
+	defer deleteTemplate()
+	return c.createStackFromTemplateData(ctx, i, templateData, tags, parameters, capabilities)
+}
+
+// createStackFromTemplateData requests the creation of a CloudFormation stack from template data
+// that was already resolved by resolveTemplateData
+func (c *StackCollection) createStackFromTemplateData(ctx context.Context, i *Stack, templateData TemplateData, tags, parameters map[string]string, capabilities []types.Capability) error {
 	input := &cloudformation.CreateStackInput{
 		StackName:       i.StackName,
 		DisableRollback: aws.Bool(c.disableRollback),
+		Capabilities:    capabilities,
 	}
 	input.Tags = append(input.Tags, c.sharedTags...)
 	for k, v := range tags {
 		input.Tags = append(input.Tags, newTag(k, v))
 	}
+	if _, ok := tags[api.DeploymentIDTag]; !ok && c.deploymentID != "" {
+		input.Tags = append(input.Tags, newTag(api.DeploymentIDTag, c.deploymentID))
+	}
 
 	switch data := templateData.(type) {
 	case TemplateBody:
@@ -132,14 +212,6 @@ func (c *StackCollection) DoCreateStackRequest(ctx context.Context, i *Stack, te
 		return fmt.Errorf("unknown template data type: %T", templateData)
 	}
 
-	if withIAM {
-		input.Capabilities = stackCapabilitiesIAM
-	}
-
-	if withNamedIAM {
-		input.Capabilities = stackCapabilitiesNamedIAM
-	}
-
 	if cfnRole := c.roleARN; cfnRole != "" {
 		input.RoleARN = aws.String(cfnRole)
 	}
@@ -160,29 +232,333 @@ func (c *StackCollection) DoCreateStackRequest(ctx context.Context, i *Stack, te
 	return nil
 }
 
+// makeStackCapabilities returns the IAM capabilities required by a stack along with any additional ones
+func makeStackCapabilities(withIAM, withNamedIAM bool, additional []types.Capability) []types.Capability {
+	var capabilities []types.Capability
+	switch {
+	case withNamedIAM:
+		capabilities = append(capabilities, stackCapabilitiesNamedIAM...)
+	case withIAM:
+		capabilities = append(capabilities, stackCapabilitiesIAM...)
+	}
+	for _, a := range additional {
+		if !containsCapability(capabilities, a) {
+			capabilities = append(capabilities, a)
+		}
+	}
+	return capabilities
+}
+
+// parseStackCapabilities validates the given capabilities against the ones known to CloudFormation
+func parseStackCapabilities(capabilities []string) ([]types.Capability, error) {
+	var parsed []types.Capability
+	for _, c := range capabilities {
+		capability := types.Capability(c)
+		if !containsCapability(capability.Values(), capability) {
+			return nil, fmt.Errorf("unknown CloudFormation capability %q, expected one of %v", c, capability.Values())
+		}
+		parsed = append(parsed, capability)
+	}
+	return parsed, nil
+}
+
+func containsCapability(capabilities []types.Capability, capability types.Capability) bool {
+	for _, c := range capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
+
+// iamResourceNameProperties maps the types of IAM resources that require CAPABILITY_IAM to the property setting
+// their name, which requires CAPABILITY_NAMED_IAM instead
+var iamResourceNameProperties = map[string]string{
+	"AWS::IAM::AccessKey":           "",
+	"AWS::IAM::Group":               "GroupName",
+	"AWS::IAM::InstanceProfile":     "InstanceProfileName",
+	"AWS::IAM::ManagedPolicy":       "ManagedPolicyName",
+	"AWS::IAM::Policy":              "",
+	"AWS::IAM::Role":                "RoleName",
+	"AWS::IAM::User":                "UserName",
+	"AWS::IAM::UserToGroupAddition": "",
+}
+
+// requiredStackCapabilities returns the capabilities CloudFormation requires to deploy the given JSON template:
+// CAPABILITY_IAM or CAPABILITY_NAMED_IAM for IAM resources, depending on whether they are given a custom name,
+// and CAPABILITY_AUTO_EXPAND for templates using transforms
+func requiredStackCapabilities(template string) []types.Capability {
+	var withIAM, withNamedIAM, withTransform bool
+	gjson.Get(template, "Resources").ForEach(func(_, resource gjson.Result) bool {
+		nameProperty, isIAM := iamResourceNameProperties[resource.Get("Type").String()]
+		if isIAM {
+			withIAM = true
+			if nameProperty != "" && resource.Get("Properties."+nameProperty).Exists() {
+				withNamedIAM = true
+			}
+		}
+		return true
+	})
+	if gjson.Get(template, "Transform").Exists() || strings.Contains(template, `"Fn::Transform"`) {
+		withTransform = true
+	}
+
+	var capabilities []types.Capability
+	if withTransform {
+		capabilities = append(capabilities, types.CapabilityCapabilityAutoExpand)
+	}
+	return makeStackCapabilities(withIAM, withNamedIAM, capabilities)
+}
+
+// checkStackCapabilities returns an error naming the capabilities the template requires that are not among the
+// granted ones; templates that aren't passed inline as JSON are not checked
+func checkStackCapabilities(stackName string, templateData TemplateData, granted []types.Capability) error {
+	body, ok := templateData.(TemplateBody)
+	if !ok || !gjson.ValidBytes(body) {
+		return nil
+	}
+	var missing []string
+	for _, capability := range requiredStackCapabilities(string(body)) {
+		if containsCapability(granted, capability) {
+			continue
+		}
+		if capability == types.CapabilityCapabilityIam && containsCapability(granted, types.CapabilityCapabilityNamedIam) {
+			continue
+		}
+		missing = append(missing, string(capability))
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("the template of stack %q requires capabilities %s, which are not granted to the stack", stackName, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// ValidationResult holds the outcome of the validation of a template
+type ValidationResult struct {
+	// Parameters holds the parameters declared in the template
+	Parameters []types.TemplateParameter
+	// Capabilities holds the capabilities required to create a stack from the template
+	Capabilities []types.Capability
+	// CapabilitiesReason explains why the capabilities are required
+	CapabilitiesReason string
+}
+
+// ValidateTemplate validates the given template with CloudFormation, returning the parameters it declares and the
+// capabilities it requires; an invalid template is reported as an error
+func (c *StackCollection) ValidateTemplate(ctx context.Context, templateData TemplateData) (*ValidationResult, error) {
+	input := &cloudformation.ValidateTemplateInput{}
+	switch data := templateData.(type) {
+	case TemplateBody:
+		input.TemplateBody = aws.String(string(data))
+	case TemplateURL:
+		input.TemplateURL = aws.String(string(data))
+	default:
+		return nil, fmt.Errorf("unknown template data type: %T", templateData)
+	}
+
+	out, err := c.cloudformationAPI.ValidateTemplate(ctx, input)
+	if err != nil {
+		return nil, errors.Wrap(err, "validating template")
+	}
+	return &ValidationResult{
+		Parameters:         out.Parameters,
+		Capabilities:       out.Capabilities,
+		CapabilitiesReason: aws.StringValue(out.CapabilitiesReason),
+	}, nil
+}
+
+// validateStackTemplate validates the given template of the resource set, and ensures the capabilities it requires
+// are granted when creating its stack
+func (c *StackCollection) validateStackTemplate(ctx context.Context, stackName string, resourceSet builder.ResourceSetReader, templateData TemplateData) error {
+	result, err := c.ValidateTemplate(ctx, templateData)
+	if err != nil {
+		return errors.Wrapf(err, "template of stack %q is invalid", stackName)
+	}
+	granted := makeStackCapabilities(resourceSet.WithIAM(), resourceSet.WithNamedIAM(), nil)
+	for _, capability := range result.Capabilities {
+		if !containsCapability(granted, capability) {
+			return fmt.Errorf("template of stack %q requires capability %s (%s)", stackName, capability, result.CapabilitiesReason)
+		}
+	}
+	return nil
+}
+
+// SetDeploymentID sets the deployment id, e.g. a git commit, recorded in the deployment id tag
+// of the stacks that are subsequently created or updated
+func (c *StackCollection) SetDeploymentID(id string) {
+	c.deploymentID = id
+}
+
+// SetDescribeConcurrency sets the maximum number of describe requests issued concurrently when describing the
+// stacks and resources of nodegroups, which defaults to 10
+func (c *StackCollection) SetDescribeConcurrency(n int) error {
+	if n < 1 {
+		return fmt.Errorf("describe concurrency must be at least 1, got %d", n)
+	}
+	c.describeConcurrency = n
+	return nil
+}
+
+// GetDeploymentID returns the deployment id recorded in the tags of the stack, if any
+func GetDeploymentID(s *Stack) (string, bool) {
+	for _, tag := range s.Tags {
+		if aws.StringValue(tag.Key) == api.DeploymentIDTag {
+			return aws.StringValue(tag.Value), true
+		}
+	}
+	return "", false
+}
+
 // CreateStack with given name, stack builder instance and parameters;
 // any errors will be written to errs channel, when nil is written,
 // assume completion, do not expect more then one error value on the
 // channel, it's closed immediately after it is written to
 func (c *StackCollection) CreateStack(ctx context.Context, stackName string, resourceSet builder.ResourceSetReader, tags, parameters map[string]string, errs chan error) error {
-	stack, err := c.createStackRequest(ctx, stackName, resourceSet, tags, parameters)
+	stack, err := c.createStackRequest(ctx, stackName, resourceSet, tags, parameters, nil, false)
+	if err != nil {
+		return err
+	}
+
+	go c.waitUntilStackIsCreated(ctx, stack, resourceSet, nil, errs)
+	return nil
+}
+
+// createValidatedStack works like CreateStack, but validates the template with CloudFormation before creating
+// the stack, ensuring the capabilities it requires are granted
+func (c *StackCollection) createValidatedStack(ctx context.Context, stackName string, resourceSet builder.ResourceSetReader, tags, parameters map[string]string, errs chan error) error {
+	stack, err := c.createStackRequest(ctx, stackName, resourceSet, tags, parameters, nil, true)
+	if err != nil {
+		return err
+	}
+
+	go c.waitUntilStackIsCreated(ctx, stack, resourceSet, nil, errs)
+	return nil
+}
+
+// CreateStackNoWait works like CreateStack, but returns the id of the stack as soon as its creation is requested
+// instead of waiting for it to be created; the stack can be polled with DescribeStacks, and the stacks of nodegroups
+// can be waited for by passing their ids to WaitForNodeGroupStacks
+func (c *StackCollection) CreateStackNoWait(ctx context.Context, stackName string, resourceSet builder.ResourceSetReader, tags, parameters map[string]string) (string, error) {
+	stack, err := c.createStackRequest(ctx, stackName, resourceSet, tags, parameters, nil, false)
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(stack.StackId), nil
+}
+
+// CreateStackWithEventHandler works like CreateStack, and additionally passes each new event of the stack
+// to eventHandler while waiting for it to be created, oldest first; when eventHandler is nil, it behaves
+// exactly like CreateStack
+func (c *StackCollection) CreateStackWithEventHandler(ctx context.Context, stackName string, resourceSet builder.ResourceSetReader, tags, parameters map[string]string, eventHandler func(StackEvent), errs chan error) error {
+	start := time.Now()
+	stack, err := c.createStackRequest(ctx, stackName, resourceSet, tags, parameters, nil, false)
+	if err != nil {
+		return err
+	}
+
+	var streamer *stackEventStreamer
+	if eventHandler != nil {
+		streamer = newStackEventStreamer(stack, start, eventHandler)
+	}
+	go c.waitUntilStackIsCreated(ctx, stack, resourceSet, streamer, errs)
+	return nil
+}
+
+// CreateStackIfNotExists works like CreateStack, unless a stack with the given name already exists: when its
+// template and tags match the desired ones, nil is written to errs without recreating it, otherwise an error
+// is returned, as the stack must be updated instead
+func (c *StackCollection) CreateStackIfNotExists(ctx context.Context, stackName string, resourceSet builder.ResourceSetReader, tags, parameters map[string]string, errs chan error) error {
+	existing, found, err := c.DescribeStackIfExists(ctx, &Stack{StackName: &stackName})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return c.CreateStack(ctx, stackName, resourceSet, tags, parameters, errs)
+	}
+
+	matches, err := c.stackMatches(ctx, existing, resourceSet, tags)
+	if err != nil {
+		return err
+	}
+	if !matches {
+		return fmt.Errorf("stack %q already exists with a different template or tags; update it instead", stackName)
+	}
+	logger.Info("stack %q already exists and is up-to-date", stackName)
+	go func() {
+		defer close(errs)
+		errs <- nil
+	}()
+	return nil
+}
+
+// stackMatches reports whether the existing stack has the template and tags that would be used to create it;
+// the eksctl version tag is ignored
+func (c *StackCollection) stackMatches(ctx context.Context, existing *Stack, resourceSet builder.ResourceSetReader, tags map[string]string) (bool, error) {
+	desiredTags := make(map[string]string)
+	for _, t := range c.sharedTags {
+		desiredTags[*t.Key] = *t.Value
+	}
+	for k, v := range tags {
+		desiredTags[k] = v
+	}
+	existingTags := make(map[string]string)
+	for _, t := range existing.Tags {
+		existingTags[*t.Key] = *t.Value
+	}
+	delete(desiredTags, api.EksctlVersionTag)
+	delete(existingTags, api.EksctlVersionTag)
+	if !reflect.DeepEqual(desiredTags, existingTags) {
+		return false, nil
+	}
+
+	templateBody, err := resourceSet.RenderJSON()
+	if err != nil {
+		return false, errors.Wrapf(err, "rendering template for %q stack", *existing.StackName)
+	}
+	desiredTemplate, err := ensureJSONResponse(templateBody)
+	if err != nil {
+		return false, err
+	}
+	existingTemplate, err := c.GetStackTemplate(ctx, *existing.StackName)
+	if err != nil {
+		return false, errors.Wrapf(err, "getting template of stack %q", *existing.StackName)
+	}
+	var desired, current interface{}
+	if err := json.Unmarshal([]byte(desiredTemplate), &desired); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal([]byte(existingTemplate), &current); err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(desired, current), nil
+}
+
+// CreateStackWithCapabilities works like CreateStack, additionally granting the given capabilities
+// (e.g. CAPABILITY_AUTO_EXPAND) on top of the IAM capabilities required by the resource set
+func (c *StackCollection) CreateStackWithCapabilities(ctx context.Context, stackName string, resourceSet builder.ResourceSetReader, tags, parameters map[string]string, capabilities []string, errs chan error) error {
+	additional, err := parseStackCapabilities(capabilities)
+	if err != nil {
+		return err
+	}
+	stack, err := c.createStackRequest(ctx, stackName, resourceSet, tags, parameters, additional, false)
 	if err != nil {
 		return err
 	}
 
-	go c.waitUntilStackIsCreated(ctx, stack, resourceSet, errs)
+	go c.waitUntilStackIsCreated(ctx, stack, resourceSet, nil, errs)
 	return nil
 }
 
 // createClusterStack creates the cluster stack
 func (c *StackCollection) createClusterStack(ctx context.Context, stackName string, resourceSet builder.ResourceSetReader, errCh chan error) error {
 	// Unlike with `createNodeGroupTask`, all tags are already set for the cluster stack
-	stack, err := c.createStackRequest(ctx, stackName, resourceSet, nil, nil)
+	stack, err := c.createStackRequest(ctx, stackName, resourceSet, nil, nil, nil, false)
 	if err != nil {
 		return err
 	}
 	go func() {
 		defer close(errCh)
+		defer c.RefreshClusterStackCache()
 		troubleshoot := func() {
 			stack, err := c.DescribeStack(ctx, stack)
 			if err != nil {
@@ -223,14 +599,25 @@ func (c *StackCollection) createClusterStack(ctx context.Context, stackName stri
 	return nil
 }
 
-func (c *StackCollection) createStackRequest(ctx context.Context, stackName string, resourceSet builder.ResourceSetReader, tags, parameters map[string]string) (*Stack, error) {
+func (c *StackCollection) createStackRequest(ctx context.Context, stackName string, resourceSet builder.ResourceSetReader, tags, parameters map[string]string, capabilities []types.Capability, validate bool) (*Stack, error) {
 	stack := &Stack{StackName: &stackName}
 	templateBody, err := resourceSet.RenderJSON()
 	if err != nil {
 		return nil, errors.Wrapf(err, "rendering template for %q stack", *stack.StackName)
 	}
+	templateData, deleteTemplate, err := c.resolveTemplateData(ctx, stackName, TemplateBody(templateBody))
+	if err != nil {
+		return nil, err
+	}
+	defer deleteTemplate()
 
-	if err := c.DoCreateStackRequest(ctx, stack, TemplateBody(templateBody), tags, parameters, resourceSet.WithIAM(), resourceSet.WithNamedIAM()); err != nil {
+	if validate {
+		if err := c.validateStackTemplate(ctx, stackName, resourceSet, templateData); err != nil {
+			return nil, err
+		}
+	}
+	capabilities = makeStackCapabilities(resourceSet.WithIAM(), resourceSet.WithNamedIAM(), capabilities)
+	if err := c.createStackFromTemplateData(ctx, stack, templateData, tags, parameters, capabilities); err != nil {
 		return nil, err
 	}
 
@@ -240,48 +627,111 @@ func (c *StackCollection) createStackRequest(ctx context.Context, stackName stri
 
 // UpdateStack will update a CloudFormation stack by creating and executing a ChangeSet
 func (c *StackCollection) UpdateStack(ctx context.Context, options UpdateStackOptions) error {
+	_, err := c.UpdateStackWithChanges(ctx, options)
+	return err
+}
+
+// ChangeSummary summarises the changes of a ChangeSet
+type ChangeSummary struct {
+	// Changes holds the changes of the ChangeSet
+	Changes []types.Change
+	// ActionCounts holds the number of resources changed by each action
+	ActionCounts map[types.ChangeAction]int
+	// Replacements holds the logical ids of resources that will or may be replaced
+	Replacements []string
+}
+
+func newChangeSummary(changeSet *ChangeSet) *ChangeSummary {
+	summary := &ChangeSummary{
+		Changes:      changeSet.Changes,
+		ActionCounts: make(map[types.ChangeAction]int),
+	}
+	for _, change := range changeSet.Changes {
+		rc := change.ResourceChange
+		if rc == nil {
+			continue
+		}
+		summary.ActionCounts[rc.Action]++
+		switch rc.Replacement {
+		case types.ReplacementTrue, types.ReplacementConditional:
+			summary.Replacements = append(summary.Replacements, aws.StringValue(rc.LogicalResourceId))
+		}
+	}
+	return summary
+}
+
+// UpdateStackWithChanges will update a CloudFormation stack by creating and executing a ChangeSet,
+// and returns a summary of the changes; the summary is empty when there was nothing to update
+func (c *StackCollection) UpdateStackWithChanges(ctx context.Context, options UpdateStackOptions) (*ChangeSummary, error) {
 	logger.Info(options.Description)
 	if options.Stack == nil {
 		i := &Stack{StackName: &options.StackName}
 		// Read existing tags
 		s, err := c.DescribeStack(ctx, i)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		options.Stack = s
 	} else {
 		options.StackName = *options.Stack.StackName
 	}
+	tags := options.Stack.Tags
+	deploymentID := options.DeploymentID
+	if deploymentID == "" {
+		deploymentID = c.deploymentID
+	}
+	if deploymentID != "" {
+		tags = withTag(tags, api.DeploymentIDTag, deploymentID)
+	}
+	if err := checkStackCapabilities(options.StackName, options.TemplateData, options.Stack.Capabilities); err != nil {
+		return nil, err
+	}
 	if err := c.doCreateChangeSetRequest(ctx,
 		options.StackName,
 		options.ChangeSetName,
 		options.Description,
 		options.TemplateData,
 		options.Parameters,
+		options.PreserveParameters,
 		options.Stack.Capabilities,
-		options.Stack.Tags,
+		tags,
+		options.RollbackConfiguration,
 	); err != nil {
-		return err
+		return nil, err
 	}
-	if err := c.doWaitUntilChangeSetIsCreated(ctx, options.Stack, options.ChangeSetName); err != nil {
+	if err := c.doWaitUntilChangeSetIsCreated(ctx, options.Stack, options.ChangeSetName, options.WaitInterval, options.WaitTimeout); err != nil {
 		if _, ok := err.(*noChangeError); ok {
-			return nil
+			return newChangeSummary(&ChangeSet{}), nil
 		}
-		return err
+		return nil, err
 	}
 	changeSet, err := c.DescribeStackChangeSet(ctx, options.Stack, options.ChangeSetName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	logger.Debug("changes = %#v", changeSet.Changes)
+	summary := newChangeSummary(changeSet)
+	if options.DryRun {
+		logChangeSet(changeSet)
+		return summary, c.doDeleteChangeSet(ctx, options.StackName, options.ChangeSetName)
+	}
+	var streamer *stackEventStreamer
+	if options.StreamEvents {
+		streamer = newStackEventStreamer(options.Stack, time.Now(), logStackEvent)
+	}
 	if err := c.doExecuteChangeSet(ctx, options.StackName, options.ChangeSetName); err != nil {
 		logger.Warning("error executing Cloudformation changeSet %s in stack %s. Check the Cloudformation console for further details", options.ChangeSetName, options.StackName)
-		return err
+		return nil, err
+	}
+	if options.StackName == c.MakeClusterStackName() {
+		defer c.RefreshClusterStackCache()
 	}
 	if options.Wait {
-		return c.doWaitUntilStackIsUpdated(ctx, options.Stack)
+		if err := c.doWaitUntilStackIsUpdated(ctx, options.Stack, streamer, options.WaitInterval, options.WaitTimeout); err != nil {
+			return nil, err
+		}
 	}
-	return nil
+	return summary, nil
 }
 
 // DescribeStack describes a cloudformation stack.
@@ -302,10 +752,81 @@ func (c *StackCollection) DescribeStack(ctx context.Context, i *Stack) (*Stack,
 	return &resp.Stacks[0], nil
 }
 
+// DescribeStackIfExists describes a cloudformation stack, and reports whether it exists;
+// (nil, false, nil) is returned when the stack does not exist
+func (c *StackCollection) DescribeStackIfExists(ctx context.Context, i *Stack) (*Stack, bool, error) {
+	s, err := c.DescribeStack(ctx, i)
+	if err != nil {
+		if isStackNotFoundError(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return s, true, nil
+}
+
+// GetStackParameters returns the current values of the parameters of the given stack, keyed by parameter name
+func (c *StackCollection) GetStackParameters(ctx context.Context, stackName string) (map[string]string, error) {
+	s, err := c.DescribeStack(ctx, &Stack{StackName: &stackName})
+	if err != nil {
+		return nil, err
+	}
+	parameters := make(map[string]string, len(s.Parameters))
+	for _, p := range s.Parameters {
+		parameters[aws.StringValue(p.ParameterKey)] = aws.StringValue(p.ParameterValue)
+	}
+	return parameters, nil
+}
+
+// GetStackPhysicalResourceID returns the physical id of the resource with the given logical id in the given stack;
+// ErrStackResourceNotFound is returned when the stack has no such resource
+func (c *StackCollection) GetStackPhysicalResourceID(ctx context.Context, stackName, logicalID string) (string, error) {
+	res, err := c.cloudformationAPI.DescribeStackResource(ctx, &cloudformation.DescribeStackResourceInput{
+		StackName:         aws.String(stackName),
+		LogicalResourceId: aws.String(logicalID),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "ValidationError" && strings.HasPrefix(apiErr.ErrorMessage(), "Resource "+logicalID+" does not exist") {
+			return "", errors.Wrapf(ErrStackResourceNotFound, "resource %q of stack %q", logicalID, stackName)
+		}
+		return "", errors.Wrapf(err, "describing resource %q of stack %q", logicalID, stackName)
+	}
+	return aws.StringValue(res.StackResourceDetail.PhysicalResourceId), nil
+}
+
+// SetTerminationProtection enables or disables termination protection of the given stack
+func (c *StackCollection) SetTerminationProtection(ctx context.Context, stackName string, enabled bool) error {
+	_, err := c.cloudformationAPI.UpdateTerminationProtection(ctx, &cloudformation.UpdateTerminationProtectionInput{
+		StackName:                   aws.String(stackName),
+		EnableTerminationProtection: aws.Bool(enabled),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "updating termination protection of stack %q", stackName)
+	}
+	return nil
+}
+
+// IsTerminationProtected reports whether termination protection is enabled for the given stack
+func (c *StackCollection) IsTerminationProtected(ctx context.Context, stackName string) (bool, error) {
+	s, err := c.DescribeStack(ctx, &Stack{StackName: aws.String(stackName)})
+	if err != nil {
+		return false, err
+	}
+	return aws.BoolValue(s.EnableTerminationProtection), nil
+}
+
+// IsStackDoesNotExistError reports whether err, or any error it wraps, is the error returned by CloudFormation
+// for a missing stack; other validation errors are not matched
 func IsStackDoesNotExistError(err error) bool {
-	awsError, ok := errors.Unwrap(errors.Unwrap(err)).(*smithy.OperationError)
-	return ok && strings.Contains(awsError.Error(), "ValidationError")
+	return isStackNotFoundError(err)
+}
 
+// isStackNotFoundError reports whether err is the ValidationError returned by CloudFormation for a missing stack,
+// as opposed to other validation errors
+func isStackNotFoundError(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "ValidationError" && strings.Contains(apiErr.ErrorMessage(), "does not exist")
 }
 
 // GetManagedNodeGroupTemplate returns the template for a ManagedNodeGroup resource
@@ -385,7 +906,7 @@ func (c *StackCollection) ListStacksMatching(ctx context.Context, nameRegex stri
 // ListClusterStackNames gets all stack names matching regex
 func (c *StackCollection) ListClusterStackNames(ctx context.Context) ([]string, error) {
 	var stacks []string
-	re, err := regexp.Compile(clusterStackRegex)
+	re, err := regexp.Compile(c.stackNamer.ClusterStacksRegex())
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot list stacks")
 	}
@@ -413,7 +934,22 @@ func (c *StackCollection) ListClusterStackNames(ctx context.Context) ([]string,
 
 // ListStacks gets all of CloudFormation stacks
 func (c *StackCollection) ListStacks(ctx context.Context, statusFilters ...types.StackStatus) ([]*Stack, error) {
-	return c.ListStacksMatching(ctx, fmtStacksRegexForCluster(c.spec.Metadata.Name), statusFilters...)
+	return c.ListStacksMatching(ctx, c.stackNamer.StacksRegex(c.spec.Metadata.Name), statusFilters...)
+}
+
+// ListInProgressStacks gets all CloudFormation stacks of the cluster with an operation in progress
+func (c *StackCollection) ListInProgressStacks(ctx context.Context) ([]*Stack, error) {
+	return c.ListStacks(ctx, inProgressStackStatuses()...)
+}
+
+func inProgressStackStatuses() []types.StackStatus {
+	var statuses []types.StackStatus
+	for _, status := range allNonDeletedStackStatuses() {
+		if strings.HasSuffix(string(status), "_IN_PROGRESS") {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
 }
 
 // StackStatusIsNotTransitional will return true when stack status is non-transitional
@@ -506,6 +1042,9 @@ func (c *StackCollection) DeleteStackBySpec(ctx context.Context, s *Stack) (*Sta
 	if _, err := c.cloudformationAPI.DeleteStack(ctx, input); err != nil {
 		return nil, errors.Wrapf(err, "not able to delete stack %q", *s.StackName)
 	}
+	if c.isClusterStack(s) {
+		c.RefreshClusterStackCache()
+	}
 	logger.Info("will delete stack %q", *s.StackName)
 	return s, nil
 }
@@ -549,10 +1088,6 @@ func (c *StackCollection) DeleteStackSync(ctx context.Context, s *Stack) error {
 	return c.doWaitUntilStackIsDeleted(ctx, s)
 }
 
-func fmtStacksRegexForCluster(name string) string {
-	return fmt.Sprintf(ourStackRegexFmt, name)
-}
-
 // DescribeStacks describes the existing stacks
 func (c *StackCollection) DescribeStacks(ctx context.Context) ([]*Stack, error) {
 	stacks, err := c.ListStacks(ctx)
@@ -565,12 +1100,60 @@ func (c *StackCollection) DescribeStacks(ctx context.Context) ([]*Stack, error)
 	return stacks, nil
 }
 
+// GetClusterStackIfExists returns the cluster stack, or nil when there is none. The stack following the eksctl
+// naming convention is looked up first; when there is none, the stack is looked up by its cluster name tag with
+// FindClusterStackByTag, so that the stacks of clusters imported into eksctl are recognized too.
 func (c *StackCollection) GetClusterStackIfExists(ctx context.Context) (*Stack, error) {
 	clusterStackNames, err := c.ListClusterStackNames(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return c.getClusterStackFromList(ctx, clusterStackNames, c.spec.Metadata.Name)
+	stack, err := c.getClusterStackFromList(ctx, clusterStackNames, c.spec.Metadata.Name)
+	if err != nil || stack != nil {
+		return stack, err
+	}
+	return c.FindClusterStackByTag(ctx)
+}
+
+// clusterTemplateDescriptionPrefix is the prefix of the description of the cluster stack templates generated by eksctl
+const clusterTemplateDescriptionPrefix = "EKS cluster"
+
+// FindClusterStackByTag returns the stack tagged with the cluster name that has the cluster stack's
+// ClusterStackName output, or nil when there is none. Stacks that don't follow the eksctl naming convention,
+// e.g. of clusters imported into eksctl, are found this way. Only the live stacks whose template description is
+// that of a cluster stack are described, so as not to describe every stack in the region.
+func (c *StackCollection) FindClusterStackByTag(ctx context.Context) (*Stack, error) {
+	var candidates []string
+	paginator := cloudformation.NewListStacksPaginator(c.cloudformationAPI, &cloudformation.ListStacksInput{
+		StackStatusFilter: defaultStackStatusFilter(),
+	})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "listing CloudFormation stacks")
+		}
+		for _, s := range out.StackSummaries {
+			if strings.HasPrefix(aws.StringValue(s.TemplateDescription), clusterTemplateDescriptionPrefix) {
+				candidates = append(candidates, aws.StringValue(s.StackName))
+			}
+		}
+	}
+
+	for _, name := range candidates {
+		s, err := c.DescribeStack(ctx, &Stack{StackName: aws.String(name)})
+		if err != nil {
+			return nil, err
+		}
+		if !matchesCluster(c.spec.Metadata.Name, s.Tags) {
+			continue
+		}
+		for _, o := range s.Outputs {
+			if aws.StringValue(o.OutputKey) == outputs.ClusterStackName {
+				return s, nil
+			}
+		}
+	}
+	return nil, nil
 }
 
 func (c *StackCollection) HasClusterStackFromList(ctx context.Context, clusterStackNames []string, clusterName string) (bool, error) {
@@ -638,16 +1221,25 @@ func (c *StackCollection) LookupCloudTrailEvents(ctx context.Context, i *Stack)
 }
 
 func (c *StackCollection) doCreateChangeSetRequest(ctx context.Context, stackName, changeSetName, description string, templateData TemplateData,
-	parameters map[string]string, capabilities []types.Capability, tags []types.Tag) error {
+	parameters map[string]string, preserveParameters []string, capabilities []types.Capability, tags []types.Tag, rollbackConfiguration *types.RollbackConfiguration) error {
+	if err := validateRollbackConfiguration(rollbackConfiguration); err != nil {
+		return errors.Wrapf(err, "invalid rollback configuration for stack %q", stackName)
+	}
 	input := &cloudformation.CreateChangeSetInput{
-		StackName:     &stackName,
-		ChangeSetName: &changeSetName,
-		Description:   &description,
-		Tags:          append(tags, c.sharedTags...),
+		StackName:             &stackName,
+		ChangeSetName:         &changeSetName,
+		Description:           &description,
+		Tags:                  append(tags, c.sharedTags...),
+		RollbackConfiguration: rollbackConfiguration,
 	}
 
 	input.ChangeSetType = types.ChangeSetTypeUpdate
 
+	templateData, deleteTemplate, err := c.resolveTemplateData(ctx, stackName, templateData)
+	if err != nil {
+		return err
+	}
+	defer deleteTemplate()
 	switch data := templateData.(type) {
 	case TemplateBody:
 		input.TemplateBody = aws.String(string(data))
@@ -669,6 +1261,12 @@ func (c *StackCollection) doCreateChangeSetRequest(ctx context.Context, stackNam
 		}
 		input.Parameters = append(input.Parameters, p)
 	}
+	for _, k := range preserveParameters {
+		input.Parameters = append(input.Parameters, types.Parameter{
+			ParameterKey:     aws.String(k),
+			UsePreviousValue: aws.Bool(true),
+		})
+	}
 
 	logger.Debug("creating changeSet, input = %#v", input)
 	s, err := c.cloudformationAPI.CreateChangeSet(ctx, input)
@@ -693,6 +1291,31 @@ func (c *StackCollection) doExecuteChangeSet(ctx context.Context, stackName stri
 	return nil
 }
 
+func (c *StackCollection) doDeleteChangeSet(ctx context.Context, stackName string, changeSetName string) error {
+	input := &cloudformation.DeleteChangeSetInput{
+		ChangeSetName: &changeSetName,
+		StackName:     &stackName,
+	}
+
+	logger.Debug("deleting changeSet, input = %#v", input)
+
+	if _, err := c.cloudformationAPI.DeleteChangeSet(ctx, input); err != nil {
+		return errors.Wrapf(err, "deleting CloudFormation ChangeSet %q for stack %q", changeSetName, stackName)
+	}
+	return nil
+}
+
+func logChangeSet(changeSet *ChangeSet) {
+	logger.Info("%d change(s) to be applied to stack %q", len(changeSet.Changes), aws.StringValue(changeSet.StackName))
+	for _, change := range changeSet.Changes {
+		rc := change.ResourceChange
+		if rc == nil {
+			continue
+		}
+		logger.Info("%s %s (%s), replacement: %s", rc.Action, aws.StringValue(rc.LogicalResourceId), aws.StringValue(rc.ResourceType), rc.Replacement)
+	}
+}
+
 // DescribeStackChangeSet describes a ChangeSet by name
 func (c *StackCollection) DescribeStackChangeSet(ctx context.Context, i *Stack, changeSetName string) (*ChangeSet, error) {
 	input := &cloudformation.DescribeChangeSetInput{
@@ -708,3 +1331,39 @@ func (c *StackCollection) DescribeStackChangeSet(ctx context.Context, i *Stack,
 	}
 	return resp, nil
 }
+
+// ChangeSetSummary summarises a ChangeSet of a stack
+type ChangeSetSummary struct {
+	Name            string
+	Status          types.ChangeSetStatus
+	ExecutionStatus types.ExecutionStatus
+	CreationTime    time.Time
+}
+
+// ListStackChangeSets lists the ChangeSets of a stack
+func (c *StackCollection) ListStackChangeSets(ctx context.Context, stackName string) ([]ChangeSetSummary, error) {
+	var summaries []ChangeSetSummary
+	paginator := cloudformation.NewListChangeSetsPaginator(c.cloudformationAPI, &cloudformation.ListChangeSetsInput{
+		StackName: &stackName,
+	})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "listing CloudFormation ChangeSets for stack %q", stackName)
+		}
+		for _, s := range out.Summaries {
+			summaries = append(summaries, ChangeSetSummary{
+				Name:            aws.StringValue(s.ChangeSetName),
+				Status:          s.Status,
+				ExecutionStatus: s.ExecutionStatus,
+				CreationTime:    aws.TimeValue(s.CreationTime),
+			})
+		}
+	}
+	return summaries, nil
+}
+
+// DeleteStackChangeSet deletes a ChangeSet of a stack by name
+func (c *StackCollection) DeleteStackChangeSet(ctx context.Context, stackName, changeSetName string) error {
+	return c.doDeleteChangeSet(ctx, stackName, changeSetName)
+}
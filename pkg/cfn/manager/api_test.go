@@ -4,18 +4,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/awstesting"
+	"github.com/aws/smithy-go"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/mock"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 )
 
@@ -89,6 +92,576 @@ var _ = Describe("StackCollection", func() {
 			})
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("records the deployment id, keeping the other tags of the stack", func() {
+			stackName := "eksctl-stack"
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(nil, nil)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+				StackName:    &stackName,
+				StatusReason: aws.String("The submitted information didn't contain changes"),
+			}, nil)
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			sm.SetDeploymentID("default-sha")
+			err := sm.UpdateStack(context.TODO(), UpdateStackOptions{
+				Stack: &Stack{
+					StackName: &stackName,
+					Tags: []types.Tag{
+						{Key: aws.String("team"), Value: aws.String("eks")},
+						{Key: aws.String(api.DeploymentIDTag), Value: aws.String("old-sha")},
+					},
+				},
+				ChangeSetName: "eksctl-changeset",
+				Description:   "description",
+				TemplateData:  TemplateBody(""),
+				DeploymentID:  "new-sha",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			input := p.MockCloudFormation().Calls[0].Arguments.Get(1).(*cfn.CreateChangeSetInput)
+			Expect(input.Tags).To(ContainElements(
+				types.Tag{Key: aws.String("team"), Value: aws.String("eks")},
+				types.Tag{Key: aws.String(api.DeploymentIDTag), Value: aws.String("new-sha")},
+			))
+			Expect(input.Tags).NotTo(ContainElement(types.Tag{Key: aws.String(api.DeploymentIDTag), Value: aws.String("old-sha")}))
+		})
+
+		It("passes the rollback configuration to the change set", func() {
+			stackName := "eksctl-stack"
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(nil, nil)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+				StackName:    &stackName,
+				StatusReason: aws.String("The submitted information didn't contain changes"),
+			}, nil)
+
+			rollbackConfiguration := &types.RollbackConfiguration{
+				MonitoringTimeInMinutes: aws.Int32(15),
+				RollbackTriggers: []types.RollbackTrigger{{
+					Arn:  aws.String("arn:aws:cloudwatch:us-west-2:123456789012:alarm:node-errors"),
+					Type: aws.String("AWS::CloudWatch::Alarm"),
+				}},
+			}
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			_, err := sm.UpdateStackWithChanges(context.TODO(), UpdateStackOptions{
+				Stack:                 &Stack{StackName: &stackName},
+				ChangeSetName:         "eksctl-changeset",
+				Description:           "description",
+				TemplateData:          TemplateBody(""),
+				RollbackConfiguration: rollbackConfiguration,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			input := p.MockCloudFormation().Calls[0].Arguments.Get(1).(*cfn.CreateChangeSetInput)
+			Expect(input.RollbackConfiguration).To(Equal(rollbackConfiguration))
+		})
+
+		DescribeTable("rejects invalid rollback configurations", func(config *types.RollbackConfiguration, expectedErr string) {
+			stackName := "eksctl-stack"
+			p := mockprovider.NewMockProvider()
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			_, err := sm.UpdateStackWithChanges(context.TODO(), UpdateStackOptions{
+				Stack:                 &Stack{StackName: &stackName},
+				ChangeSetName:         "eksctl-changeset",
+				TemplateData:          TemplateBody(""),
+				RollbackConfiguration: config,
+			})
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything, mock.Anything)
+		},
+			Entry("malformed ARN", &types.RollbackConfiguration{
+				RollbackTriggers: []types.RollbackTrigger{{Arn: aws.String("node-errors"), Type: aws.String("AWS::CloudWatch::Alarm")}},
+			}, `invalid alarm ARN "node-errors"`),
+			Entry("not an alarm", &types.RollbackConfiguration{
+				RollbackTriggers: []types.RollbackTrigger{{Arn: aws.String("arn:aws:sns:us-west-2:123456789012:topic"), Type: aws.String("AWS::CloudWatch::Alarm")}},
+			}, "is not the ARN of a CloudWatch alarm"),
+			Entry("monitoring time too long", &types.RollbackConfiguration{
+				MonitoringTimeInMinutes: aws.Int32(181),
+			}, "monitoring time must be between 0 and 180 minutes, got 181"),
+		)
+
+		namedRoleTemplate := `{"Resources": {"NodeInstanceRole": {"Type": "AWS::IAM::Role", "Properties": {"RoleName": "node-role"}}}}`
+
+		It("rejects templates requiring capabilities not granted to the stack", func() {
+			stackName := "eksctl-stack"
+			p := mockprovider.NewMockProvider()
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			_, err := sm.UpdateStackWithChanges(context.TODO(), UpdateStackOptions{
+				Stack: &Stack{
+					StackName:    &stackName,
+					Capabilities: []types.Capability{types.CapabilityCapabilityIam},
+				},
+				ChangeSetName: "eksctl-changeset",
+				TemplateData:  TemplateBody(namedRoleTemplate),
+			})
+			Expect(err).To(MatchError(`the template of stack "eksctl-stack" requires capabilities CAPABILITY_NAMED_IAM, which are not granted to the stack`))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything, mock.Anything)
+		})
+
+		It("updates stacks granted the capabilities required by the template", func() {
+			stackName := "eksctl-stack"
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(nil, nil)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+				StackName:    &stackName,
+				StatusReason: aws.String("The submitted information didn't contain changes"),
+			}, nil)
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			_, err := sm.UpdateStackWithChanges(context.TODO(), UpdateStackOptions{
+				Stack: &Stack{
+					StackName:    &stackName,
+					Capabilities: []types.Capability{types.CapabilityCapabilityNamedIam},
+				},
+				ChangeSetName: "eksctl-changeset",
+				TemplateData:  TemplateBody(namedRoleTemplate),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			input := p.MockCloudFormation().Calls[0].Arguments.Get(1).(*cfn.CreateChangeSetInput)
+			Expect(input.Capabilities).To(ConsistOf(types.CapabilityCapabilityNamedIam))
+		})
+
+		DescribeTable("determines the capabilities required by a template", func(template string, expected []types.Capability) {
+			Expect(requiredStackCapabilities(template)).To(Equal(expected))
+		},
+			Entry("no IAM resources", `{"Resources": {"SG": {"Type": "AWS::EC2::SecurityGroup"}}}`, nil),
+			Entry("unnamed IAM role", `{"Resources": {"Role": {"Type": "AWS::IAM::Role", "Properties": {}}}}`, []types.Capability{types.CapabilityCapabilityIam}),
+			Entry("named IAM role", namedRoleTemplate, []types.Capability{types.CapabilityCapabilityNamedIam}),
+			Entry("transform", `{"Transform": "AWS::Serverless-2016-10-31", "Resources": {}}`, []types.Capability{types.CapabilityCapabilityAutoExpand}),
+		)
+
+		It("does not execute the change set in dry-run mode", func() {
+			stackName := "eksctl-stack"
+			changeSetName := "eksctl-changeset"
+			describeOutput := &cfn.DescribeStacksOutput{Stacks: []types.Stack{{
+				StackName:   &stackName,
+				StackStatus: types.StackStatusCreateComplete,
+			}}}
+			describeChangeSetOutput := &cfn.DescribeChangeSetOutput{
+				StackName:     &stackName,
+				ChangeSetName: &changeSetName,
+				Status:        types.ChangeSetStatusCreateComplete,
+				Changes: []types.Change{{
+					ResourceChange: &types.ResourceChange{
+						Action:            types.ChangeActionModify,
+						LogicalResourceId: aws.String("LaunchTemplate"),
+						ResourceType:      aws.String("AWS::EC2::LaunchTemplate"),
+						Replacement:       types.ReplacementFalse,
+					},
+				}},
+			}
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(describeOutput, nil)
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(nil, nil)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(describeChangeSetOutput, nil)
+			p.MockCloudFormation().On("DeleteChangeSet", mock.Anything, &cfn.DeleteChangeSetInput{
+				StackName:     &stackName,
+				ChangeSetName: &changeSetName,
+			}).Return(&cfn.DeleteChangeSetOutput{}, nil)
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			err := sm.UpdateStack(context.TODO(), UpdateStackOptions{
+				StackName:     stackName,
+				ChangeSetName: changeSetName,
+				Description:   "description",
+				TemplateData:  TemplateBody(""),
+				Wait:          true,
+				DryRun:        true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DeleteChangeSet", 1)
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ExecuteChangeSet", mock.Anything, mock.Anything)
+		})
+
+		It("returns the reason a change set failed to be created", func() {
+			stackName := "eksctl-stack"
+			describeOutput := &cfn.DescribeStacksOutput{Stacks: []types.Stack{{
+				StackName:   &stackName,
+				StackStatus: types.StackStatusCreateComplete,
+			}}}
+			reason := "Requires capabilities : [CAPABILITY_NAMED_IAM]"
+			describeChangeSetFailed := &cfn.DescribeChangeSetOutput{
+				StackName:    &stackName,
+				Status:       types.ChangeSetStatusFailed,
+				StatusReason: aws.String(reason),
+			}
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(describeOutput, nil)
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(nil, nil)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(describeChangeSetFailed, nil)
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			err := sm.UpdateStack(context.TODO(), UpdateStackOptions{
+				StackName:     stackName,
+				ChangeSetName: "eksctl-changeset",
+				Description:   "description",
+				TemplateData:  TemplateBody(""),
+			})
+			Expect(err).To(MatchError(`creating CloudFormation changeset "eksctl-changeset" for stack "eksctl-stack" failed: ` + reason))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ExecuteChangeSet", mock.Anything, mock.Anything)
+		})
+
+		It("times out waiting for the change set with a short wait timeout", func() {
+			stackName := "eksctl-stack"
+			describeOutput := &cfn.DescribeStacksOutput{Stacks: []types.Stack{{
+				StackName:   &stackName,
+				StackStatus: types.StackStatusCreateComplete,
+			}}}
+			describeChangeSetPending := &cfn.DescribeChangeSetOutput{
+				StackName: &stackName,
+				Status:    types.ChangeSetStatusCreatePending,
+			}
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(describeOutput, nil)
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(nil, nil)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(describeChangeSetPending, nil)
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			err := sm.UpdateStack(context.TODO(), UpdateStackOptions{
+				StackName:     stackName,
+				ChangeSetName: "eksctl-changeset",
+				Description:   "description",
+				TemplateData:  TemplateBody(""),
+				WaitInterval:  10 * time.Millisecond,
+				WaitTimeout:   50 * time.Millisecond,
+			})
+			Expect(err).To(MatchError(ContainSubstring("exceeded max wait time")))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ExecuteChangeSet", mock.Anything, mock.Anything)
+		})
+
+		It("marks preserved parameters as using their previous value", func() {
+			stackName := "eksctl-stack"
+			describeOutput := &cfn.DescribeStacksOutput{Stacks: []types.Stack{{
+				StackName:   &stackName,
+				StackStatus: types.StackStatusCreateComplete,
+			}}}
+			describeChangeSetNoChange := &cfn.DescribeChangeSetOutput{
+				StackName:    &stackName,
+				StatusReason: aws.String("The submitted information didn't contain changes"),
+			}
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(describeOutput, nil)
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(nil, nil)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(describeChangeSetNoChange, nil)
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			err := sm.UpdateStack(context.TODO(), UpdateStackOptions{
+				StackName:          stackName,
+				ChangeSetName:      "eksctl-changeset",
+				Description:        "description",
+				TemplateData:       TemplateBody(""),
+				Parameters:         map[string]string{"InstanceType": "m5.large"},
+				PreserveParameters: []string{"AMI"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			input := p.MockCloudFormation().Calls[1].Arguments.Get(1).(*cfn.CreateChangeSetInput)
+			Expect(input.Parameters).To(ConsistOf(
+				types.Parameter{ParameterKey: aws.String("InstanceType"), ParameterValue: aws.String("m5.large")},
+				types.Parameter{ParameterKey: aws.String("AMI"), UsePreviousValue: aws.Bool(true)},
+			))
+		})
+	})
+
+	Context("CreateStackWithCapabilities", func() {
+		var p *mockprovider.MockProvider
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+		})
+
+		It("requests the given capabilities along with the IAM ones", func() {
+			stackName := "eksctl-test-cluster-fargate"
+			p.MockCloudFormation().On("CreateStack", mock.Anything, mock.MatchedBy(func(input *cfn.CreateStackInput) bool {
+				return *input.StackName == stackName
+			})).Return(&cfn.CreateStackOutput{StackId: aws.String("stack-id")}, nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String(stackName), StackStatus: types.StackStatusCreateComplete}},
+			}, nil)
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			errs := make(chan error)
+			err := sm.CreateStackWithCapabilities(context.TODO(), stackName, builder.NewFargateResourceSet(api.NewClusterConfig()), nil, nil, []string{"CAPABILITY_AUTO_EXPAND"}, errs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(<-errs).NotTo(HaveOccurred())
+
+			input := p.MockCloudFormation().Calls[0].Arguments.Get(1).(*cfn.CreateStackInput)
+			Expect(input.Capabilities).To(Equal([]types.Capability{types.CapabilityCapabilityNamedIam, types.CapabilityCapabilityAutoExpand}))
+		})
+
+		It("rejects unknown capabilities", func() {
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			err := sm.CreateStackWithCapabilities(context.TODO(), "stack", builder.NewFargateResourceSet(api.NewClusterConfig()), nil, nil, []string{"CAPABILITY_EVERYTHING"}, make(chan error))
+			Expect(err).To(MatchError(ContainSubstring(`unknown CloudFormation capability "CAPABILITY_EVERYTHING"`)))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateStack", mock.Anything, mock.Anything)
+		})
+	})
+
+	Context("DescribeStackIfExists", func() {
+		var p *mockprovider.MockProvider
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+		})
+
+		It("returns the stack when it exists", func() {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String("stack")}},
+			}, nil)
+
+			stack, found, err := NewStackCollection(p, api.NewClusterConfig()).DescribeStackIfExists(context.TODO(), &Stack{StackName: aws.String("stack")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(*stack.StackName).To(Equal("stack"))
+		})
+
+		It("reports a missing stack without an error", func() {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(nil, &smithy.OperationError{
+				Err: &smithy.GenericAPIError{Code: "ValidationError", Message: "Stack with id stack does not exist"},
+			})
+
+			stack, found, err := NewStackCollection(p, api.NewClusterConfig()).DescribeStackIfExists(context.TODO(), &Stack{StackName: aws.String("stack")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+			Expect(stack).To(BeNil())
+		})
+
+		It("propagates other validation errors", func() {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(nil, &smithy.OperationError{
+				Err: &smithy.GenericAPIError{Code: "ValidationError", Message: "1 validation error detected"},
+			})
+
+			_, found, err := NewStackCollection(p, api.NewClusterConfig()).DescribeStackIfExists(context.TODO(), &Stack{StackName: aws.String("stack")})
+			Expect(err).To(MatchError(ContainSubstring("1 validation error detected")))
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("termination protection", func() {
+		var p *mockprovider.MockProvider
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("UpdateTerminationProtection", mock.Anything, mock.Anything).Return(&cfn.UpdateTerminationProtectionOutput{}, nil)
+		})
+
+		DescribeTable("SetTerminationProtection", func(enabled bool) {
+			Expect(NewStackCollection(p, api.NewClusterConfig()).SetTerminationProtection(context.TODO(), "stack", enabled)).To(Succeed())
+			p.MockCloudFormation().AssertCalled(GinkgoT(), "UpdateTerminationProtection", mock.Anything, &cfn.UpdateTerminationProtectionInput{
+				StackName:                   aws.String("stack"),
+				EnableTerminationProtection: aws.Bool(enabled),
+			})
+		},
+			Entry("enables protection", true),
+			Entry("disables protection", false),
+		)
+
+		DescribeTable("IsTerminationProtected", func(enabled *bool, expected bool) {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: aws.String("stack")}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String("stack"), EnableTerminationProtection: enabled}},
+			}, nil)
+
+			protected, err := NewStackCollection(p, api.NewClusterConfig()).IsTerminationProtected(context.TODO(), "stack")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(protected).To(Equal(expected))
+		},
+			Entry("protected", aws.Bool(true), true),
+			Entry("not protected", aws.Bool(false), false),
+			Entry("unset", nil, false),
+		)
+	})
+
+	Context("CreateStackIfNotExists", func() {
+		const stackName = "eksctl-test-cluster-fargate"
+		var (
+			p            *mockprovider.MockProvider
+			sm           *StackCollection
+			resourceSet  *builder.FargateResourceSet
+			templateBody []byte
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			sm = NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+			resourceSet = builder.NewFargateResourceSet(api.NewClusterConfig())
+			Expect(resourceSet.AddAllResources()).To(Succeed())
+			var err error
+			templateBody, err = resourceSet.RenderJSON()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		mockExistingStack := func(tags map[string]string, template string) {
+			stack := types.Stack{StackName: aws.String(stackName), StackStatus: types.StackStatusCreateComplete}
+			for _, t := range sm.sharedTags {
+				stack.Tags = append(stack.Tags, t)
+			}
+			for k, v := range tags {
+				stack.Tags = append(stack.Tags, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+			}
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{Stacks: []types.Stack{stack}}, nil)
+			p.MockCloudFormation().On("GetTemplate", mock.Anything, mock.Anything).Return(&cfn.GetTemplateOutput{TemplateBody: aws.String(template)}, nil)
+		}
+
+		It("creates the stack when it does not exist", func() {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(nil, &smithy.OperationError{
+				Err: &smithy.GenericAPIError{Code: "ValidationError", Message: "Stack with id eksctl-test-cluster-fargate does not exist"},
+			}).Once()
+			p.MockCloudFormation().On("CreateStack", mock.Anything, mock.Anything).Return(&cfn.CreateStackOutput{StackId: aws.String("stack-id")}, nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{
+					StackName:   aws.String(stackName),
+					StackStatus: types.StackStatusCreateComplete,
+					Outputs:     []types.Output{{OutputKey: aws.String("FargatePodExecutionRoleARN"), OutputValue: aws.String("arn")}},
+				}},
+			}, nil)
+
+			errs := make(chan error)
+			Expect(sm.CreateStackIfNotExists(context.TODO(), stackName, resourceSet, nil, nil, errs)).To(Succeed())
+			Expect(<-errs).NotTo(HaveOccurred())
+			p.MockCloudFormation().AssertCalled(GinkgoT(), "CreateStack", mock.Anything, mock.Anything)
+		})
+
+		It("does nothing when the existing stack matches", func() {
+			mockExistingStack(map[string]string{"team": "eks"}, string(templateBody))
+
+			errs := make(chan error)
+			Expect(sm.CreateStackIfNotExists(context.TODO(), stackName, resourceSet, map[string]string{"team": "eks"}, nil, errs)).To(Succeed())
+			Expect(<-errs).NotTo(HaveOccurred())
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateStack", mock.Anything, mock.Anything)
+		})
+
+		It("errors when the existing stack has different tags", func() {
+			mockExistingStack(map[string]string{"team": "other"}, string(templateBody))
+
+			err := sm.CreateStackIfNotExists(context.TODO(), stackName, resourceSet, map[string]string{"team": "eks"}, nil, make(chan error))
+			Expect(err).To(MatchError(ContainSubstring("update it instead")))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateStack", mock.Anything, mock.Anything)
+		})
+
+		It("errors when the existing stack has a different template", func() {
+			mockExistingStack(nil, `{"Resources": {"Role": {"Type": "AWS::IAM::Role"}}}`)
+
+			err := sm.CreateStackIfNotExists(context.TODO(), stackName, resourceSet, nil, nil, make(chan error))
+			Expect(err).To(MatchError(ContainSubstring("update it instead")))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateStack", mock.Anything, mock.Anything)
+		})
+	})
+
+	Context("CreateStackWithEventHandler", func() {
+		It("passes the stack events to the handler in chronological order", func() {
+			stackName := "eksctl-test-cluster-fargate"
+			later := time.Now().Add(time.Hour)
+			newEvent := func(id string, offset time.Duration) types.StackEvent {
+				return types.StackEvent{EventId: aws.String(id), Timestamp: aws.Time(later.Add(offset))}
+			}
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("CreateStack", mock.Anything, mock.Anything).Return(&cfn.CreateStackOutput{StackId: aws.String("stack-id")}, nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String(stackName), StackStatus: types.StackStatusCreateComplete}},
+			}, nil)
+			p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, mock.Anything).Return(&cfn.DescribeStackEventsOutput{
+				StackEvents: []types.StackEvent{newEvent("3", 2*time.Second), newEvent("2", time.Second), newEvent("1", 0)},
+			}, nil)
+
+			var reported []string
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			errs := make(chan error)
+			err := sm.CreateStackWithEventHandler(context.TODO(), stackName, builder.NewFargateResourceSet(api.NewClusterConfig()), nil, nil, func(e StackEvent) {
+				reported = append(reported, *e.EventId)
+			}, errs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(<-errs).NotTo(HaveOccurred())
+			Expect(reported).To(Equal([]string{"1", "2", "3"}))
+		})
+	})
+
+	Context("CreateStackNoWait", func() {
+		It("returns the stack id without waiting for the stack to be created", func() {
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("CreateStack", mock.Anything, mock.Anything).Return(&cfn.CreateStackOutput{StackId: aws.String("stack-id")}, nil)
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			stackID, err := sm.CreateStackNoWait(context.TODO(), "eksctl-test-cluster-fargate", builder.NewFargateResourceSet(api.NewClusterConfig()), nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stackID).To(Equal("stack-id"))
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "CreateStack", 1)
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStacks", mock.Anything, mock.Anything, mock.Anything)
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStacks", mock.Anything, mock.Anything)
+		})
+	})
+
+	Context("ListStackChangeSets", func() {
+		It("lists the change sets of the stack", func() {
+			creationTime := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("ListChangeSets", mock.Anything, &cfn.ListChangeSetsInput{StackName: aws.String("stack")}, mock.Anything).Return(&cfn.ListChangeSetsOutput{
+				Summaries: []types.ChangeSetSummary{{
+					ChangeSetName:   aws.String("eksctl-update-1"),
+					Status:          types.ChangeSetStatusCreateComplete,
+					ExecutionStatus: types.ExecutionStatusAvailable,
+					CreationTime:    &creationTime,
+				}},
+			}, nil)
+
+			summaries, err := NewStackCollection(p, api.NewClusterConfig()).ListStackChangeSets(context.TODO(), "stack")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(summaries).To(Equal([]ChangeSetSummary{{
+				Name:            "eksctl-update-1",
+				Status:          types.ChangeSetStatusCreateComplete,
+				ExecutionStatus: types.ExecutionStatusAvailable,
+				CreationTime:    creationTime,
+			}}))
+		})
+	})
+
+	Context("UpdateStackWithChanges", func() {
+		It("returns a summary of the executed changes", func() {
+			stackName := "eksctl-stack"
+			changeSetName := "eksctl-changeset"
+			describeOutput := &cfn.DescribeStacksOutput{Stacks: []types.Stack{{
+				StackName:   &stackName,
+				StackStatus: types.StackStatusCreateComplete,
+			}}}
+			newChange := func(id string, action types.ChangeAction, replacement types.Replacement) types.Change {
+				return types.Change{ResourceChange: &types.ResourceChange{
+					Action:            action,
+					LogicalResourceId: aws.String(id),
+					Replacement:       replacement,
+				}}
+			}
+			describeChangeSetOutput := &cfn.DescribeChangeSetOutput{
+				StackName:     &stackName,
+				ChangeSetName: &changeSetName,
+				Status:        types.ChangeSetStatusCreateComplete,
+				Changes: []types.Change{
+					newChange("LaunchTemplate", types.ChangeActionModify, types.ReplacementTrue),
+					newChange("NodeGroup", types.ChangeActionModify, types.ReplacementConditional),
+					newChange("SG", types.ChangeActionModify, types.ReplacementFalse),
+					newChange("PolicyEBS", types.ChangeActionAdd, ""),
+				},
+			}
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(describeOutput, nil)
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(nil, nil)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(describeChangeSetOutput, nil)
+			p.MockCloudFormation().On("ExecuteChangeSet", mock.Anything, mock.Anything).Return(&cfn.ExecuteChangeSetOutput{}, nil)
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			summary, err := sm.UpdateStackWithChanges(context.TODO(), UpdateStackOptions{
+				StackName:     stackName,
+				ChangeSetName: changeSetName,
+				Description:   "description",
+				TemplateData:  TemplateBody(""),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(summary.Changes).To(HaveLen(4))
+			Expect(summary.ActionCounts).To(Equal(map[types.ChangeAction]int{
+				types.ChangeActionModify: 3,
+				types.ChangeActionAdd:    1,
+			}))
+			Expect(summary.Replacements).To(Equal([]string{"LaunchTemplate", "NodeGroup"}))
+		})
 	})
 
 	It("updates tags (existing + metadata + auto)", func() {
@@ -317,9 +890,40 @@ var _ = Describe("StackCollection", func() {
 				stack, err := sm.GetClusterStackIfExists(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 				Expect(stack).To(BeNil())
+				p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{}, mock.Anything)
 			})
 		})
 
+		It("does not look up stacks by tag when the named stack exists", func() {
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{
+				StackSummaries: []types.StackSummary{{StackName: &stackNameWithEksctl}},
+			}, nil)
+			sm := NewStackCollection(p, cfg)
+			_, err := sm.GetClusterStackIfExists(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "ListStacks", 1)
+		})
+
+		It("finds a cluster stack that doesn't follow the naming convention by its cluster name tag", func() {
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{
+				StackSummaries: []types.StackSummary{
+					{StackName: aws.String("imported-cluster"), TemplateDescription: aws.String("EKS cluster (dedicated VPC: true) [created and managed by eksctl]")},
+				},
+			}, nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: aws.String("imported-cluster")}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{
+					StackName:   aws.String("imported-cluster"),
+					StackStatus: types.StackStatusUpdateComplete,
+					Tags:        []types.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("confirm-this")}},
+					Outputs:     []types.Output{{OutputKey: aws.String("ClusterStackName"), OutputValue: aws.String("imported-cluster")}},
+				}},
+			}, nil)
+			sm := NewStackCollection(p, cfg)
+			stack, err := sm.GetClusterStackIfExists(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*stack.StackName).To(Equal("imported-cluster"))
+		})
+
 		When("ListStacks errors", func() {
 			It("errors", func() {
 				p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(nil, errors.New("nope"))
@@ -329,4 +933,495 @@ var _ = Describe("StackCollection", func() {
 			})
 		})
 	})
+
+	Context("FindClusterStackByTag", func() {
+		var (
+			p   *mockprovider.MockProvider
+			cfg *api.ClusterConfig
+		)
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "confirm-this"
+		})
+
+		mockStack := func(name string, tags []types.Tag, outputs []types.Output) {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: aws.String(name)}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String(name), StackStatus: types.StackStatusCreateComplete, Tags: tags, Outputs: outputs}},
+			}, nil)
+		}
+
+		It("describes only the stacks with the template description of a cluster stack", func() {
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{
+				StackSummaries: []types.StackSummary{
+					{StackName: aws.String("imported-nodegroup"), TemplateDescription: aws.String("EKS nodes (AMI family: AmazonLinux2) [created and managed by eksctl]")},
+					{StackName: aws.String("other-cluster"), TemplateDescription: aws.String("EKS cluster (dedicated VPC: true) [created and managed by eksctl]")},
+					{StackName: aws.String("imported-cluster"), TemplateDescription: aws.String("EKS cluster (dedicated VPC: true) [created and managed by eksctl]")},
+				},
+			}, nil)
+			mockStack("other-cluster", []types.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("other")}},
+				[]types.Output{{OutputKey: aws.String("ClusterStackName"), OutputValue: aws.String("other-cluster")}})
+			mockStack("imported-cluster", []types.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("confirm-this")}},
+				[]types.Output{{OutputKey: aws.String("ClusterStackName"), OutputValue: aws.String("imported-cluster")}})
+
+			stack, err := NewStackCollection(p, cfg).FindClusterStackByTag(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*stack.StackName).To(Equal("imported-cluster"))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: aws.String("imported-nodegroup")})
+			p.MockCloudFormation().AssertCalled(GinkgoT(), "ListStacks", mock.Anything, &cfn.ListStacksInput{StackStatusFilter: defaultStackStatusFilter()})
+		})
+
+		It("returns no stack when no stack is tagged with the cluster name", func() {
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{}, nil)
+			stack, err := NewStackCollection(p, cfg).FindClusterStackByTag(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stack).To(BeNil())
+		})
+	})
+
+	Context("DescribeClusterStack", func() {
+		var (
+			p  *mockprovider.MockProvider
+			sm StackManager
+		)
+
+		BeforeEach(func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			stackName := aws.String("eksctl-test-cluster-cluster")
+			p = mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{
+				StackSummaries: []types.StackSummary{{StackName: stackName}},
+			}, nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{
+					StackName: stackName,
+					Tags:      []types.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")}},
+				}},
+			}, nil)
+			sm = NewStackCollection(p, cfg)
+		})
+
+		It("describes the cluster stack only once", func() {
+			for i := 0; i < 3; i++ {
+				stack, err := sm.DescribeClusterStack(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*stack.StackName).To(Equal("eksctl-test-cluster-cluster"))
+			}
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 1)
+		})
+
+		It("describes the cluster stack again after the cache is refreshed", func() {
+			_, err := sm.DescribeClusterStack(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			sm.RefreshClusterStackCache()
+			_, err = sm.DescribeClusterStack(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 2)
+		})
+
+		It("does not cache a missing cluster stack", func() {
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{}, nil)
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			for i := 0; i < 2; i++ {
+				stack, err := sm.DescribeClusterStack(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stack).To(BeNil())
+			}
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "ListStacks", 2)
+		})
+	})
+
+	Context("ListInProgressStacks", func() {
+		It("lists the stacks of the cluster with an operation in progress", func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{
+				StackSummaries: []types.StackSummary{
+					{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1")},
+					{StackName: aws.String("eksctl-other-cluster-nodegroup-ng-1")},
+				},
+			}, nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1")}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{
+					StackName:   aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+					StackStatus: types.StackStatusUpdateInProgress,
+				}},
+			}, nil)
+
+			stacks, err := NewStackCollection(p, cfg).ListInProgressStacks(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stacks).To(HaveLen(1))
+			Expect(*stacks[0].StackName).To(Equal("eksctl-test-cluster-nodegroup-ng-1"))
+
+			input := p.MockCloudFormation().Calls[0].Arguments.Get(1).(*cfn.ListStacksInput)
+			Expect(input.StackStatusFilter).To(ConsistOf(
+				types.StackStatusCreateInProgress,
+				types.StackStatusRollbackInProgress,
+				types.StackStatusDeleteInProgress,
+				types.StackStatusUpdateInProgress,
+				types.StackStatusUpdateCompleteCleanupInProgress,
+				types.StackStatusUpdateRollbackInProgress,
+				types.StackStatusUpdateRollbackCompleteCleanupInProgress,
+				types.StackStatusReviewInProgress,
+			))
+		})
+	})
+
+	Context("GetStackParameters", func() {
+		It("returns the parameters of the stack", func() {
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: aws.String("eksctl-stack")}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{
+					StackName: aws.String("eksctl-stack"),
+					Parameters: []types.Parameter{
+						{ParameterKey: aws.String("ClusterName"), ParameterValue: aws.String("test-cluster")},
+						{ParameterKey: aws.String("DesiredCapacity"), ParameterValue: aws.String("2")},
+					},
+				}},
+			}, nil)
+
+			parameters, err := NewStackCollection(p, api.NewClusterConfig()).GetStackParameters(context.TODO(), "eksctl-stack")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parameters).To(Equal(map[string]string{"ClusterName": "test-cluster", "DesiredCapacity": "2"}))
+		})
+
+		It("returns an empty map for a stack without parameters", func() {
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String("eksctl-stack")}},
+			}, nil)
+
+			parameters, err := NewStackCollection(p, api.NewClusterConfig()).GetStackParameters(context.TODO(), "eksctl-stack")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parameters).NotTo(BeNil())
+			Expect(parameters).To(BeEmpty())
+		})
+	})
+
+	Context("GetStackPhysicalResourceID", func() {
+		It("returns the physical id of the resource", func() {
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything, &cfn.DescribeStackResourceInput{
+				StackName:         aws.String("eksctl-stack"),
+				LogicalResourceId: aws.String("SG"),
+			}).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &types.StackResourceDetail{PhysicalResourceId: aws.String("sg-1")},
+			}, nil)
+
+			id, err := NewStackCollection(p, api.NewClusterConfig()).GetStackPhysicalResourceID(context.TODO(), "eksctl-stack", "SG")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal("sg-1"))
+		})
+
+		It("returns ErrStackResourceNotFound when the stack has no such resource", func() {
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything, mock.Anything).Return(nil, &smithy.OperationError{
+				Err: &smithy.GenericAPIError{Code: "ValidationError", Message: "Resource SG does not exist for stack eksctl-stack"},
+			})
+
+			_, err := NewStackCollection(p, api.NewClusterConfig()).GetStackPhysicalResourceID(context.TODO(), "eksctl-stack", "SG")
+			Expect(errors.Is(err, ErrStackResourceNotFound)).To(BeTrue())
+		})
+	})
+
+	Context("DescribeAllClusterStacks", func() {
+		It("classifies the stacks of the cluster", func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			clusterTag := types.Tag{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")}
+			stacks := []types.Stack{
+				{StackName: aws.String("eksctl-test-cluster-cluster"), Tags: []types.Tag{clusterTag}},
+				{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1"), Tags: []types.Tag{clusterTag, {Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")}}},
+				{StackName: aws.String("eksctl-test-cluster-addon-vpc-cni"), Tags: []types.Tag{clusterTag}},
+				{StackName: aws.String("eksctl-test-cluster-other-cluster"), Tags: []types.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster-other")}}},
+			}
+
+			p := mockprovider.NewMockProvider()
+			var summaries []types.StackSummary
+			for i := range stacks {
+				s := stacks[i]
+				summaries = append(summaries, types.StackSummary{StackName: s.StackName})
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: s.StackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{s},
+				}, nil)
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{StackSummaries: summaries}, nil)
+
+			sm := NewStackCollection(p, cfg)
+			cluster, nodegroups, others, err := sm.DescribeAllClusterStacks(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*cluster.StackName).To(Equal("eksctl-test-cluster-cluster"))
+			Expect(nodegroups).To(HaveLen(1))
+			Expect(*nodegroups[0].StackName).To(Equal("eksctl-test-cluster-nodegroup-ng-1"))
+			Expect(others).To(HaveLen(1))
+			Expect(*others[0].StackName).To(Equal("eksctl-test-cluster-addon-vpc-cni"))
+		})
+	})
+
+	Context("GetClusterVPCConfig", func() {
+		mockClusterStack := func(p *mockprovider.MockProvider, outputs map[string]string) {
+			stack := types.Stack{StackName: aws.String("eksctl-test-cluster-cluster"), Tags: []types.Tag{
+				{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+			}}
+			for k, v := range outputs {
+				stack.Outputs = append(stack.Outputs, types.Output{OutputKey: aws.String(k), OutputValue: aws.String(v)})
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{
+				StackSummaries: []types.StackSummary{{StackName: stack.StackName}},
+			}, nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{stack},
+			}, nil)
+		}
+
+		newStackManager := func(p *mockprovider.MockProvider) StackManager {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			return NewStackCollection(p, cfg)
+		}
+
+		It("returns the VPC config from the cluster stack outputs", func() {
+			p := mockprovider.NewMockProvider()
+			mockClusterStack(p, map[string]string{
+				"VPC":                     "vpc-1",
+				"SubnetsPrivate":          "subnet-1,subnet-2",
+				"SubnetsPublic":           "subnet-3",
+				"SharedNodeSecurityGroup": "sg-1",
+			})
+
+			info, err := newStackManager(p).GetClusterVPCConfig(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*info).To(Equal(ClusterVPCInfo{
+				VPC:                     "vpc-1",
+				PrivateSubnets:          []string{"subnet-1", "subnet-2"},
+				PublicSubnets:           []string{"subnet-3"},
+				SharedNodeSecurityGroup: "sg-1",
+			}))
+		})
+
+		It("reads the public subnets from the legacy output", func() {
+			p := mockprovider.NewMockProvider()
+			mockClusterStack(p, map[string]string{
+				"VPC":                     "vpc-1",
+				"Subnets":                 "subnet-3",
+				"SharedNodeSecurityGroup": "sg-1",
+			})
+
+			info, err := newStackManager(p).GetClusterVPCConfig(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.PublicSubnets).To(Equal([]string{"subnet-3"}))
+			Expect(info.PrivateSubnets).To(BeEmpty())
+		})
+
+		It("returns an error when the cluster stack predates the VPC outputs", func() {
+			p := mockprovider.NewMockProvider()
+			mockClusterStack(p, map[string]string{"VPC": "vpc-1"})
+
+			_, err := newStackManager(p).GetClusterVPCConfig(context.TODO())
+			Expect(err).To(MatchError(`cluster stack "eksctl-test-cluster-cluster" predates the outputs describing its VPC: missing required outputs SharedNodeSecurityGroup in stack "eksctl-test-cluster-cluster"`))
+		})
+	})
+
+	Context("AddClusterStackTags", func() {
+		var (
+			p         *mockprovider.MockProvider
+			sm        StackManager
+			stackName = aws.String("eksctl-test-cluster-cluster")
+		)
+
+		BeforeEach(func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			p = mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{
+				StackSummaries: []types.StackSummary{{StackName: stackName}},
+			}, nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{
+					StackName:    stackName,
+					StackStatus:  types.StackStatusUpdateComplete,
+					Capabilities: []types.Capability{types.CapabilityCapabilityIam},
+					Parameters:   []types.Parameter{{ParameterKey: aws.String("Param"), ParameterValue: aws.String("value")}},
+					Tags: []types.Tag{
+						{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+						{Key: aws.String("team"), Value: aws.String("eks")},
+					},
+				}},
+			}, nil)
+			sm = NewStackCollection(p, cfg)
+		})
+
+		It("updates the stack with the previous template and the merged tags", func() {
+			p.MockCloudFormation().On("UpdateStack", mock.Anything, mock.Anything).Return(&cfn.UpdateStackOutput{}, nil)
+
+			Expect(sm.AddClusterStackTags(context.TODO(), map[string]string{"cost-center": "1234", "team": "platform"})).To(Succeed())
+
+			p.MockCloudFormation().AssertCalled(GinkgoT(), "UpdateStack", mock.Anything, &cfn.UpdateStackInput{
+				StackName:           stackName,
+				UsePreviousTemplate: aws.Bool(true),
+				Capabilities:        []types.Capability{types.CapabilityCapabilityIam},
+				Parameters:          []types.Parameter{{ParameterKey: aws.String("Param"), UsePreviousValue: aws.Bool(true)}},
+				Tags: []types.Tag{
+					{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+					{Key: aws.String("cost-center"), Value: aws.String("1234")},
+					{Key: aws.String("team"), Value: aws.String("platform")},
+				},
+			})
+		})
+
+		It("succeeds when the stack already has the tags", func() {
+			p.MockCloudFormation().On("UpdateStack", mock.Anything, mock.Anything).Return(nil, errors.New("ValidationError: No updates are to be performed."))
+
+			Expect(sm.AddClusterStackTags(context.TODO(), map[string]string{"team": "eks"})).To(Succeed())
+		})
+	})
+
+	Context("ApplyTagsToAllClusterStacks", func() {
+		var (
+			p  *mockprovider.MockProvider
+			sm StackManager
+		)
+
+		mockStack := func(stackName string, status types.StackStatus, tags ...types.Tag) {
+			tags = append(tags, types.Tag{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")})
+			output := &cfn.DescribeStacksOutput{Stacks: []types.Stack{{
+				StackName:   aws.String(stackName),
+				StackStatus: status,
+				Tags:        tags,
+			}}}
+			input := &cfn.DescribeStacksInput{StackName: aws.String(stackName)}
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, input).Return(output, nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, input, mock.Anything).Return(output, nil)
+		}
+
+		BeforeEach(func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			p = mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{
+				StackSummaries: []types.StackSummary{
+					{StackName: aws.String("eksctl-test-cluster-cluster")},
+					{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1")},
+					{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-2")},
+					{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-3")},
+				},
+			}, nil)
+			mockStack("eksctl-test-cluster-cluster", types.StackStatusUpdateComplete)
+			mockStack("eksctl-test-cluster-nodegroup-ng-1", types.StackStatusUpdateComplete,
+				types.Tag{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+				types.Tag{Key: aws.String("team"), Value: aws.String("nodes")},
+			)
+			mockStack("eksctl-test-cluster-nodegroup-ng-2", types.StackStatusCreateComplete,
+				types.Tag{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-2")},
+			)
+			mockStack("eksctl-test-cluster-nodegroup-ng-3", types.StackStatusUpdateInProgress,
+				types.Tag{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-3")},
+			)
+			sm = NewStackCollection(p, cfg)
+		})
+
+		It("tags the cluster and nodegroup stacks, preserving their tags and reporting the stacks that failed", func() {
+			p.MockCloudFormation().On("UpdateStack", mock.Anything, mock.MatchedBy(func(input *cfn.UpdateStackInput) bool {
+				return *input.StackName == "eksctl-test-cluster-nodegroup-ng-2"
+			})).Return(nil, errors.New("access denied"))
+			p.MockCloudFormation().On("UpdateStack", mock.Anything, mock.Anything).Return(&cfn.UpdateStackOutput{}, nil)
+
+			err := sm.ApplyTagsToAllClusterStacks(context.TODO(), map[string]string{"cost-center": "1234"})
+			Expect(err).To(MatchError(ContainSubstring(`tagging stack "eksctl-test-cluster-nodegroup-ng-2": updating tags of stack "eksctl-test-cluster-nodegroup-ng-2": access denied`)))
+			Expect(err).To(MatchError(ContainSubstring(`cannot tag stack "eksctl-test-cluster-nodegroup-ng-3" as its status is UPDATE_IN_PROGRESS`)))
+
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "UpdateStack", 3)
+			p.MockCloudFormation().AssertCalled(GinkgoT(), "UpdateStack", mock.Anything, &cfn.UpdateStackInput{
+				StackName:           aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+				UsePreviousTemplate: aws.Bool(true),
+				Tags: []types.Tag{
+					{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+					{Key: aws.String("cost-center"), Value: aws.String("1234")},
+					{Key: aws.String("team"), Value: aws.String("nodes")},
+				},
+			})
+		})
+
+		It("refuses to override the tags set by eksctl", func() {
+			err := sm.ApplyTagsToAllClusterStacks(context.TODO(), map[string]string{api.NodeGroupNameTag: "other"})
+			Expect(err).To(MatchError(ContainSubstring("as it is managed by eksctl")))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "UpdateStack", mock.Anything, mock.Anything)
+		})
+	})
+
+	Context("ValidateTemplate", func() {
+		const templateBody = `{"Resources": {"Role": {"Type": "AWS::IAM::Role"}}}`
+		var (
+			p  *mockprovider.MockProvider
+			sm *StackCollection
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			sm = NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+			p.MockCloudFormation().On("ValidateTemplate", mock.Anything, &cfn.ValidateTemplateInput{
+				TemplateBody: aws.String(templateBody),
+			}).Return(&cfn.ValidateTemplateOutput{
+				Capabilities:       []types.Capability{types.CapabilityCapabilityIam},
+				CapabilitiesReason: aws.String("The following resource(s) require capabilities: [AWS::IAM::Role]"),
+				Parameters:         []types.TemplateParameter{{ParameterKey: aws.String("ClusterName")}},
+			}, nil)
+		})
+
+		It("returns the parameters and required capabilities of the template", func() {
+			result, err := sm.ValidateTemplate(context.TODO(), TemplateBody(templateBody))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Capabilities).To(Equal([]types.Capability{types.CapabilityCapabilityIam}))
+			Expect(result.CapabilitiesReason).To(ContainSubstring("AWS::IAM::Role"))
+			Expect(result.Parameters).To(HaveLen(1))
+			Expect(*result.Parameters[0].ParameterKey).To(Equal("ClusterName"))
+		})
+
+		It("rejects a stack template requiring capabilities that would not be granted", func() {
+			err := sm.validateStackTemplate(context.TODO(), "eksctl-stack", &stubResourceSet{template: templateBody}, TemplateBody(templateBody))
+			Expect(err).To(MatchError(ContainSubstring(`template of stack "eksctl-stack" requires capability CAPABILITY_IAM`)))
+		})
+
+		It("accepts a stack template whose required capabilities are granted", func() {
+			err := sm.validateStackTemplate(context.TODO(), "eksctl-stack", &stubResourceSet{template: templateBody, withIAM: true}, TemplateBody(templateBody))
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("DeploymentID", func() {
+		It("tags created stacks with the deployment id", func() {
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("CreateStack", mock.Anything, mock.Anything).Return(&cfn.CreateStackOutput{StackId: aws.String("stack-id")}, nil)
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			sm.SetDeploymentID("abc123")
+			Expect(sm.DoCreateStackRequest(context.TODO(), &Stack{StackName: aws.String("eksctl-stack")}, TemplateBody("{}"), nil, nil, false, false)).To(Succeed())
+
+			input := p.MockCloudFormation().Calls[0].Arguments.Get(1).(*cfn.CreateStackInput)
+			Expect(input.Tags).To(ContainElement(types.Tag{Key: aws.String(api.DeploymentIDTag), Value: aws.String("abc123")}))
+		})
+
+		DescribeTable("reads the deployment id of a stack", func(tags []types.Tag, expectedID string, expectedFound bool) {
+			id, found := GetDeploymentID(&Stack{Tags: tags})
+			Expect(id).To(Equal(expectedID))
+			Expect(found).To(Equal(expectedFound))
+		},
+			Entry("tagged", []types.Tag{{Key: aws.String(api.DeploymentIDTag), Value: aws.String("abc123")}}, "abc123", true),
+			Entry("untagged", []types.Tag{{Key: aws.String(api.EksctlVersionTag), Value: aws.String("0.90.0")}}, "", false),
+		)
+	})
 })
+
+type stubResourceSet struct {
+	template string
+	withIAM  bool
+}
+
+func (r *stubResourceSet) RenderJSON() ([]byte, error)     { return []byte(r.template), nil }
+func (r *stubResourceSet) WithIAM() bool                   { return r.withIAM }
+func (r *stubResourceSet) WithNamedIAM() bool              { return false }
+func (r *stubResourceSet) GetAllOutputs(types.Stack) error { return nil }
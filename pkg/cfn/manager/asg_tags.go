@@ -0,0 +1,575 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+// maxTagsPerAutoScalingGroupCall is the maximum number of tags created, updated or deleted per request
+const maxTagsPerAutoScalingGroupCall = 25
+
+// asgTagChanges holds the tags to create or update and the keys of the tags to delete on an autoscaling group
+type asgTagChanges struct {
+	upsert []asgtypes.Tag
+	delete []string
+}
+
+// ReconcileManagedNodeGroupTagsToASG makes the tags of the autoscaling groups of the given managed nodegroup match
+// desired: missing tags are added, tags with a different value are updated, and tags that are not desired are deleted.
+// Only the tags owned by eksctl are ever deleted, which are the tags of the nodegroup's stack, eksctl's own tags and the
+// cluster-autoscaler node-template tags; tags added by users or other tools are left untouched
+func (c *StackCollection) ReconcileManagedNodeGroupTagsToASG(ctx context.Context, ngName string, desired map[string]string) error {
+	stack, err := c.DescribeNodeGroupStack(ctx, ngName)
+	if err != nil {
+		return err
+	}
+	nodeGroupType, err := GetNodeGroupType(stack.Tags)
+	if err != nil {
+		return err
+	}
+	if nodeGroupType != api.NodeGroupTypeManaged {
+		return fmt.Errorf("nodegroup %q is not a managed nodegroup", ngName)
+	}
+
+	asgNames, err := c.waitForManagedNodeGroupAutoScalingGroupNames(ctx, stack)
+	if err != nil {
+		return err
+	}
+	if asgNames == "" {
+		return fmt.Errorf("no autoscaling groups found for nodegroup %q", ngName)
+	}
+
+	stackTags := make(map[string]bool, len(stack.Tags))
+	for _, t := range stack.Tags {
+		stackTags[aws.StringValue(t.Key)] = true
+	}
+	isOwned := func(key string) bool {
+		return stackTags[key] || isEksctlTag(key) || strings.HasPrefix(key, nodeTemplateTagPrefix)
+	}
+
+	var errs []error
+	for _, name := range strings.Split(asgNames, ",") {
+		asg, err := c.GetAutoScalingGroupDesiredCapacity(ctx, name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		changes := diffASGTags(name, asg.Tags, desired, isOwned)
+		if err := c.applyASGTagChanges(ctx, name, changes); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return combineErrors(errs)
+}
+
+// autoscalerTags returns the tags cluster-autoscaler uses to discover the autoscaling groups of the cluster
+func (c *StackCollection) autoscalerTags() map[string]string {
+	return map[string]string{
+		"k8s.io/cluster-autoscaler/enabled":                 "true",
+		"k8s.io/cluster-autoscaler/" + c.spec.Metadata.Name: "owned",
+	}
+}
+
+// FindNodeGroupsMissingAutoscalerTags returns the names of the nodegroups with an autoscaling group that lacks any
+// of the tags cluster-autoscaler discovers autoscaling groups by, and which it will therefore not scale
+func (c *StackCollection) FindNodeGroupsMissingAutoscalerTags(ctx context.Context) ([]string, error) {
+	stacks, err := c.ListNodeGroupStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodeGroupNames []string
+	for _, s := range stacks {
+		asgNames, err := c.GetAutoScalingGroupName(ctx, s.Stack)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting the autoscaling groups of nodegroup %q", s.NodeGroupName)
+		}
+		missing, err := c.isMissingAutoscalerTags(ctx, asgNames)
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing the tags of nodegroup %q", s.NodeGroupName)
+		}
+		if missing {
+			nodeGroupNames = append(nodeGroupNames, s.NodeGroupName)
+		}
+	}
+	return nodeGroupNames, nil
+}
+
+// isMissingAutoscalerTags reports whether any of the given comma-separated autoscaling groups lacks any of the
+// cluster-autoscaler discovery tags
+func (c *StackCollection) isMissingAutoscalerTags(ctx context.Context, asgNames string) (bool, error) {
+	var names []string
+	for _, name := range strings.Split(asgNames, ",") {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return false, nil
+	}
+
+	required := c.autoscalerTags()
+	keys := make([]string, 0, len(required))
+	for k := range required {
+		keys = append(keys, k)
+	}
+	found := make(map[string]int, len(names))
+	paginator := autoscaling.NewDescribeTagsPaginator(c.asgAPI, &autoscaling.DescribeTagsInput{
+		Filters: []asgtypes.Filter{
+			{Name: aws.String("auto-scaling-group"), Values: names},
+			{Name: aws.String("key"), Values: keys},
+		},
+	})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return false, err
+		}
+		for _, t := range out.Tags {
+			found[aws.StringValue(t.ResourceId)]++
+		}
+	}
+	for _, name := range names {
+		if found[name] < len(required) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AddAutoscalerTags adds the tags cluster-autoscaler discovers autoscaling groups by to the autoscaling groups of
+// the given nodegroup. The autoscaling groups of managed nodegroups are tagged directly, like
+// PropagateManagedNodeGroupTagsToASG does, while the autoscaling group of an unmanaged nodegroup, which is owned by
+// its stack, is tagged through an update of the stack
+func (c *StackCollection) AddAutoscalerTags(ctx context.Context, ngName string) error {
+	stack, err := c.DescribeNodeGroupStack(ctx, ngName)
+	if err != nil {
+		return err
+	}
+	nodeGroupType, err := GetNodeGroupType(stack.Tags)
+	if err != nil {
+		return err
+	}
+	if nodeGroupType != api.NodeGroupTypeManaged {
+		return c.addStackAutoscalerTags(ctx, ngName, stack)
+	}
+	asgNames, err := c.getManagedNodeGroupAutoScalingGroupName(ctx, stack)
+	if err != nil {
+		return err
+	}
+	if asgNames == "" {
+		return fmt.Errorf("no autoscaling groups found for nodegroup %q", ngName)
+	}
+
+	var errs []error
+	for _, name := range strings.Split(asgNames, ",") {
+		var changes asgTagChanges
+		for k, v := range c.autoscalerTags() {
+			changes.upsert = append(changes.upsert, asgtypes.Tag{
+				ResourceId:        aws.String(name),
+				ResourceType:      aws.String("auto-scaling-group"),
+				Key:               aws.String(k),
+				Value:             aws.String(v),
+				PropagateAtLaunch: aws.Bool(true),
+			})
+		}
+		if err := c.applyASGTagChanges(ctx, name, changes); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return combineErrors(errs)
+}
+
+// nodeGroupASGTagsPath is the path of the tags of the autoscaling group in unmanaged nodegroup stacks
+const nodeGroupASGTagsPath = "Resources.NodeGroup.Properties.Tags"
+
+// addStackAutoscalerTags adds the cluster-autoscaler discovery tags to the autoscaling group in the given unmanaged
+// nodegroup stack, and updates the stack unless its tags already include them
+func (c *StackCollection) addStackAutoscalerTags(ctx context.Context, ngName string, stack *Stack) error {
+	stackName := aws.StringValue(stack.StackName)
+	template, err := c.GetStackTemplate(ctx, stackName)
+	if err != nil {
+		return errors.Wrapf(err, "getting template of stack %q", stackName)
+	}
+	asgTags := gjson.Get(template, nodeGroupASGTagsPath)
+	if !asgTags.Exists() {
+		return fmt.Errorf("no autoscaling group found in stack %q", stackName)
+	}
+
+	templateTags, _ := asgTags.Value().([]interface{})
+	templateTags, changed := mergeTemplateTags(templateTags, c.autoscalerTags(), func(k, v string) map[string]interface{} {
+		return map[string]interface{}{"Key": k, "Value": v, "PropagateAtLaunch": "true"}
+	})
+	if !changed {
+		logger.Info("autoscaling group of nodegroup %q already has the cluster-autoscaler tags", ngName)
+		return nil
+	}
+	if template, err = sjson.Set(template, nodeGroupASGTagsPath, templateTags); err != nil {
+		return errors.Wrapf(err, "adding cluster-autoscaler tags to the template of stack %q", stackName)
+	}
+	return c.UpdateStack(ctx, UpdateStackOptions{
+		Stack:         stack,
+		ChangeSetName: c.MakeChangeSetName("update-autoscaler-tags"),
+		Description:   fmt.Sprintf("adding cluster-autoscaler tags to the autoscaling group of nodegroup %q", ngName),
+		TemplateData:  TemplateBody(template),
+		Wait:          true,
+	})
+}
+
+// diffASGTags returns the changes that make the current tags of the named autoscaling group match desired, deleting
+// only the tags for which removable returns true; updated tags keep propagating to instances if they did before
+func diffASGTags(asgName string, current []asgtypes.TagDescription, desired map[string]string, removable func(key string) bool) asgTagChanges {
+	currentTags := make(map[string]string, len(current))
+	propagateAtLaunch := make(map[string]bool, len(current))
+	for _, t := range current {
+		currentTags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+		propagateAtLaunch[aws.StringValue(t.Key)] = aws.BoolValue(t.PropagateAtLaunch)
+	}
+	toAdd, toUpdate, toRemove := diffTags(currentTags, desired, removable)
+
+	var changes asgTagChanges
+	for _, tags := range []map[string]string{toAdd, toUpdate} {
+		for _, k := range sortedTagKeys(tags) {
+			changes.upsert = append(changes.upsert, asgtypes.Tag{
+				ResourceId:        aws.String(asgName),
+				ResourceType:      aws.String("auto-scaling-group"),
+				Key:               aws.String(k),
+				Value:             aws.String(tags[k]),
+				PropagateAtLaunch: aws.Bool(propagateAtLaunch[k]),
+			})
+		}
+	}
+	changes.delete = sortedTagKeys(toRemove)
+	return changes
+}
+
+// diffTags compares the current tags of an autoscaling group with the desired ones, and returns the tags to add,
+// the tags to update with a new value, and the tags to remove, which are the undesired tags removable returns true for
+func diffTags(current, desired map[string]string, removable func(key string) bool) (toAdd, toUpdate, toRemove map[string]string) {
+	toAdd, toUpdate, toRemove = make(map[string]string), make(map[string]string), make(map[string]string)
+	for k, v := range desired {
+		currentValue, ok := current[k]
+		switch {
+		case !ok:
+			toAdd[k] = v
+		case currentValue != v:
+			toUpdate[k] = v
+		}
+	}
+	for k, v := range current {
+		if _, ok := desired[k]; !ok && removable(k) {
+			toRemove[k] = v
+		}
+	}
+	return toAdd, toUpdate, toRemove
+}
+
+// nodeTemplateTagPrefix is the prefix of the cluster-autoscaler tags eksctl derives from the labels and taints of
+// a nodegroup
+const nodeTemplateTagPrefix = "k8s.io/cluster-autoscaler/node-template/"
+
+func sortedTagKeys(tags map[string]string) []string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// applyASGTagChanges creates, updates and deletes the tags of the named autoscaling group,
+// at most maxTagsPerAutoScalingGroupCall tags per request
+func (c *StackCollection) applyASGTagChanges(ctx context.Context, asgName string, changes asgTagChanges) error {
+	for i := 0; i < len(changes.upsert); i += maxTagsPerAutoScalingGroupCall {
+		end := i + maxTagsPerAutoScalingGroupCall
+		if end > len(changes.upsert) {
+			end = len(changes.upsert)
+		}
+		if _, err := c.asgAPI.CreateOrUpdateTags(ctx, &autoscaling.CreateOrUpdateTagsInput{
+			Tags: changes.upsert[i:end],
+		}); err != nil {
+			return errors.Wrapf(err, "creating or updating tags of ASG %q", asgName)
+		}
+	}
+
+	for i := 0; i < len(changes.delete); i += maxTagsPerAutoScalingGroupCall {
+		end := i + maxTagsPerAutoScalingGroupCall
+		if end > len(changes.delete) {
+			end = len(changes.delete)
+		}
+		var tags []asgtypes.Tag
+		for _, k := range changes.delete[i:end] {
+			tags = append(tags, asgtypes.Tag{
+				ResourceId:   aws.String(asgName),
+				ResourceType: aws.String("auto-scaling-group"),
+				Key:          aws.String(k),
+			})
+		}
+		if _, err := c.asgAPI.DeleteTags(ctx, &autoscaling.DeleteTagsInput{Tags: tags}); err != nil {
+			return errors.Wrapf(err, "deleting tags of ASG %q", asgName)
+		}
+	}
+
+	logger.Info("reconciled tags of ASG %q: %d created or updated, %d deleted", asgName, len(changes.upsert), len(changes.delete))
+	return nil
+}
+
+// PropagateManagedNodeGroupTagsToVolumes adds the given tags to the EBS volumes of the instances of the given managed
+// nodegroup. The tags are added to the volume tag specification of the launch template in the nodegroup's stack, which
+// is updated so that the volumes of instances launched later are tagged too, and to the volumes attached to the
+// nodegroup's current instances. Launch templates supplied by the user are never modified.
+func (c *StackCollection) PropagateManagedNodeGroupTagsToVolumes(ctx context.Context, ngName string, tags map[string]string) error {
+	stack, err := c.DescribeNodeGroupStack(ctx, ngName)
+	if err != nil {
+		return err
+	}
+	nodeGroupType, err := GetNodeGroupType(stack.Tags)
+	if err != nil {
+		return err
+	}
+	if nodeGroupType != api.NodeGroupTypeManaged {
+		return fmt.Errorf("nodegroup %q is not a managed nodegroup", ngName)
+	}
+	custom, launchTemplateID, err := c.UsesCustomLaunchTemplate(ctx, ngName)
+	if err != nil {
+		return err
+	}
+	if custom {
+		return fmt.Errorf("refusing to modify launch template %q of nodegroup %q as it was not created by eksctl", launchTemplateID, ngName)
+	}
+	if launchTemplateID == "" {
+		return fmt.Errorf("managed nodegroup %q does not use a launch template", ngName)
+	}
+	if err := c.addStackLaunchTemplateVolumeTags(ctx, ngName, stack, tags); err != nil {
+		return err
+	}
+
+	asgNames, err := c.getManagedNodeGroupAutoScalingGroupName(ctx, stack)
+	if err != nil {
+		return err
+	}
+	if asgNames == "" {
+		return nil
+	}
+	var instanceIDs []string
+	for _, name := range strings.Split(asgNames, ",") {
+		asg, err := c.GetAutoScalingGroupDesiredCapacity(ctx, name)
+		if err != nil {
+			return err
+		}
+		for _, i := range asg.Instances {
+			instanceIDs = append(instanceIDs, aws.StringValue(i.InstanceId))
+		}
+	}
+	return c.tagInstanceVolumes(ctx, instanceIDs, tags)
+}
+
+// launchTemplateTagSpecificationsPath is the path of the tag specifications of the launch template eksctl creates in
+// managed nodegroup stacks
+const launchTemplateTagSpecificationsPath = "Resources.LaunchTemplate.Properties.LaunchTemplateData.TagSpecifications"
+
+// addStackLaunchTemplateVolumeTags adds tags to the volume tag specification of the launch template in the given
+// nodegroup stack, and updates the stack unless the volume tags already include them. The update creates a new
+// version of the launch template, which the nodegroup is then updated to
+func (c *StackCollection) addStackLaunchTemplateVolumeTags(ctx context.Context, ngName string, stack *Stack, tags map[string]string) error {
+	stackName := aws.StringValue(stack.StackName)
+	template, err := c.GetStackTemplate(ctx, stackName)
+	if err != nil {
+		return errors.Wrapf(err, "getting template of stack %q", stackName)
+	}
+	tagSpecifications := gjson.Get(template, launchTemplateTagSpecificationsPath)
+	if !tagSpecifications.Exists() {
+		return fmt.Errorf("no launch template found in stack %q", stackName)
+	}
+
+	volumeTagsPath := ""
+	var volumeTags []interface{}
+	for i, ts := range tagSpecifications.Array() {
+		if ts.Get("ResourceType").String() == string(ec2types.ResourceTypeVolume) {
+			volumeTagsPath = fmt.Sprintf("%s.%d.Tags", launchTemplateTagSpecificationsPath, i)
+			volumeTags, _ = ts.Get("Tags").Value().([]interface{})
+			break
+		}
+	}
+
+	volumeTags, changed := mergeTemplateTags(volumeTags, tags, func(k, v string) map[string]interface{} {
+		return map[string]interface{}{"Key": k, "Value": v}
+	})
+	if !changed {
+		logger.Debug("volume tags of the launch template of nodegroup %q are up to date", ngName)
+		return nil
+	}
+
+	if volumeTagsPath == "" {
+		template, err = sjson.Set(template, launchTemplateTagSpecificationsPath+".-1", map[string]interface{}{
+			"ResourceType": string(ec2types.ResourceTypeVolume),
+			"Tags":         volumeTags,
+		})
+	} else {
+		template, err = sjson.Set(template, volumeTagsPath, volumeTags)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "adding volume tags to the template of stack %q", stackName)
+	}
+	return c.UpdateStack(ctx, UpdateStackOptions{
+		Stack:         stack,
+		ChangeSetName: c.MakeChangeSetName("update-volume-tags"),
+		Description:   fmt.Sprintf("adding volume tags to the launch template of nodegroup %q", ngName),
+		TemplateData:  TemplateBody(template),
+		Wait:          true,
+	})
+}
+
+// mergeTemplateTags sets the given tags in the tags of a template resource, updating the value of those already there
+// and appending the others with newTag, and reports whether any tag was changed
+func mergeTemplateTags(templateTags []interface{}, tags map[string]string, newTag func(k, v string) map[string]interface{}) ([]interface{}, bool) {
+	changed := false
+	found := make(map[string]bool, len(tags))
+	for _, t := range templateTags {
+		tag, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key, _ := tag["Key"].(string)
+		if v, ok := tags[key]; ok {
+			found[key] = true
+			if tag["Value"] != v {
+				tag["Value"] = v
+				changed = true
+			}
+		}
+	}
+	for _, k := range sortedTagKeys(tags) {
+		if !found[k] {
+			templateTags = append(templateTags, newTag(k, tags[k]))
+			changed = true
+		}
+	}
+	return templateTags, changed
+}
+
+// tagInstanceVolumes adds tags to the EBS volumes attached to the given instances
+func (c *StackCollection) tagInstanceVolumes(ctx context.Context, instanceIDs []string, tags map[string]string) error {
+	if len(instanceIDs) == 0 || len(tags) == 0 {
+		return nil
+	}
+	var volumeIDs []string
+	paginator := ec2.NewDescribeInstancesPaginator(c.ec2API, &ec2.DescribeInstancesInput{
+		InstanceIds: instanceIDs,
+	})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return errors.Wrap(err, "describing nodegroup instances")
+		}
+		for _, r := range out.Reservations {
+			for _, i := range r.Instances {
+				for _, bdm := range i.BlockDeviceMappings {
+					if bdm.Ebs != nil {
+						volumeIDs = append(volumeIDs, aws.StringValue(bdm.Ebs.VolumeId))
+					}
+				}
+			}
+		}
+	}
+	if len(volumeIDs) == 0 {
+		return nil
+	}
+
+	if _, err := c.ec2API.CreateTags(ctx, &ec2.CreateTagsInput{
+		Resources: volumeIDs,
+		Tags:      makeEC2Tags(tags),
+	}); err != nil {
+		return errors.Wrap(err, "tagging nodegroup volumes")
+	}
+	logger.Info("tagged %d volumes", len(volumeIDs))
+	return nil
+}
+
+// PropagateManagedNodeGroupTagsToSecurityGroups adds the given tags to the remote access security group EKS creates
+// for the given managed nodegroup. Nodegroups without remote access have no such security group, in which case
+// nothing is tagged. An error is returned when the tags would take the security group over the EC2 tag limit.
+func (c *StackCollection) PropagateManagedNodeGroupTagsToSecurityGroups(ctx context.Context, ngName string, tags map[string]string) error {
+	stack, err := c.DescribeNodeGroupStack(ctx, ngName)
+	if err != nil {
+		return err
+	}
+	nodeGroupType, err := GetNodeGroupType(stack.Tags)
+	if err != nil {
+		return err
+	}
+	if nodeGroupType != api.NodeGroupTypeManaged {
+		return fmt.Errorf("nodegroup %q is not a managed nodegroup", ngName)
+	}
+	securityGroupIDs, err := c.getManagedNodeGroupSecurityGroups(NodeGroupStack{NodeGroupName: ngName, Type: nodeGroupType, Stack: stack})
+	if err != nil {
+		return errors.Wrapf(err, "describing managed nodegroup %q", ngName)
+	}
+	if len(securityGroupIDs) == 0 {
+		logger.Debug("managed nodegroup %q has no remote access security group", ngName)
+		return nil
+	}
+
+	out, err := c.ec2API.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		GroupIds: securityGroupIDs,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing security groups of nodegroup %q", ngName)
+	}
+	for _, sg := range out.SecurityGroups {
+		sgTags := make(map[string]string, len(sg.Tags))
+		for _, t := range sg.Tags {
+			sgTags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+		}
+		missing := make(map[string]string)
+		for k, v := range tags {
+			if existing, ok := sgTags[k]; !ok || existing != v {
+				missing[k] = v
+			}
+			sgTags[k] = v
+		}
+		if len(missing) == 0 {
+			logger.Debug("tags of security group %q are up to date", aws.StringValue(sg.GroupId))
+			continue
+		}
+		if len(sgTags) > builder.MaximumTagNumber {
+			return fmt.Errorf("adding %d tags to security group %q of nodegroup %q would exceed the maximum of %d tags", len(missing), aws.StringValue(sg.GroupId), ngName, builder.MaximumTagNumber)
+		}
+		if _, err := c.ec2API.CreateTags(ctx, &ec2.CreateTagsInput{
+			Resources: []string{aws.StringValue(sg.GroupId)},
+			Tags:      makeEC2Tags(missing),
+		}); err != nil {
+			return errors.Wrapf(err, "tagging security group %q of nodegroup %q", aws.StringValue(sg.GroupId), ngName)
+		}
+		logger.Info("tagged security group %q of nodegroup %q", aws.StringValue(sg.GroupId), ngName)
+	}
+	return nil
+}
+
+func makeEC2Tags(tags map[string]string) []ec2types.Tag {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	ec2Tags := make([]ec2types.Tag, 0, len(keys))
+	for _, k := range keys {
+		ec2Tags = append(ec2Tags, ec2types.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+	}
+	return ec2Tags
+}
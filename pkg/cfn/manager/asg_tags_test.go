@@ -0,0 +1,427 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"github.com/tidwall/gjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("ReconcileManagedNodeGroupTagsToASG", func() {
+	var (
+		p   *mockprovider.MockProvider
+		cfg *api.ClusterConfig
+	)
+
+	mockNodeGroupStack := func(ngType api.NodeGroupType) {
+		stackName := aws.String("eksctl-test-cluster-nodegroup-mng")
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+			Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{
+				{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+				{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("mng")},
+				{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(ngType))},
+				{Key: aws.String("team"), Value: aws.String("nodes")},
+			}}},
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+	})
+
+	It("adds missing tags, updates changed ones and deletes the extra ones owned by eksctl", func() {
+		mockNodeGroupStack(api.NodeGroupTypeManaged)
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+			Nodegroup: &eks.Nodegroup{Resources: &eks.NodegroupResources{
+				AutoScalingGroups: []*eks.AutoScalingGroup{{Name: aws.String("asg-1")}},
+			}},
+		}, nil)
+
+		desired := map[string]string{"unchanged": "v", "changed": "new"}
+		for i := 0; i < 30; i++ {
+			desired[fmt.Sprintf("k8s.io/cluster-autoscaler/node-template/label/l%02d", i)] = "v"
+		}
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []string{"asg-1"},
+		}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []asgtypes.AutoScalingGroup{{
+				AutoScalingGroupName: aws.String("asg-1"),
+				Tags: []asgtypes.TagDescription{
+					{Key: aws.String("unchanged"), Value: aws.String("v")},
+					{Key: aws.String("changed"), Value: aws.String("old"), PropagateAtLaunch: aws.Bool(true)},
+					{Key: aws.String("k8s.io/cluster-autoscaler/node-template/label/removed"), Value: aws.String("v")},
+					{Key: aws.String("eks:nodegroup-name"), Value: aws.String("mng")},
+					{Key: aws.String("k8s.io/cluster-autoscaler/enabled"), Value: aws.String("true")},
+					{Key: aws.String("team"), Value: aws.String("nodes")},
+					{Key: aws.String("alpha.eksctl.io/stale"), Value: aws.String("v")},
+					{Key: aws.String("added-by-hand"), Value: aws.String("v")},
+					{Key: aws.String("kubernetes.io/cluster/test-cluster"), Value: aws.String("owned")},
+				},
+			}},
+		}, nil)
+		p.MockASG().On("CreateOrUpdateTags", mock.Anything, mock.Anything).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil)
+		p.MockASG().On("DeleteTags", mock.Anything, mock.Anything).Return(&autoscaling.DeleteTagsOutput{}, nil)
+
+		Expect(NewStackCollection(p, cfg).ReconcileManagedNodeGroupTagsToASG(context.TODO(), "mng", desired)).To(Succeed())
+
+		var (
+			upserted = map[string]asgtypes.Tag{}
+			deleted  []string
+		)
+		for _, call := range p.MockASG().Calls {
+			switch call.Method {
+			case "CreateOrUpdateTags":
+				input := call.Arguments.Get(1).(*autoscaling.CreateOrUpdateTagsInput)
+				Expect(len(input.Tags)).To(BeNumerically("<=", maxTagsPerAutoScalingGroupCall))
+				for _, t := range input.Tags {
+					Expect(*t.ResourceId).To(Equal("asg-1"))
+					upserted[*t.Key] = t
+				}
+			case "DeleteTags":
+				for _, t := range call.Arguments.Get(1).(*autoscaling.DeleteTagsInput).Tags {
+					deleted = append(deleted, *t.Key)
+				}
+			}
+		}
+		p.MockASG().AssertNumberOfCalls(GinkgoT(), "CreateOrUpdateTags", 2)
+
+		Expect(upserted).To(HaveLen(31))
+		Expect(upserted).NotTo(HaveKey("unchanged"))
+		Expect(*upserted["changed"].Value).To(Equal("new"))
+		Expect(*upserted["changed"].PropagateAtLaunch).To(BeTrue())
+		Expect(*upserted["k8s.io/cluster-autoscaler/node-template/label/l00"].PropagateAtLaunch).To(BeFalse())
+		Expect(deleted).To(ConsistOf("k8s.io/cluster-autoscaler/node-template/label/removed", "team", "alpha.eksctl.io/stale"))
+	})
+
+	It("leaves the tags added by hand untouched", func() {
+		mockNodeGroupStack(api.NodeGroupTypeManaged)
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+			Nodegroup: &eks.Nodegroup{Resources: &eks.NodegroupResources{
+				AutoScalingGroups: []*eks.AutoScalingGroup{{Name: aws.String("asg-1")}},
+			}},
+		}, nil)
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []asgtypes.AutoScalingGroup{{
+				AutoScalingGroupName: aws.String("asg-1"),
+				Tags: []asgtypes.TagDescription{
+					{Key: aws.String("team"), Value: aws.String("nodes")},
+					{Key: aws.String("cost-center"), Value: aws.String("1234")},
+				},
+			}},
+		}, nil)
+
+		Expect(NewStackCollection(p, cfg).ReconcileManagedNodeGroupTagsToASG(context.TODO(), "mng", map[string]string{"team": "nodes"})).To(Succeed())
+		p.MockASG().AssertNotCalled(GinkgoT(), "DeleteTags", mock.Anything, mock.Anything)
+	})
+
+	It("refuses to reconcile the tags of an unmanaged nodegroup", func() {
+		mockNodeGroupStack(api.NodeGroupTypeUnmanaged)
+
+		err := NewStackCollection(p, cfg).ReconcileManagedNodeGroupTagsToASG(context.TODO(), "mng", map[string]string{})
+		Expect(err).To(MatchError(`nodegroup "mng" is not a managed nodegroup`))
+		p.MockASG().AssertNotCalled(GinkgoT(), "CreateOrUpdateTags", mock.Anything, mock.Anything)
+	})
+})
+
+var _ = Describe("PropagateManagedNodeGroupTagsToVolumes", func() {
+	var (
+		p   *mockprovider.MockProvider
+		cfg *api.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+
+		stackName := aws.String("eksctl-test-cluster-nodegroup-mng")
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+			Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{
+				{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+				{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("mng")},
+				{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeManaged))},
+			}}},
+		}, nil)
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+			Nodegroup: &eks.Nodegroup{
+				LaunchTemplate: &eks.LaunchTemplateSpecification{Id: aws.String("lt-1"), Version: aws.String("2")},
+				Resources: &eks.NodegroupResources{
+					AutoScalingGroups: []*eks.AutoScalingGroup{{Name: aws.String("asg-1")}},
+				},
+			},
+		}, nil)
+	})
+
+	It("adds the tags to the volume tag specification of the launch template in the stack and to existing volumes", func() {
+		p.MockCloudFormation().On("DescribeStackResources", mock.Anything, mock.Anything).Return(&cfn.DescribeStackResourcesOutput{
+			StackResources: []types.StackResource{{ResourceType: aws.String("AWS::EC2::LaunchTemplate"), PhysicalResourceId: aws.String("lt-1")}},
+		}, nil)
+		p.MockCloudFormation().On("GetTemplate", mock.Anything, mock.Anything).Return(&cfn.GetTemplateOutput{TemplateBody: aws.String(`{
+			"Resources": {"LaunchTemplate": {"Type": "AWS::EC2::LaunchTemplate", "Properties": {"LaunchTemplateData": {"TagSpecifications": [
+				{"ResourceType": "instance", "Tags": [{"Key": "Name", "Value": "mng-Node"}]},
+				{"ResourceType": "volume", "Tags": [{"Key": "Name", "Value": "mng-Node"}, {"Key": "team", "Value": "nodes"}]}
+			]}}}}
+		}`)}, nil)
+		p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(&cfn.CreateChangeSetOutput{}, nil)
+		p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+			StatusReason: aws.String("The submitted information didn't contain changes"),
+		}, nil)
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []asgtypes.AutoScalingGroup{{
+				AutoScalingGroupName: aws.String("asg-1"),
+				Instances:            []asgtypes.Instance{{InstanceId: aws.String("i-1")}},
+			}},
+		}, nil)
+		p.MockEC2().On("DescribeInstances", mock.Anything, &ec2.DescribeInstancesInput{InstanceIds: []string{"i-1"}}, mock.Anything).Return(&ec2.DescribeInstancesOutput{
+			Reservations: []ec2types.Reservation{{Instances: []ec2types.Instance{{
+				BlockDeviceMappings: []ec2types.InstanceBlockDeviceMapping{{Ebs: &ec2types.EbsInstanceBlockDevice{VolumeId: aws.String("vol-1")}}},
+			}}}},
+		}, nil)
+		p.MockEC2().On("CreateTags", mock.Anything, mock.Anything).Return(&ec2.CreateTagsOutput{}, nil)
+
+		tags := map[string]string{"team": "storage", "cost-center": "1234"}
+		Expect(NewStackCollection(p, cfg).PropagateManagedNodeGroupTagsToVolumes(context.TODO(), "mng", tags)).To(Succeed())
+
+		var input *cfn.CreateChangeSetInput
+		for _, call := range p.MockCloudFormation().Calls {
+			if call.Method == "CreateChangeSet" {
+				input = call.Arguments.Get(1).(*cfn.CreateChangeSetInput)
+			}
+		}
+		Expect(input).NotTo(BeNil())
+		tagSpecifications := gjson.Get(*input.TemplateBody, launchTemplateTagSpecificationsPath)
+		Expect(tagSpecifications.Get("0.Tags").Raw).To(MatchJSON(`[{"Key": "Name", "Value": "mng-Node"}]`))
+		Expect(tagSpecifications.Get("1.Tags").Raw).To(MatchJSON(`[
+			{"Key": "Name", "Value": "mng-Node"}, {"Key": "team", "Value": "storage"}, {"Key": "cost-center", "Value": "1234"}
+		]`))
+		p.MockEC2().AssertCalled(GinkgoT(), "CreateTags", mock.Anything, &ec2.CreateTagsInput{
+			Resources: []string{"vol-1"},
+			Tags: []ec2types.Tag{
+				{Key: aws.String("cost-center"), Value: aws.String("1234")},
+				{Key: aws.String("team"), Value: aws.String("storage")},
+			},
+		})
+		p.MockEC2().AssertNotCalled(GinkgoT(), "CreateLaunchTemplateVersion", mock.Anything, mock.Anything)
+	})
+
+	It("refuses to modify a custom launch template", func() {
+		p.MockCloudFormation().On("DescribeStackResources", mock.Anything, mock.Anything).Return(&cfn.DescribeStackResourcesOutput{}, nil)
+
+		err := NewStackCollection(p, cfg).PropagateManagedNodeGroupTagsToVolumes(context.TODO(), "mng", map[string]string{"team": "storage"})
+		Expect(err).To(MatchError(`refusing to modify launch template "lt-1" of nodegroup "mng" as it was not created by eksctl`))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything, mock.Anything)
+		p.MockEC2().AssertNotCalled(GinkgoT(), "CreateTags", mock.Anything, mock.Anything)
+	})
+
+	It("refuses to tag the volumes of an unmanaged nodegroup", func() {
+		stackName := aws.String("eksctl-test-cluster-nodegroup-ng")
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+			Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{
+				{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+				{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng")},
+				{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeUnmanaged))},
+			}}},
+		}, nil)
+
+		err := NewStackCollection(p, cfg).PropagateManagedNodeGroupTagsToVolumes(context.TODO(), "ng", map[string]string{"team": "storage"})
+		Expect(err).To(MatchError(`nodegroup "ng" is not a managed nodegroup`))
+		p.MockEC2().AssertNotCalled(GinkgoT(), "CreateTags", mock.Anything, mock.Anything)
+	})
+})
+
+var _ = Describe("PropagateManagedNodeGroupTagsToSecurityGroups", func() {
+	var (
+		p   *mockprovider.MockProvider
+		cfg *api.ClusterConfig
+	)
+
+	mockRemoteAccessSecurityGroup := func(sg *ec2types.SecurityGroup) {
+		resources := &eks.NodegroupResources{}
+		if sg != nil {
+			resources.RemoteAccessSecurityGroup = sg.GroupId
+			p.MockEC2().On("DescribeSecurityGroups", mock.Anything, &ec2.DescribeSecurityGroupsInput{
+				GroupIds: []string{*sg.GroupId},
+			}).Return(&ec2.DescribeSecurityGroupsOutput{SecurityGroups: []ec2types.SecurityGroup{*sg}}, nil)
+		}
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+			Nodegroup: &eks.Nodegroup{Resources: resources},
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+
+		stackName := aws.String("eksctl-test-cluster-nodegroup-mng")
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+			Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{
+				{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+				{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("mng")},
+				{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeManaged))},
+			}}},
+		}, nil)
+		p.MockEC2().On("CreateTags", mock.Anything, mock.Anything).Return(&ec2.CreateTagsOutput{}, nil)
+	})
+
+	It("adds the missing tags to the remote access security group", func() {
+		mockRemoteAccessSecurityGroup(&ec2types.SecurityGroup{
+			GroupId: aws.String("sg-1"),
+			Tags: []ec2types.Tag{
+				{Key: aws.String("team"), Value: aws.String("nodes")},
+				{Key: aws.String("cost-center"), Value: aws.String("old")},
+			},
+		})
+
+		tags := map[string]string{"team": "nodes", "cost-center": "1234"}
+		Expect(NewStackCollection(p, cfg).PropagateManagedNodeGroupTagsToSecurityGroups(context.TODO(), "mng", tags)).To(Succeed())
+
+		p.MockEC2().AssertCalled(GinkgoT(), "CreateTags", mock.Anything, &ec2.CreateTagsInput{
+			Resources: []string{"sg-1"},
+			Tags:      []ec2types.Tag{{Key: aws.String("cost-center"), Value: aws.String("1234")}},
+		})
+	})
+
+	It("does nothing when the nodegroup has no remote access security group", func() {
+		mockRemoteAccessSecurityGroup(nil)
+
+		Expect(NewStackCollection(p, cfg).PropagateManagedNodeGroupTagsToSecurityGroups(context.TODO(), "mng", map[string]string{"team": "nodes"})).To(Succeed())
+		p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeSecurityGroups", mock.Anything, mock.Anything)
+		p.MockEC2().AssertNotCalled(GinkgoT(), "CreateTags", mock.Anything, mock.Anything)
+	})
+
+	It("refuses to exceed the tag limit of the security group", func() {
+		sg := &ec2types.SecurityGroup{GroupId: aws.String("sg-1")}
+		for i := 0; i < 50; i++ {
+			sg.Tags = append(sg.Tags, ec2types.Tag{Key: aws.String(fmt.Sprintf("tag-%d", i)), Value: aws.String("v")})
+		}
+		mockRemoteAccessSecurityGroup(sg)
+
+		err := NewStackCollection(p, cfg).PropagateManagedNodeGroupTagsToSecurityGroups(context.TODO(), "mng", map[string]string{"team": "nodes"})
+		Expect(err).To(MatchError(`adding 1 tags to security group "sg-1" of nodegroup "mng" would exceed the maximum of 50 tags`))
+		p.MockEC2().AssertNotCalled(GinkgoT(), "CreateTags", mock.Anything, mock.Anything)
+	})
+})
+
+var _ = Describe("Cluster autoscaler tags", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sm StackManager
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		sm = NewStackCollection(p, cfg)
+
+		var summaries []types.StackSummary
+		for _, ngName := range []string{"mng-1", "mng-2"} {
+			stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+			summaries = append(summaries, types.StackSummary{StackName: stackName})
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{
+					{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+					{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeManaged))},
+				}}},
+			}, nil)
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String(ngName),
+			}).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{Resources: &eks.NodegroupResources{
+					AutoScalingGroups: []*eks.AutoScalingGroup{{Name: aws.String(ngName + "-asg")}},
+				}},
+			}, nil)
+		}
+		p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{StackSummaries: summaries}, nil)
+	})
+
+	It("finds the nodegroups whose autoscaling groups lack any of the discovery tags", func() {
+		tags := map[string][]asgtypes.TagDescription{
+			"mng-1-asg": {
+				{ResourceId: aws.String("mng-1-asg"), Key: aws.String("k8s.io/cluster-autoscaler/enabled"), Value: aws.String("true")},
+				{ResourceId: aws.String("mng-1-asg"), Key: aws.String("k8s.io/cluster-autoscaler/test-cluster"), Value: aws.String("owned")},
+			},
+			"mng-2-asg": {
+				{ResourceId: aws.String("mng-2-asg"), Key: aws.String("k8s.io/cluster-autoscaler/enabled"), Value: aws.String("true")},
+			},
+		}
+		for asgName, asgTags := range tags {
+			asgName := asgName
+			p.MockASG().On("DescribeTags", mock.Anything, mock.MatchedBy(func(input *autoscaling.DescribeTagsInput) bool {
+				return input.Filters[0].Values[0] == asgName
+			}), mock.Anything).Return(&autoscaling.DescribeTagsOutput{Tags: asgTags}, nil)
+		}
+
+		nodeGroups, err := sm.FindNodeGroupsMissingAutoscalerTags(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nodeGroups).To(Equal([]string{"mng-2"}))
+	})
+
+	It("adds the discovery tags to the autoscaling groups of a nodegroup", func() {
+		p.MockASG().On("CreateOrUpdateTags", mock.Anything, mock.Anything).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil)
+
+		Expect(sm.AddAutoscalerTags(context.TODO(), "mng-2")).To(Succeed())
+
+		input := p.MockASG().Calls[0].Arguments.Get(1).(*autoscaling.CreateOrUpdateTagsInput)
+		Expect(input.Tags).To(ConsistOf(
+			asgtypes.Tag{ResourceId: aws.String("mng-2-asg"), ResourceType: aws.String("auto-scaling-group"), Key: aws.String("k8s.io/cluster-autoscaler/enabled"), Value: aws.String("true"), PropagateAtLaunch: aws.Bool(true)},
+			asgtypes.Tag{ResourceId: aws.String("mng-2-asg"), ResourceType: aws.String("auto-scaling-group"), Key: aws.String("k8s.io/cluster-autoscaler/test-cluster"), Value: aws.String("owned"), PropagateAtLaunch: aws.Bool(true)},
+		))
+	})
+
+	It("adds the discovery tags to the autoscaling group of an unmanaged nodegroup through its stack", func() {
+		stackName := aws.String("eksctl-test-cluster-nodegroup-ng")
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+			Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{
+				{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+				{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng")},
+				{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeUnmanaged))},
+			}}},
+		}, nil)
+		p.MockCloudFormation().On("GetTemplate", mock.Anything, mock.Anything).Return(&cfn.GetTemplateOutput{TemplateBody: aws.String(`{
+			"Resources": {"NodeGroup": {"Type": "AWS::AutoScaling::AutoScalingGroup", "Properties": {"Tags": [
+				{"Key": "Name", "Value": "test-cluster-ng-Node", "PropagateAtLaunch": "true"},
+				{"Key": "k8s.io/cluster-autoscaler/enabled", "Value": "true", "PropagateAtLaunch": "true"}
+			]}}}
+		}`)}, nil)
+		p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(&cfn.CreateChangeSetOutput{}, nil)
+		p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+			StatusReason: aws.String("The submitted information didn't contain changes"),
+		}, nil)
+
+		Expect(sm.AddAutoscalerTags(context.TODO(), "ng")).To(Succeed())
+
+		var input *cfn.CreateChangeSetInput
+		for _, call := range p.MockCloudFormation().Calls {
+			if call.Method == "CreateChangeSet" {
+				input = call.Arguments.Get(1).(*cfn.CreateChangeSetInput)
+			}
+		}
+		Expect(input).NotTo(BeNil())
+		Expect(gjson.Get(*input.TemplateBody, nodeGroupASGTagsPath).Raw).To(MatchJSON(`[
+			{"Key": "Name", "Value": "test-cluster-ng-Node", "PropagateAtLaunch": "true"},
+			{"Key": "k8s.io/cluster-autoscaler/enabled", "Value": "true", "PropagateAtLaunch": "true"},
+			{"Key": "k8s.io/cluster-autoscaler/test-cluster", "Value": "owned", "PropagateAtLaunch": "true"}
+		]`))
+		p.MockASG().AssertNotCalled(GinkgoT(), "CreateOrUpdateTags", mock.Anything, mock.Anything)
+	})
+})
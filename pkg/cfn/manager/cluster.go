@@ -3,10 +3,14 @@ package manager
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
@@ -27,7 +31,7 @@ func (c *StackCollection) MakeClusterStackName() string {
 }
 
 func (c *StackCollection) MakeClusterStackNameFromName(name string) string {
-	return "eksctl-" + name + "-cluster"
+	return c.stackNamer.ClusterStackName(name)
 }
 
 // createClusterTask creates the cluster
@@ -41,8 +45,107 @@ func (c *StackCollection) createClusterTask(ctx context.Context, errs chan error
 	return c.createClusterStack(ctx, name, stack, errs)
 }
 
-// DescribeClusterStack calls DescribeStacks and filters out cluster stack
+// clusterStackCache memoizes the cluster stack, once found, for the lifetime of a StackCollection
+type clusterStackCache struct {
+	mu    sync.Mutex
+	stack *Stack
+	valid bool
+}
+
+// DescribeClusterStack calls DescribeStacks and filters out cluster stack; once found, the stack is cached
+// until the cluster stack is created or deleted through this StackCollection, or RefreshClusterStackCache is called.
+// The status and outputs of the returned stack may therefore be stale; callers that depend on them should call
+// RefreshClusterStackCache first. A missing cluster stack is not cached, so that it is found once created elsewhere
 func (c *StackCollection) DescribeClusterStack(ctx context.Context) (*Stack, error) {
+	c.clusterStackCache.mu.Lock()
+	defer c.clusterStackCache.mu.Unlock()
+
+	if c.clusterStackCache.valid {
+		return c.clusterStackCache.stack, nil
+	}
+	stack, err := c.describeClusterStack(ctx)
+	if err != nil || stack == nil {
+		return stack, err
+	}
+	c.clusterStackCache.stack = stack
+	c.clusterStackCache.valid = true
+	return stack, nil
+}
+
+// ClusterVPCInfo describes the VPC of a cluster as recorded in the outputs of its stack
+type ClusterVPCInfo struct {
+	VPC                     string   `cfn:"VPC"`
+	PrivateSubnets          []string `cfn:"SubnetsPrivate,optional"`
+	PublicSubnets           []string `cfn:"SubnetsPublic,optional"`
+	SharedNodeSecurityGroup string   `cfn:"SharedNodeSecurityGroup"`
+}
+
+// GetClusterVPCConfig returns the VPC, subnets and shared node security group of the cluster from the outputs of
+// its stack. Public subnets are read from the legacy Subnets output of cluster stacks that predate SubnetsPublic
+func (c *StackCollection) GetClusterVPCConfig(ctx context.Context) (*ClusterVPCInfo, error) {
+	stack, err := c.DescribeClusterStack(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if stack == nil {
+		return nil, fmt.Errorf("no cluster stack found for cluster %q", c.spec.Metadata.Name)
+	}
+
+	info := &ClusterVPCInfo{}
+	if err := c.CollectStackOutputs(stack, info); err != nil {
+		return nil, errors.Wrapf(err, "cluster stack %q predates the outputs describing its VPC", *stack.StackName)
+	}
+	if !outputs.Exists(*stack, outputs.ClusterSubnetsPublic) {
+		var legacy struct {
+			PublicSubnets []string `cfn:"Subnets,optional"`
+		}
+		if err := c.CollectStackOutputs(stack, &legacy); err != nil {
+			return nil, err
+		}
+		info.PublicSubnets = legacy.PublicSubnets
+	}
+	return info, nil
+}
+
+// DescribeAllClusterStacks calls DescribeStacks and classifies the stacks of the cluster into the cluster stack,
+// nodegroup stacks and all other stacks, e.g. those of addons, Fargate profiles and IAM service accounts.
+// Stacks that are not tagged with the name of the cluster are left out.
+func (c *StackCollection) DescribeAllClusterStacks(ctx context.Context) (cluster *Stack, nodegroups []*Stack, others []*Stack, err error) {
+	stacks, err := c.DescribeStacks(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	clusterName := c.spec.Metadata.Name
+	for _, s := range stacks {
+		if s.StackStatus == types.StackStatusDeleteComplete {
+			continue
+		}
+		switch {
+		case cluster == nil && (*s.StackName == c.MakeClusterStackName() || getClusterName(s) == clusterName):
+			cluster = s
+		case !matchesCluster(clusterName, s.Tags):
+			logger.Debug("skipping stack %q as it is not tagged with cluster %q", *s.StackName, clusterName)
+		case GetNodegroupTagName(s.Tags) != "":
+			nodegroups = append(nodegroups, s)
+		default:
+			others = append(others, s)
+		}
+	}
+	return cluster, nodegroups, others, nil
+}
+
+// RefreshClusterStackCache discards the cached cluster stack, so that the next call
+// to DescribeClusterStack fetches it again
+func (c *StackCollection) RefreshClusterStackCache() {
+	c.clusterStackCache.mu.Lock()
+	defer c.clusterStackCache.mu.Unlock()
+
+	c.clusterStackCache.stack = nil
+	c.clusterStackCache.valid = false
+}
+
+func (c *StackCollection) describeClusterStack(ctx context.Context) (*Stack, error) {
 	stacks, err := c.DescribeStacks(ctx)
 	if err != nil {
 		return nil, err
@@ -56,7 +159,7 @@ func (c *StackCollection) DescribeClusterStack(ctx context.Context) (*Stack, err
 		if s.StackStatus == types.StackStatusDeleteComplete {
 			continue
 		}
-		if getClusterName(s) != "" {
+		if c.isClusterStack(s) {
 			return s, nil
 		}
 	}
@@ -205,6 +308,135 @@ func (c *StackCollection) AppendNewClusterStackResource(ctx context.Context, pla
 	})
 }
 
+// AddClusterStackTags adds the given tags to the cluster stack, preserving its existing tags.
+// The stack is updated with its previous template and parameters, so that only the tags change.
+func (c *StackCollection) AddClusterStackTags(ctx context.Context, tags map[string]string) error {
+	stack, err := c.DescribeClusterStack(ctx)
+	if err != nil {
+		return err
+	}
+	if stack == nil {
+		return &StackNotFoundErr{ClusterName: c.spec.Metadata.Name}
+	}
+
+	logger.Info("adding tags to stack %q", *stack.StackName)
+	updated, err := c.doUpdateStackTags(ctx, stack, c.mergeStackTags(stack, tags))
+	if updated {
+		c.RefreshClusterStackCache()
+	}
+	if err == nil && !updated {
+		logger.Info("stack %q already has the given tags", *stack.StackName)
+	}
+	return err
+}
+
+// eksctlTagPrefixes are the prefixes of the keys of the stack tags set by eksctl
+var eksctlTagPrefixes = []string{"alpha.eksctl.io/", "eksctl.io/"}
+
+func isEksctlTag(key string) bool {
+	for _, prefix := range eksctlTagPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyTagsToAllClusterStacks adds the given tags to the cluster stack and all nodegroup stacks, preserving their
+// existing tags. Like AddClusterStackTags, only the tags of the stacks change. Stacks are updated concurrently, with
+// at most defaultUpdateConcurrency updates in flight; a failure to tag one stack, including one that is not in an
+// updatable state, does not prevent tagging the others, and all failures are reported in the returned error.
+// Tags set by eksctl itself can't be overridden.
+func (c *StackCollection) ApplyTagsToAllClusterStacks(ctx context.Context, tags map[string]string) error {
+	for k := range tags {
+		if isEksctlTag(k) {
+			return fmt.Errorf("cannot set tag %q on the stacks of cluster %q as it is managed by eksctl", k, c.spec.Metadata.Name)
+		}
+	}
+	cluster, nodegroups, _, err := c.DescribeAllClusterStacks(ctx)
+	if err != nil {
+		return err
+	}
+	if cluster == nil {
+		return &StackNotFoundErr{ClusterName: c.spec.Metadata.Name}
+	}
+
+	var (
+		stacks = append([]*Stack{cluster}, nodegroups...)
+		errs   = make([]error, len(stacks))
+	)
+	err = forEachConcurrently(ctx, len(stacks), defaultUpdateConcurrency, func(i int) {
+		s := stacks[i]
+		if !isUpdatableStackStatus(s.StackStatus) {
+			errs[i] = fmt.Errorf("cannot tag stack %q as its status is %s", *s.StackName, s.StackStatus)
+			return
+		}
+		logger.Info("adding tags to stack %q", *s.StackName)
+		updated, err := c.doUpdateStackTags(ctx, s, c.mergeStackTags(s, tags))
+		if err != nil {
+			errs[i] = errors.Wrapf(err, "tagging stack %q", *s.StackName)
+			return
+		}
+		if !updated {
+			logger.Info("stack %q already has the given tags", *s.StackName)
+		}
+	})
+	c.RefreshClusterStackCache()
+	if err != nil {
+		return err
+	}
+	return combineErrors(errs)
+}
+
+// mergeStackTags returns the tags of the given stack with tags added, keeping the cluster name tag
+func (c *StackCollection) mergeStackTags(stack *Stack, tags map[string]string) map[string]string {
+	merged := make(map[string]string)
+	for _, t := range stack.Tags {
+		merged[*t.Key] = *t.Value
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	merged[api.ClusterNameTag] = c.spec.Metadata.Name
+	return merged
+}
+
+// doUpdateStackTags updates the stack with its previous template and parameters, so that only its tags
+// are replaced with the given ones, and waits for the update to complete; it returns false when the stack
+// already had exactly these tags and no update was performed
+func (c *StackCollection) doUpdateStackTags(ctx context.Context, stack *Stack, tags map[string]string) (bool, error) {
+	input := &cloudformation.UpdateStackInput{
+		StackName:           stack.StackName,
+		UsePreviousTemplate: aws.Bool(true),
+		Capabilities:        stack.Capabilities,
+	}
+	for _, p := range stack.Parameters {
+		input.Parameters = append(input.Parameters, types.Parameter{
+			ParameterKey:     p.ParameterKey,
+			UsePreviousValue: aws.Bool(true),
+		})
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		input.Tags = append(input.Tags, newTag(k, tags[k]))
+	}
+	if cfnRole := c.roleARN; cfnRole != "" {
+		input.RoleARN = &cfnRole
+	}
+
+	if _, err := c.cloudformationAPI.UpdateStack(ctx, input); err != nil {
+		if strings.Contains(err.Error(), "No updates are to be performed") {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "updating tags of stack %q", *stack.StackName)
+	}
+	return true, c.doWaitUntilStackIsUpdated(ctx, stack, nil, 0, 0)
+}
+
 func (c *StackCollection) importServiceRoleARN(ctx context.Context, resources gjson.Result) error {
 	s, err := c.DescribeClusterStack(ctx)
 	if err != nil {
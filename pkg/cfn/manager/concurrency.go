@@ -0,0 +1,32 @@
+package manager
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sync/semaphore"
+)
+
+// forEachConcurrently calls fn with each index in [0, n), with at most concurrency calls running at a time. It
+// always waits for the calls it started to return; when ctx is done before all calls were started, the remaining
+// ones are skipped and an error is returned
+func forEachConcurrently(ctx context.Context, n, concurrency int, fn func(i int)) error {
+	var (
+		wg  sync.WaitGroup
+		sem = semaphore.NewWeighted(int64(concurrency))
+	)
+	defer wg.Wait()
+	for i := 0; i < n; i++ {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return errors.Wrap(err, "failed to acquire semaphore")
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer sem.Release(1)
+			fn(i)
+		}(i)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package manager
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("forEachConcurrently", func() {
+	It("calls fn with every index, with at most the given number of calls in flight", func() {
+		var (
+			calls    = make([]int32, 10)
+			inFlight int32
+			maxSeen  int32
+		)
+		err := forEachConcurrently(context.TODO(), len(calls), 3, func(i int) {
+			n := atomic.AddInt32(&inFlight, 1)
+			for {
+				seen := atomic.LoadInt32(&maxSeen)
+				if n <= seen || atomic.CompareAndSwapInt32(&maxSeen, seen, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&calls[i], 1)
+			atomic.AddInt32(&inFlight, -1)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		for _, c := range calls {
+			Expect(c).To(Equal(int32(1)))
+		}
+		Expect(maxSeen).To(BeNumerically("<=", 3))
+	})
+
+	It("waits for the calls in flight to return when the context is cancelled", func() {
+		ctx, cancel := context.WithCancel(context.TODO())
+		var started, finished int32
+		err := forEachConcurrently(ctx, 10, 1, func(int) {
+			atomic.AddInt32(&started, 1)
+			cancel()
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&finished, 1)
+		})
+		Expect(err).To(MatchError(ContainSubstring("failed to acquire semaphore")))
+		Expect(atomic.LoadInt32(&finished)).To(Equal(atomic.LoadInt32(&started)))
+		Expect(started).To(BeNumerically("<", 10))
+	})
+})
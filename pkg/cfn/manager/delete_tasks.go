@@ -25,7 +25,7 @@ func deleteAll(_ string) bool { return true }
 func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(ctx context.Context, clusterStack *Stack, nodeGroupStacks []NodeGroupStack, deleteOIDCProvider bool, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter, wait bool, cleanup func(chan error, string) error) (*tasks.TaskTree, error) {
 	taskTree := &tasks.TaskTree{Parallel: false}
 
-	nodeGroupTasks, err := c.NewTasksToDeleteNodeGroups(nodeGroupStacks, deleteAll, true, cleanup)
+	nodeGroupTasks, err := c.NewTasksToDeleteNodeGroups(ctx, nodeGroupStacks, deleteAll, true, cleanup)
 
 	if err != nil {
 		return nil, err
@@ -65,12 +65,14 @@ func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(ctx context.Cont
 	if wait {
 		taskTree.Append(&taskWithStackSpec{
 			info:  info,
+			ctx:   ctx,
 			stack: clusterStack,
 			call:  c.DeleteStackBySpecSync,
 		})
 	} else {
 		taskTree.Append(&asyncTaskWithStackSpec{
 			info:  info,
+			ctx:   ctx,
 			stack: clusterStack,
 			call:  c.DeleteStackBySpec,
 		})
@@ -80,7 +82,7 @@ func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(ctx context.Cont
 }
 
 // NewTasksToDeleteNodeGroups defines tasks required to delete all of the nodegroups
-func (c *StackCollection) NewTasksToDeleteNodeGroups(nodeGroupStacks []NodeGroupStack, shouldDelete func(string) bool, wait bool, cleanup func(chan error, string) error) (*tasks.TaskTree, error) {
+func (c *StackCollection) NewTasksToDeleteNodeGroups(ctx context.Context, nodeGroupStacks []NodeGroupStack, shouldDelete func(string) bool, wait bool, cleanup func(chan error, string) error) (*tasks.TaskTree, error) {
 	taskTree := &tasks.TaskTree{Parallel: true}
 
 	for _, s := range nodeGroupStacks {
@@ -99,12 +101,14 @@ func (c *StackCollection) NewTasksToDeleteNodeGroups(nodeGroupStacks []NodeGroup
 		if wait {
 			taskTree.Append(&taskWithStackSpec{
 				info:  info,
+				ctx:   ctx,
 				stack: s.Stack,
 				call:  c.DeleteStackBySpecSync,
 			})
 		} else {
 			taskTree.Append(&asyncTaskWithStackSpec{
 				info:  info,
+				ctx:   ctx,
 				stack: s.Stack,
 				call:  c.DeleteStackBySpec,
 			})
@@ -245,12 +249,14 @@ func (c *StackCollection) NewTasksToDeleteIAMServiceAccounts(ctx context.Context
 			if wait {
 				saTasks.Append(&taskWithStackSpec{
 					info:  info,
+					ctx:   ctx,
 					stack: s,
 					call:  c.DeleteStackBySpecSync,
 				})
 			} else {
 				saTasks.Append(&asyncTaskWithStackSpec{
 					info:  info,
+					ctx:   ctx,
 					stack: s,
 					call:  c.DeleteStackBySpec,
 				})
@@ -299,12 +305,14 @@ func (c *StackCollection) NewTaskToDeleteAddonIAM(ctx context.Context, wait bool
 		if wait {
 			deleteStackTasks.Append(&taskWithStackSpec{
 				info:  info,
+				ctx:   ctx,
 				stack: s,
 				call:  c.DeleteStackBySpecSync,
 			})
 		} else {
 			deleteStackTasks.Append(&asyncTaskWithStackSpec{
 				info:  info,
+				ctx:   ctx,
 				stack: s,
 				call:  c.DeleteStackBySpec,
 			})
@@ -89,6 +89,7 @@ func (c *StackCollection) DeleteTasksForDeprecatedStacks(ctx context.Context) (*
 					taskTree.Append(deleteControlPlaneTask)
 				} else {
 					taskTree.Append(&taskWithStackSpec{
+						ctx:   ctx,
 						stack: s,
 						call:  c.DeleteStackBySpecSync,
 					})
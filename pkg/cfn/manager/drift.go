@@ -0,0 +1,237 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// DriftResult holds the outcome of a drift detection operation on a stack
+type DriftResult struct {
+	StackName   string
+	DriftStatus types.StackDriftStatus
+	Resources   []ResourceDrift
+}
+
+// ResourceDrift describes the drift status of a single stack resource
+type ResourceDrift struct {
+	LogicalResourceID   string
+	ResourceType        string
+	DriftStatus         types.StackResourceDriftStatus
+	PropertyDifferences []types.PropertyDifference
+}
+
+// DetectStackDrift starts a drift detection operation on the given stack, waits for it
+// to complete and returns the drift status of each resource that was checked
+func (c *StackCollection) DetectStackDrift(ctx context.Context, stackName string) (*DriftResult, error) {
+	out, err := c.cloudformationAPI.DetectStackDrift(ctx, &cloudformation.DetectStackDriftInput{
+		StackName: &stackName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "detecting drift for CloudFormation stack %q", stackName)
+	}
+
+	logger.Info("waiting for drift detection on stack %q", stackName)
+	status, err := c.waitForStackDriftDetection(ctx, stackName, *out.StackDriftDetectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DriftResult{
+		StackName:   stackName,
+		DriftStatus: status.StackDriftStatus,
+	}
+
+	paginator := cloudformation.NewDescribeStackResourceDriftsPaginator(c.cloudformationAPI, &cloudformation.DescribeStackResourceDriftsInput{
+		StackName: &stackName,
+		StackResourceDriftStatusFilters: []types.StackResourceDriftStatus{
+			types.StackResourceDriftStatusInSync,
+			types.StackResourceDriftStatusModified,
+			types.StackResourceDriftStatusDeleted,
+		},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing resource drifts for CloudFormation stack %q", stackName)
+		}
+		for _, d := range page.StackResourceDrifts {
+			result.Resources = append(result.Resources, makeResourceDrift(d))
+		}
+	}
+
+	return result, nil
+}
+
+// DetectResourceDrift checks a single resource of the given stack for drift, which is faster than
+// detecting drift on the whole stack when only one resource is of interest
+func (c *StackCollection) DetectResourceDrift(ctx context.Context, stackName, logicalID string) (*ResourceDrift, error) {
+	out, err := c.cloudformationAPI.DetectStackResourceDrift(ctx, &cloudformation.DetectStackResourceDriftInput{
+		StackName:         &stackName,
+		LogicalResourceId: &logicalID,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "detecting drift for resource %q of CloudFormation stack %q", logicalID, stackName)
+	}
+	drift := makeResourceDrift(*out.StackResourceDrift)
+	return &drift, nil
+}
+
+func (c *StackCollection) waitForStackDriftDetection(ctx context.Context, stackName, detectionID string) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	var status *cloudformation.DescribeStackDriftDetectionStatusOutput
+	w := &waiter.Waiter{
+		NextDelay: func(attempts int) time.Duration {
+			if attempts == 1 {
+				return 0
+			}
+			return 5 * time.Second
+		},
+		Operation: func() (bool, error) {
+			out, err := c.cloudformationAPI.DescribeStackDriftDetectionStatus(ctx, &cloudformation.DescribeStackDriftDetectionStatusInput{
+				StackDriftDetectionId: &detectionID,
+			})
+			if err != nil {
+				return false, errors.Wrapf(err, "describing drift detection status for CloudFormation stack %q", stackName)
+			}
+			status = out
+			switch out.DetectionStatus {
+			case types.StackDriftDetectionStatusDetectionComplete:
+				return true, nil
+			case types.StackDriftDetectionStatusDetectionFailed:
+				return false, fmt.Errorf("drift detection failed for CloudFormation stack %q: %s", stackName, aws.StringValue(out.DetectionStatusReason))
+			default:
+				return false, nil
+			}
+		},
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, c.waitTimeout)
+	defer cancel()
+	if err := w.Wait(timeoutCtx); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, fmt.Errorf("drift detection for CloudFormation stack %q is still in progress after %v", stackName, c.waitTimeout)
+		}
+		return nil, err
+	}
+	return status, nil
+}
+
+// NodeGroupTemplateDrift compares the template the stack of the given unmanaged nodegroup was deployed with to the
+// template eksctl generates for it now, and reports whether they differ along with a unified diff of the two. Both
+// templates are normalized before comparing them, so that differences in formatting, key order or tag order
+// aren't reported. Unlike DetectStackDrift, this surfaces changes in what eksctl generates rather than changes made
+// to the deployed resources.
+func (c *StackCollection) NodeGroupTemplateDrift(ctx context.Context, ng *api.NodeGroup) (bool, string, error) {
+	bootstrapper, err := nodebootstrap.NewBootstrapper(c.spec, ng)
+	if err != nil {
+		return false, "", errors.Wrap(err, "error creating bootstrapper")
+	}
+	resourceSet := builder.NewNodeGroupResourceSet(c.ec2API, c.iamAPI, c.spec, ng, bootstrapper, false, vpc.NewStackConfigImporter(c.MakeClusterStackName()))
+	if err := resourceSet.AddAllResources(ctx); err != nil {
+		return false, "", err
+	}
+	generated, err := resourceSet.RenderJSON()
+	if err != nil {
+		return false, "", errors.Wrapf(err, "rendering template of nodegroup %q", ng.Name)
+	}
+
+	deployed, err := c.GetNodeGroupStackTemplate(ctx, ng.Name)
+	if err != nil {
+		return false, "", err
+	}
+
+	deployedTemplate, err := normalizeTemplate([]byte(deployed))
+	if err != nil {
+		return false, "", errors.Wrapf(err, "normalizing deployed template of nodegroup %q", ng.Name)
+	}
+	generatedTemplate, err := normalizeTemplate(generated)
+	if err != nil {
+		return false, "", errors.Wrapf(err, "normalizing generated template of nodegroup %q", ng.Name)
+	}
+	if deployedTemplate == generatedTemplate {
+		return false, "", nil
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(deployedTemplate),
+		B:        difflib.SplitLines(generatedTemplate),
+		FromFile: "deployed",
+		ToFile:   "generated",
+		Context:  3,
+	})
+	if err != nil {
+		return false, "", errors.Wrapf(err, "diffing templates of nodegroup %q", ng.Name)
+	}
+	return true, diff, nil
+}
+
+// normalizeTemplate re-encodes the given JSON or YAML template as indented JSON with sorted keys, sorting lists of
+// tags by key as their order is not significant
+func normalizeTemplate(template []byte) (string, error) {
+	var doc interface{}
+	if err := yaml.Unmarshal(template, &doc); err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(sortTagLists(doc), "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
+
+func sortTagLists(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = sortTagLists(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = sortTagLists(e)
+		}
+		if isTagList(v) {
+			sort.SliceStable(v, func(i, j int) bool {
+				return v[i].(map[string]interface{})["Key"].(string) < v[j].(map[string]interface{})["Key"].(string)
+			})
+		}
+	}
+	return v
+}
+
+func isTagList(list []interface{}) bool {
+	for _, e := range list {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if _, ok := m["Key"].(string); !ok {
+			return false
+		}
+	}
+	return len(list) > 0
+}
+
+func makeResourceDrift(d types.StackResourceDrift) ResourceDrift {
+	return ResourceDrift{
+		LogicalResourceID:   aws.StringValue(d.LogicalResourceId),
+		ResourceType:        aws.StringValue(d.ResourceType),
+		DriftStatus:         d.StackResourceDriftStatus,
+		PropertyDifferences: d.PropertyDifferences,
+	}
+}
@@ -0,0 +1,188 @@
+package manager
+
+import (
+	"context"
+	"strings"
+
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+var _ = Describe("StackCollection Drift", func() {
+	const stackName = "eksctl-cluster-nodegroup-ng-1"
+
+	var (
+		p  *mockprovider.MockProvider
+		sm StackManager
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		p.MockCloudFormation().On("DetectStackDrift", mock.Anything, &cfn.DetectStackDriftInput{StackName: aws.String(stackName)}).
+			Return(&cfn.DetectStackDriftOutput{StackDriftDetectionId: aws.String("detection-id")}, nil)
+		sm = NewStackCollection(p, api.NewClusterConfig())
+	})
+
+	Context("DetectStackDrift", func() {
+		It("returns the drift status of each resource", func() {
+			p.MockCloudFormation().On("DescribeStackDriftDetectionStatus", mock.Anything, mock.Anything).
+				Return(&cfn.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus:  types.StackDriftDetectionStatusDetectionComplete,
+					StackDriftStatus: types.StackDriftStatusDrifted,
+				}, nil)
+			p.MockCloudFormation().On("DescribeStackResourceDrifts", mock.Anything, mock.Anything).
+				Return(&cfn.DescribeStackResourceDriftsOutput{
+					StackResourceDrifts: []types.StackResourceDrift{
+						{
+							LogicalResourceId:        aws.String("SG"),
+							ResourceType:             aws.String("AWS::EC2::SecurityGroup"),
+							StackResourceDriftStatus: types.StackResourceDriftStatusModified,
+							PropertyDifferences: []types.PropertyDifference{{
+								PropertyPath:   aws.String("/GroupDescription"),
+								ExpectedValue:  aws.String("old"),
+								ActualValue:    aws.String("new"),
+								DifferenceType: types.DifferenceTypeNotEqual,
+							}},
+						},
+						{
+							LogicalResourceId:        aws.String("NodeGroup"),
+							ResourceType:             aws.String("AWS::AutoScaling::AutoScalingGroup"),
+							StackResourceDriftStatus: types.StackResourceDriftStatusInSync,
+						},
+					},
+				}, nil)
+
+			result, err := sm.DetectStackDrift(context.TODO(), stackName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.DriftStatus).To(Equal(types.StackDriftStatusDrifted))
+			Expect(result.Resources).To(HaveLen(2))
+			Expect(result.Resources[0].LogicalResourceID).To(Equal("SG"))
+			Expect(result.Resources[0].DriftStatus).To(Equal(types.StackResourceDriftStatusModified))
+			Expect(result.Resources[0].PropertyDifferences).To(HaveLen(1))
+			Expect(result.Resources[1].DriftStatus).To(Equal(types.StackResourceDriftStatusInSync))
+		})
+
+		It("returns an error when drift detection fails", func() {
+			p.MockCloudFormation().On("DescribeStackDriftDetectionStatus", mock.Anything, mock.Anything).
+				Return(&cfn.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus:       types.StackDriftDetectionStatusDetectionFailed,
+					DetectionStatusReason: aws.String("access denied"),
+				}, nil)
+
+			_, err := sm.DetectStackDrift(context.TODO(), stackName)
+			Expect(err).To(MatchError(ContainSubstring("access denied")))
+		})
+	})
+
+	Context("DetectResourceDrift", func() {
+		It("returns the drift status and property differences of the resource", func() {
+			p.MockCloudFormation().On("DetectStackResourceDrift", mock.Anything, &cfn.DetectStackResourceDriftInput{
+				StackName:         aws.String(stackName),
+				LogicalResourceId: aws.String("NodeGroup"),
+			}).Return(&cfn.DetectStackResourceDriftOutput{
+				StackResourceDrift: &types.StackResourceDrift{
+					LogicalResourceId:        aws.String("NodeGroup"),
+					ResourceType:             aws.String("AWS::AutoScaling::AutoScalingGroup"),
+					StackResourceDriftStatus: types.StackResourceDriftStatusModified,
+					PropertyDifferences: []types.PropertyDifference{{
+						PropertyPath:   aws.String("/MaxSize"),
+						ExpectedValue:  aws.String("3"),
+						ActualValue:    aws.String("5"),
+						DifferenceType: types.DifferenceTypeNotEqual,
+					}},
+				},
+			}, nil)
+
+			drift, err := sm.DetectResourceDrift(context.TODO(), stackName, "NodeGroup")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift.LogicalResourceID).To(Equal("NodeGroup"))
+			Expect(drift.ResourceType).To(Equal("AWS::AutoScaling::AutoScalingGroup"))
+			Expect(drift.DriftStatus).To(Equal(types.StackResourceDriftStatusModified))
+			Expect(drift.PropertyDifferences).To(HaveLen(1))
+			Expect(*drift.PropertyDifferences[0].PropertyPath).To(Equal("/MaxSize"))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DetectStackDrift", mock.Anything, mock.Anything)
+		})
+	})
+
+	Context("NodeGroupTemplateDrift", func() {
+		var sc *StackCollection
+
+		newNodeGroup := func() *api.NodeGroup {
+			ng := sc.spec.NewNodeGroup()
+			ng.Name = "ng-1"
+			ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+			ng.AMI = "ami-123"
+			ng.InstanceType = "m5.large"
+			ng.VolumeType = aws.String(api.NodeVolumeTypeGP2)
+			ng.VolumeName = aws.String("/dev/xvda")
+			ng.VolumeEncrypted = api.Disabled()
+			ng.Labels = map[string]string{"role": "worker", "team": "a"}
+			return ng
+		}
+
+		renderTemplate := func() string {
+			ng := newNodeGroup()
+			bootstrapper, err := nodebootstrap.NewBootstrapper(sc.spec, ng)
+			Expect(err).NotTo(HaveOccurred())
+			resourceSet := builder.NewNodeGroupResourceSet(sc.ec2API, sc.iamAPI, sc.spec, ng, bootstrapper, false, vpc.NewStackConfigImporter(sc.MakeClusterStackName()))
+			Expect(resourceSet.AddAllResources(context.TODO())).To(Succeed())
+			template, err := resourceSet.RenderJSON()
+			Expect(err).NotTo(HaveOccurred())
+			return string(template)
+		}
+
+		mockDeployedTemplate := func(template string) {
+			p.MockCloudFormation().On("GetTemplate", mock.Anything, &cfn.GetTemplateInput{
+				StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+			}).Return(&cfn.GetTemplateOutput{TemplateBody: aws.String(template)}, nil)
+		}
+
+		BeforeEach(func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			cfg.Status = &api.ClusterStatus{Endpoint: "https://test-cluster.example.com", CertificateAuthorityData: []byte("CA")}
+			sc = NewStackCollection(p, cfg).(*StackCollection)
+		})
+
+		It("reports no drift when the templates only differ in format", func() {
+			deployed, err := yaml.JSONToYAML([]byte(renderTemplate()))
+			Expect(err).NotTo(HaveOccurred())
+			mockDeployedTemplate(string(deployed))
+
+			drifted, diff, err := sc.NodeGroupTemplateDrift(context.TODO(), newNodeGroup())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifted).To(BeFalse())
+			Expect(diff).To(BeEmpty())
+		})
+
+		It("returns a diff of the deployed and generated templates", func() {
+			mockDeployedTemplate(strings.Replace(renderTemplate(), `"m5.large"`, `"m5.xlarge"`, 1))
+
+			drifted, diff, err := sc.NodeGroupTemplateDrift(context.TODO(), newNodeGroup())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifted).To(BeTrue())
+			Expect(diff).To(HavePrefix("--- deployed\n+++ generated\n"))
+			Expect(diff).To(MatchRegexp(`(?m)^-\s+"InstanceType": "m5.xlarge"`))
+			Expect(diff).To(MatchRegexp(`(?m)^\+\s+"InstanceType": "m5.large"`))
+		})
+
+		It("ignores the order of tags", func() {
+			a, err := normalizeTemplate([]byte(`{"Tags": [{"Key": "a", "Value": "1"}, {"Key": "b", "Value": "2"}]}`))
+			Expect(err).NotTo(HaveOccurred())
+			b, err := normalizeTemplate([]byte(`{"Tags": [{"Value": "2", "Key": "b"}, {"Key": "a", "Value": "1"}]}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(a).To(Equal(b))
+		})
+	})
+})
@@ -1,6 +1,27 @@
 package manager
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrEksctlManagedLaunchTemplate is returned by GetManagedNodeGroupLaunchTemplate when the
+// nodegroup's launch template was created by eksctl rather than supplied by the user
+var ErrEksctlManagedLaunchTemplate = errors.New("nodegroup uses a launch template managed by eksctl")
+
+// ErrPreExistingInstanceRole is returned by GetNodeGroupInstanceRoleARN when the nodegroup uses
+// an instance role that was supplied by the user rather than created by eksctl
+var ErrPreExistingInstanceRole = errors.New("nodegroup uses a pre-existing instance role")
+
+// ErrStackResourceNotFound is returned by GetStackPhysicalResourceID when the stack has no resource
+// with the given logical id
+var ErrStackResourceNotFound = errors.New("stack resource not found")
+
+// ErrUnknownNodeGroupVersion is returned by GetNodeGroupKubernetesVersion when the Kubernetes version
+// of the nodegroup can't be determined
+var ErrUnknownNodeGroupVersion = errors.New("unknown Kubernetes version")
 
 type StackNotFoundErr struct {
 	ClusterName string
@@ -9,3 +30,25 @@ type StackNotFoundErr struct {
 func (e *StackNotFoundErr) Error() string {
 	return fmt.Sprintf("no eksctl-managed CloudFormation stacks found for %q", e.ClusterName)
 }
+
+// combineErrors returns a single error describing all non-nil errors in errs,
+// or nil if there are none
+func combineErrors(errs []error) error {
+	var nonNil []error
+	for _, err := range errs {
+		if err != nil {
+			nonNil = append(nonNil, err)
+		}
+	}
+	switch len(nonNil) {
+	case 0:
+		return nil
+	case 1:
+		return nonNil[0]
+	}
+	msgs := make([]string, len(nonNil))
+	for i, err := range nonNil {
+		msgs[i] = err.Error()
+	}
+	return fmt.Errorf("%d errors occurred: %s", len(nonNil), strings.Join(msgs, "; "))
+}
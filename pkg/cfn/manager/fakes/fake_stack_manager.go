@@ -3,7 +3,9 @@ package fakes
 
 import (
 	"context"
+	"io"
 	"sync"
+	"time"
 
 	typesa "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
@@ -20,6 +22,30 @@ import (
 )
 
 type FakeStackManager struct {
+	AddAutoscalerTagsStub        func(context.Context, string) error
+	addAutoscalerTagsMutex       sync.RWMutex
+	addAutoscalerTagsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	addAutoscalerTagsReturns struct {
+		result1 error
+	}
+	addAutoscalerTagsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddClusterStackTagsStub        func(context.Context, map[string]string) error
+	addClusterStackTagsMutex       sync.RWMutex
+	addClusterStackTagsArgsForCall []struct {
+		arg1 context.Context
+		arg2 map[string]string
+	}
+	addClusterStackTagsReturns struct {
+		result1 error
+	}
+	addClusterStackTagsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	AppendNewClusterStackResourceStub        func(context.Context, bool) (bool, error)
 	appendNewClusterStackResourceMutex       sync.RWMutex
 	appendNewClusterStackResourceArgsForCall []struct {
@@ -34,6 +60,95 @@ type FakeStackManager struct {
 		result1 bool
 		result2 error
 	}
+	ApplyTagsToAllClusterStacksStub        func(context.Context, map[string]string) error
+	applyTagsToAllClusterStacksMutex       sync.RWMutex
+	applyTagsToAllClusterStacksArgsForCall []struct {
+		arg1 context.Context
+		arg2 map[string]string
+	}
+	applyTagsToAllClusterStacksReturns struct {
+		result1 error
+	}
+	applyTagsToAllClusterStacksReturnsOnCall map[int]struct {
+		result1 error
+	}
+	BackfillEksctlVersionTagStub        func(context.Context) ([]string, error)
+	backfillEksctlVersionTagMutex       sync.RWMutex
+	backfillEksctlVersionTagArgsForCall []struct {
+		arg1 context.Context
+	}
+	backfillEksctlVersionTagReturns struct {
+		result1 []string
+		result2 error
+	}
+	backfillEksctlVersionTagReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	CancelStackUpdateStub        func(context.Context, string, bool) error
+	cancelStackUpdateMutex       sync.RWMutex
+	cancelStackUpdateArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}
+	cancelStackUpdateReturns struct {
+		result1 error
+	}
+	cancelStackUpdateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CheckNodeGroupVersionCompatibilityStub        func(context.Context) ([]string, error)
+	checkNodeGroupVersionCompatibilityMutex       sync.RWMutex
+	checkNodeGroupVersionCompatibilityArgsForCall []struct {
+		arg1 context.Context
+	}
+	checkNodeGroupVersionCompatibilityReturns struct {
+		result1 []string
+		result2 error
+	}
+	checkNodeGroupVersionCompatibilityReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	CollectStackOutputsStub        func(*types.Stack, interface{}) error
+	collectStackOutputsMutex       sync.RWMutex
+	collectStackOutputsArgsForCall []struct {
+		arg1 *types.Stack
+		arg2 interface{}
+	}
+	collectStackOutputsReturns struct {
+		result1 error
+	}
+	collectStackOutputsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateNodeGroupStackSetStub        func(context.Context, *v1alpha5.NodeGroup, []string) error
+	createNodeGroupStackSetMutex       sync.RWMutex
+	createNodeGroupStackSetArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha5.NodeGroup
+		arg3 []string
+	}
+	createNodeGroupStackSetReturns struct {
+		result1 error
+	}
+	createNodeGroupStackSetReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateNodeGroupStacksStub        func(context.Context, []manager.NodeGroupSpec, int) error
+	createNodeGroupStacksMutex       sync.RWMutex
+	createNodeGroupStacksArgsForCall []struct {
+		arg1 context.Context
+		arg2 []manager.NodeGroupSpec
+		arg3 int
+	}
+	createNodeGroupStacksReturns struct {
+		result1 error
+	}
+	createNodeGroupStacksReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CreateStackStub        func(context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string, chan error) error
 	createStackMutex       sync.RWMutex
 	createStackArgsForCall []struct {
@@ -50,6 +165,98 @@ type FakeStackManager struct {
 	createStackReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CreateStackIfNotExistsStub        func(context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string, chan error) error
+	createStackIfNotExistsMutex       sync.RWMutex
+	createStackIfNotExistsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 builder.ResourceSetReader
+		arg4 map[string]string
+		arg5 map[string]string
+		arg6 chan error
+	}
+	createStackIfNotExistsReturns struct {
+		result1 error
+	}
+	createStackIfNotExistsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateStackNoWaitStub        func(context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string) (string, error)
+	createStackNoWaitMutex       sync.RWMutex
+	createStackNoWaitArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 builder.ResourceSetReader
+		arg4 map[string]string
+		arg5 map[string]string
+	}
+	createStackNoWaitReturns struct {
+		result1 string
+		result2 error
+	}
+	createStackNoWaitReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateStackWithCapabilitiesStub        func(context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string, []string, chan error) error
+	createStackWithCapabilitiesMutex       sync.RWMutex
+	createStackWithCapabilitiesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 builder.ResourceSetReader
+		arg4 map[string]string
+		arg5 map[string]string
+		arg6 []string
+		arg7 chan error
+	}
+	createStackWithCapabilitiesReturns struct {
+		result1 error
+	}
+	createStackWithCapabilitiesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateStackWithEventHandlerStub        func(context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string, func(manager.StackEvent), chan error) error
+	createStackWithEventHandlerMutex       sync.RWMutex
+	createStackWithEventHandlerArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 builder.ResourceSetReader
+		arg4 map[string]string
+		arg5 map[string]string
+		arg6 func(manager.StackEvent)
+		arg7 chan error
+	}
+	createStackWithEventHandlerReturns struct {
+		result1 error
+	}
+	createStackWithEventHandlerReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteNodeGroupStackSetStub        func(context.Context, string) error
+	deleteNodeGroupStackSetMutex       sync.RWMutex
+	deleteNodeGroupStackSetArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteNodeGroupStackSetReturns struct {
+		result1 error
+	}
+	deleteNodeGroupStackSetReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteNodeGroupStacksStub        func(context.Context, []string, manager.DeleteOptions) error
+	deleteNodeGroupStacksMutex       sync.RWMutex
+	deleteNodeGroupStacksArgsForCall []struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 manager.DeleteOptions
+	}
+	deleteNodeGroupStacksReturns struct {
+		result1 error
+	}
+	deleteNodeGroupStacksReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteStackBySpecStub        func(context.Context, *types.Stack) (*types.Stack, error)
 	deleteStackBySpecMutex       sync.RWMutex
 	deleteStackBySpecArgsForCall []struct {
@@ -77,6 +284,19 @@ type FakeStackManager struct {
 	deleteStackBySpecSyncReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteStackChangeSetStub        func(context.Context, string, string) error
+	deleteStackChangeSetMutex       sync.RWMutex
+	deleteStackChangeSetArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	deleteStackChangeSetReturns struct {
+		result1 error
+	}
+	deleteStackChangeSetReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteStackSyncStub        func(context.Context, *types.Stack) error
 	deleteStackSyncMutex       sync.RWMutex
 	deleteStackSyncArgsForCall []struct {
@@ -102,6 +322,23 @@ type FakeStackManager struct {
 		result1 *tasks.TaskTree
 		result2 error
 	}
+	DescribeAllClusterStacksStub        func(context.Context) (*types.Stack, []*types.Stack, []*types.Stack, error)
+	describeAllClusterStacksMutex       sync.RWMutex
+	describeAllClusterStacksArgsForCall []struct {
+		arg1 context.Context
+	}
+	describeAllClusterStacksReturns struct {
+		result1 *types.Stack
+		result2 []*types.Stack
+		result3 []*types.Stack
+		result4 error
+	}
+	describeAllClusterStacksReturnsOnCall map[int]struct {
+		result1 *types.Stack
+		result2 []*types.Stack
+		result3 []*types.Stack
+		result4 error
+	}
 	DescribeClusterStackStub        func(context.Context) (*types.Stack, error)
 	describeClusterStackMutex       sync.RWMutex
 	describeClusterStackArgsForCall []struct {
@@ -115,6 +352,19 @@ type FakeStackManager struct {
 		result1 *types.Stack
 		result2 error
 	}
+	DescribeFargateProfileStacksStub        func(context.Context) ([]*types.Stack, error)
+	describeFargateProfileStacksMutex       sync.RWMutex
+	describeFargateProfileStacksArgsForCall []struct {
+		arg1 context.Context
+	}
+	describeFargateProfileStacksReturns struct {
+		result1 []*types.Stack
+		result2 error
+	}
+	describeFargateProfileStacksReturnsOnCall map[int]struct {
+		result1 []*types.Stack
+		result2 error
+	}
 	DescribeIAMServiceAccountStacksStub        func(context.Context) ([]*types.Stack, error)
 	describeIAMServiceAccountStacksMutex       sync.RWMutex
 	describeIAMServiceAccountStacksArgsForCall []struct {
@@ -128,6 +378,34 @@ type FakeStackManager struct {
 		result1 []*types.Stack
 		result2 error
 	}
+	DescribeNodeGroupAMIStub        func(context.Context, string) (*manager.NodeGroupAMI, error)
+	describeNodeGroupAMIMutex       sync.RWMutex
+	describeNodeGroupAMIArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	describeNodeGroupAMIReturns struct {
+		result1 *manager.NodeGroupAMI
+		result2 error
+	}
+	describeNodeGroupAMIReturnsOnCall map[int]struct {
+		result1 *manager.NodeGroupAMI
+		result2 error
+	}
+	DescribeNodeGroupDeletionImpactStub        func(context.Context, string) (*manager.DeletionImpact, error)
+	describeNodeGroupDeletionImpactMutex       sync.RWMutex
+	describeNodeGroupDeletionImpactArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	describeNodeGroupDeletionImpactReturns struct {
+		result1 *manager.DeletionImpact
+		result2 error
+	}
+	describeNodeGroupDeletionImpactReturnsOnCall map[int]struct {
+		result1 *manager.DeletionImpact
+		result2 error
+	}
 	DescribeNodeGroupStackStub        func(context.Context, string) (*types.Stack, error)
 	describeNodeGroupStackMutex       sync.RWMutex
 	describeNodeGroupStackArgsForCall []struct {
@@ -142,6 +420,48 @@ type FakeStackManager struct {
 		result1 *types.Stack
 		result2 error
 	}
+	DescribeNodeGroupStackAndResourcesStub        func(context.Context, string) (manager.StackInfo, error)
+	describeNodeGroupStackAndResourcesMutex       sync.RWMutex
+	describeNodeGroupStackAndResourcesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	describeNodeGroupStackAndResourcesReturns struct {
+		result1 manager.StackInfo
+		result2 error
+	}
+	describeNodeGroupStackAndResourcesReturnsOnCall map[int]struct {
+		result1 manager.StackInfo
+		result2 error
+	}
+	DescribeNodeGroupStackByIDStub        func(context.Context, string) (*types.Stack, error)
+	describeNodeGroupStackByIDMutex       sync.RWMutex
+	describeNodeGroupStackByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	describeNodeGroupStackByIDReturns struct {
+		result1 *types.Stack
+		result2 error
+	}
+	describeNodeGroupStackByIDReturnsOnCall map[int]struct {
+		result1 *types.Stack
+		result2 error
+	}
+	DescribeNodeGroupStackSetInstancesStub        func(context.Context, string) ([]manager.StackSetInstanceStatus, error)
+	describeNodeGroupStackSetInstancesMutex       sync.RWMutex
+	describeNodeGroupStackSetInstancesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	describeNodeGroupStackSetInstancesReturns struct {
+		result1 []manager.StackSetInstanceStatus
+		result2 error
+	}
+	describeNodeGroupStackSetInstancesReturnsOnCall map[int]struct {
+		result1 []manager.StackSetInstanceStatus
+		result2 error
+	}
 	DescribeNodeGroupStacksStub        func(context.Context) ([]*types.Stack, error)
 	describeNodeGroupStacksMutex       sync.RWMutex
 	describeNodeGroupStacksArgsForCall []struct {
@@ -168,6 +488,35 @@ type FakeStackManager struct {
 		result1 map[string]manager.StackInfo
 		result2 error
 	}
+	DescribeNodeGroupStacksCreatedBetweenStub        func(context.Context, time.Time, time.Time) ([]*types.Stack, error)
+	describeNodeGroupStacksCreatedBetweenMutex       sync.RWMutex
+	describeNodeGroupStacksCreatedBetweenArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 time.Time
+	}
+	describeNodeGroupStacksCreatedBetweenReturns struct {
+		result1 []*types.Stack
+		result2 error
+	}
+	describeNodeGroupStacksCreatedBetweenReturnsOnCall map[int]struct {
+		result1 []*types.Stack
+		result2 error
+	}
+	DescribeNodeGroupStacksMatchingTagsStub        func(context.Context, map[string]string) ([]*types.Stack, error)
+	describeNodeGroupStacksMatchingTagsMutex       sync.RWMutex
+	describeNodeGroupStacksMatchingTagsArgsForCall []struct {
+		arg1 context.Context
+		arg2 map[string]string
+	}
+	describeNodeGroupStacksMatchingTagsReturns struct {
+		result1 []*types.Stack
+		result2 error
+	}
+	describeNodeGroupStacksMatchingTagsReturnsOnCall map[int]struct {
+		result1 []*types.Stack
+		result2 error
+	}
 	DescribeStackStub        func(context.Context, *types.Stack) (*types.Stack, error)
 	describeStackMutex       sync.RWMutex
 	describeStackArgsForCall []struct {
@@ -211,6 +560,22 @@ type FakeStackManager struct {
 		result1 []types.StackEvent
 		result2 error
 	}
+	DescribeStackIfExistsStub        func(context.Context, *types.Stack) (*types.Stack, bool, error)
+	describeStackIfExistsMutex       sync.RWMutex
+	describeStackIfExistsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *types.Stack
+	}
+	describeStackIfExistsReturns struct {
+		result1 *types.Stack
+		result2 bool
+		result3 error
+	}
+	describeStackIfExistsReturnsOnCall map[int]struct {
+		result1 *types.Stack
+		result2 bool
+		result3 error
+	}
 	DescribeStacksStub        func(context.Context) ([]*types.Stack, error)
 	describeStacksMutex       sync.RWMutex
 	describeStacksArgsForCall []struct {
@@ -224,6 +589,54 @@ type FakeStackManager struct {
 		result1 []*types.Stack
 		result2 error
 	}
+	DetectResourceDriftStub        func(context.Context, string, string) (*manager.ResourceDrift, error)
+	detectResourceDriftMutex       sync.RWMutex
+	detectResourceDriftArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	detectResourceDriftReturns struct {
+		result1 *manager.ResourceDrift
+		result2 error
+	}
+	detectResourceDriftReturnsOnCall map[int]struct {
+		result1 *manager.ResourceDrift
+		result2 error
+	}
+	DetectStackDriftStub        func(context.Context, string) (*manager.DriftResult, error)
+	detectStackDriftMutex       sync.RWMutex
+	detectStackDriftArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	detectStackDriftReturns struct {
+		result1 *manager.DriftResult
+		result2 error
+	}
+	detectStackDriftReturnsOnCall map[int]struct {
+		result1 *manager.DriftResult
+		result2 error
+	}
+	DiffManagedNodeGroupTagsStub        func(context.Context, string, map[string]string) (map[string]string, map[string]string, map[string]string, error)
+	diffManagedNodeGroupTagsMutex       sync.RWMutex
+	diffManagedNodeGroupTagsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]string
+	}
+	diffManagedNodeGroupTagsReturns struct {
+		result1 map[string]string
+		result2 map[string]string
+		result3 map[string]string
+		result4 error
+	}
+	diffManagedNodeGroupTagsReturnsOnCall map[int]struct {
+		result1 map[string]string
+		result2 map[string]string
+		result3 map[string]string
+		result4 error
+	}
 	DoCreateStackRequestStub        func(context.Context, *types.Stack, manager.TemplateData, map[string]string, map[string]string, bool, bool) error
 	doCreateStackRequestMutex       sync.RWMutex
 	doCreateStackRequestArgsForCall []struct {
@@ -253,6 +666,10 @@ type FakeStackManager struct {
 	doWaitUntilStackIsCreatedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	EnableDefaultTemplateBucketStub        func()
+	enableDefaultTemplateBucketMutex       sync.RWMutex
+	enableDefaultTemplateBucketArgsForCall []struct {
+	}
 	EnsureMapPublicIPOnLaunchEnabledStub        func(context.Context) error
 	ensureMapPublicIPOnLaunchEnabledMutex       sync.RWMutex
 	ensureMapPublicIPOnLaunchEnabledArgsForCall []struct {
@@ -264,58 +681,178 @@ type FakeStackManager struct {
 	ensureMapPublicIPOnLaunchEnabledReturnsOnCall map[int]struct {
 		result1 error
 	}
-	FixClusterCompatibilityStub        func(context.Context) error
-	fixClusterCompatibilityMutex       sync.RWMutex
-	fixClusterCompatibilityArgsForCall []struct {
+	ExportNodeGroupTemplateStub        func(context.Context, string, string) error
+	exportNodeGroupTemplateMutex       sync.RWMutex
+	exportNodeGroupTemplateArgsForCall []struct {
 		arg1 context.Context
+		arg2 string
+		arg3 string
 	}
-	fixClusterCompatibilityReturns struct {
+	exportNodeGroupTemplateReturns struct {
 		result1 error
 	}
-	fixClusterCompatibilityReturnsOnCall map[int]struct {
+	exportNodeGroupTemplateReturnsOnCall map[int]struct {
 		result1 error
 	}
-	GetAutoScalingGroupDesiredCapacityStub        func(context.Context, string) (typesa.AutoScalingGroup, error)
-	getAutoScalingGroupDesiredCapacityMutex       sync.RWMutex
-	getAutoScalingGroupDesiredCapacityArgsForCall []struct {
+	FindClusterStackByTagStub        func(context.Context) (*types.Stack, error)
+	findClusterStackByTagMutex       sync.RWMutex
+	findClusterStackByTagArgsForCall []struct {
 		arg1 context.Context
-		arg2 string
 	}
-	getAutoScalingGroupDesiredCapacityReturns struct {
-		result1 typesa.AutoScalingGroup
+	findClusterStackByTagReturns struct {
+		result1 *types.Stack
 		result2 error
 	}
-	getAutoScalingGroupDesiredCapacityReturnsOnCall map[int]struct {
-		result1 typesa.AutoScalingGroup
+	findClusterStackByTagReturnsOnCall map[int]struct {
+		result1 *types.Stack
 		result2 error
 	}
-	GetAutoScalingGroupNameStub        func(context.Context, *types.Stack) (string, error)
-	getAutoScalingGroupNameMutex       sync.RWMutex
-	getAutoScalingGroupNameArgsForCall []struct {
+	FindNodeGroupsMissingAutoscalerTagsStub        func(context.Context) ([]string, error)
+	findNodeGroupsMissingAutoscalerTagsMutex       sync.RWMutex
+	findNodeGroupsMissingAutoscalerTagsArgsForCall []struct {
 		arg1 context.Context
-		arg2 *types.Stack
 	}
-	getAutoScalingGroupNameReturns struct {
-		result1 string
+	findNodeGroupsMissingAutoscalerTagsReturns struct {
+		result1 []string
 		result2 error
 	}
-	getAutoScalingGroupNameReturnsOnCall map[int]struct {
-		result1 string
+	findNodeGroupsMissingAutoscalerTagsReturnsOnCall map[int]struct {
+		result1 []string
 		result2 error
 	}
-	GetClusterStackIfExistsStub        func(context.Context) (*types.Stack, error)
-	getClusterStackIfExistsMutex       sync.RWMutex
-	getClusterStackIfExistsArgsForCall []struct {
+	FindNodeGroupsUsingInstanceTypesStub        func(context.Context, []string) ([]string, error)
+	findNodeGroupsUsingInstanceTypesMutex       sync.RWMutex
+	findNodeGroupsUsingInstanceTypesArgsForCall []struct {
 		arg1 context.Context
+		arg2 []string
 	}
-	getClusterStackIfExistsReturns struct {
-		result1 *types.Stack
+	findNodeGroupsUsingInstanceTypesReturns struct {
+		result1 []string
 		result2 error
 	}
-	getClusterStackIfExistsReturnsOnCall map[int]struct {
+	findNodeGroupsUsingInstanceTypesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	FindNodeGroupsWithStaleLaunchTemplateStub        func(context.Context) ([]string, error)
+	findNodeGroupsWithStaleLaunchTemplateMutex       sync.RWMutex
+	findNodeGroupsWithStaleLaunchTemplateArgsForCall []struct {
+		arg1 context.Context
+	}
+	findNodeGroupsWithStaleLaunchTemplateReturns struct {
+		result1 []string
+		result2 error
+	}
+	findNodeGroupsWithStaleLaunchTemplateReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	FindOrphanedNodeGroupENIsStub        func(context.Context, string) ([]string, error)
+	findOrphanedNodeGroupENIsMutex       sync.RWMutex
+	findOrphanedNodeGroupENIsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	findOrphanedNodeGroupENIsReturns struct {
+		result1 []string
+		result2 error
+	}
+	findOrphanedNodeGroupENIsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	FixClusterCompatibilityStub        func(context.Context) error
+	fixClusterCompatibilityMutex       sync.RWMutex
+	fixClusterCompatibilityArgsForCall []struct {
+		arg1 context.Context
+	}
+	fixClusterCompatibilityReturns struct {
+		result1 error
+	}
+	fixClusterCompatibilityReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ForceDeleteNodeGroupStackStub        func(context.Context, string, []string) error
+	forceDeleteNodeGroupStackMutex       sync.RWMutex
+	forceDeleteNodeGroupStackArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+	}
+	forceDeleteNodeGroupStackReturns struct {
+		result1 error
+	}
+	forceDeleteNodeGroupStackReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetAutoScalingGroupCapacityStub        func(context.Context, string) (manager.ScalingConfig, error)
+	getAutoScalingGroupCapacityMutex       sync.RWMutex
+	getAutoScalingGroupCapacityArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getAutoScalingGroupCapacityReturns struct {
+		result1 manager.ScalingConfig
+		result2 error
+	}
+	getAutoScalingGroupCapacityReturnsOnCall map[int]struct {
+		result1 manager.ScalingConfig
+		result2 error
+	}
+	GetAutoScalingGroupDesiredCapacityStub        func(context.Context, string) (typesa.AutoScalingGroup, error)
+	getAutoScalingGroupDesiredCapacityMutex       sync.RWMutex
+	getAutoScalingGroupDesiredCapacityArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getAutoScalingGroupDesiredCapacityReturns struct {
+		result1 typesa.AutoScalingGroup
+		result2 error
+	}
+	getAutoScalingGroupDesiredCapacityReturnsOnCall map[int]struct {
+		result1 typesa.AutoScalingGroup
+		result2 error
+	}
+	GetAutoScalingGroupNameStub        func(context.Context, *types.Stack) (string, error)
+	getAutoScalingGroupNameMutex       sync.RWMutex
+	getAutoScalingGroupNameArgsForCall []struct {
+		arg1 context.Context
+		arg2 *types.Stack
+	}
+	getAutoScalingGroupNameReturns struct {
+		result1 string
+		result2 error
+	}
+	getAutoScalingGroupNameReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetClusterStackIfExistsStub        func(context.Context) (*types.Stack, error)
+	getClusterStackIfExistsMutex       sync.RWMutex
+	getClusterStackIfExistsArgsForCall []struct {
+		arg1 context.Context
+	}
+	getClusterStackIfExistsReturns struct {
+		result1 *types.Stack
+		result2 error
+	}
+	getClusterStackIfExistsReturnsOnCall map[int]struct {
 		result1 *types.Stack
 		result2 error
 	}
+	GetClusterVPCConfigStub        func(context.Context) (*manager.ClusterVPCInfo, error)
+	getClusterVPCConfigMutex       sync.RWMutex
+	getClusterVPCConfigArgsForCall []struct {
+		arg1 context.Context
+	}
+	getClusterVPCConfigReturns struct {
+		result1 *manager.ClusterVPCInfo
+		result2 error
+	}
+	getClusterVPCConfigReturnsOnCall map[int]struct {
+		result1 *manager.ClusterVPCInfo
+		result2 error
+	}
 	GetFargateStackStub        func(context.Context) (*types.Stack, error)
 	getFargateStackMutex       sync.RWMutex
 	getFargateStackArgsForCall []struct {
@@ -379,6 +916,22 @@ type FakeStackManager struct {
 		result1 *types.Stack
 		result2 error
 	}
+	GetManagedNodeGroupLaunchTemplateStub        func(context.Context, *types.Stack) (string, string, error)
+	getManagedNodeGroupLaunchTemplateMutex       sync.RWMutex
+	getManagedNodeGroupLaunchTemplateArgsForCall []struct {
+		arg1 context.Context
+		arg2 *types.Stack
+	}
+	getManagedNodeGroupLaunchTemplateReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	getManagedNodeGroupLaunchTemplateReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
 	GetManagedNodeGroupTemplateStub        func(context.Context, manager.GetNodegroupOption) (string, error)
 	getManagedNodeGroupTemplateMutex       sync.RWMutex
 	getManagedNodeGroupTemplateArgsForCall []struct {
@@ -393,6 +946,48 @@ type FakeStackManager struct {
 		result1 string
 		result2 error
 	}
+	GetNodeGroupAMIStub        func(context.Context, string) (string, error)
+	getNodeGroupAMIMutex       sync.RWMutex
+	getNodeGroupAMIArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getNodeGroupAMIReturns struct {
+		result1 string
+		result2 error
+	}
+	getNodeGroupAMIReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetNodeGroupInstanceRoleARNStub        func(context.Context, string) (string, error)
+	getNodeGroupInstanceRoleARNMutex       sync.RWMutex
+	getNodeGroupInstanceRoleARNArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getNodeGroupInstanceRoleARNReturns struct {
+		result1 string
+		result2 error
+	}
+	getNodeGroupInstanceRoleARNReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetNodeGroupKubernetesVersionStub        func(context.Context, string) (string, error)
+	getNodeGroupKubernetesVersionMutex       sync.RWMutex
+	getNodeGroupKubernetesVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getNodeGroupKubernetesVersionReturns struct {
+		result1 string
+		result2 error
+	}
+	getNodeGroupKubernetesVersionReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	GetNodeGroupNameStub        func(*types.Stack) string
 	getNodeGroupNameMutex       sync.RWMutex
 	getNodeGroupNameArgsForCall []struct {
@@ -404,6 +999,34 @@ type FakeStackManager struct {
 	getNodeGroupNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	GetNodeGroupSecurityGroupIDsStub        func(context.Context, string) ([]string, error)
+	getNodeGroupSecurityGroupIDsMutex       sync.RWMutex
+	getNodeGroupSecurityGroupIDsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getNodeGroupSecurityGroupIDsReturns struct {
+		result1 []string
+		result2 error
+	}
+	getNodeGroupSecurityGroupIDsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	GetNodeGroupStackTemplateStub        func(context.Context, string) (string, error)
+	getNodeGroupStackTemplateMutex       sync.RWMutex
+	getNodeGroupStackTemplateArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getNodeGroupStackTemplateReturns struct {
+		result1 string
+		result2 error
+	}
+	getNodeGroupStackTemplateReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	GetNodeGroupStackTypeStub        func(context.Context, manager.GetNodegroupOption) (v1alpha5.NodeGroupType, error)
 	getNodeGroupStackTypeMutex       sync.RWMutex
 	getNodeGroupStackTypeArgsForCall []struct {
@@ -418,6 +1041,63 @@ type FakeStackManager struct {
 		result1 v1alpha5.NodeGroupType
 		result2 error
 	}
+	GetStackParametersStub        func(context.Context, string) (map[string]string, error)
+	getStackParametersMutex       sync.RWMutex
+	getStackParametersArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getStackParametersReturns struct {
+		result1 map[string]string
+		result2 error
+	}
+	getStackParametersReturnsOnCall map[int]struct {
+		result1 map[string]string
+		result2 error
+	}
+	GetStackPhysicalResourceIDStub        func(context.Context, string, string) (string, error)
+	getStackPhysicalResourceIDMutex       sync.RWMutex
+	getStackPhysicalResourceIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	getStackPhysicalResourceIDReturns struct {
+		result1 string
+		result2 error
+	}
+	getStackPhysicalResourceIDReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetStackPolicyStub        func(context.Context, string) (string, error)
+	getStackPolicyMutex       sync.RWMutex
+	getStackPolicyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getStackPolicyReturns struct {
+		result1 string
+		result2 error
+	}
+	getStackPolicyReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetStackRollbackConfigurationStub        func(context.Context, string) (*manager.RollbackConfiguration, error)
+	getStackRollbackConfigurationMutex       sync.RWMutex
+	getStackRollbackConfigurationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getStackRollbackConfigurationReturns struct {
+		result1 *manager.RollbackConfiguration
+		result2 error
+	}
+	getStackRollbackConfigurationReturnsOnCall map[int]struct {
+		result1 *manager.RollbackConfiguration
+		result2 error
+	}
 	GetStackTemplateStub        func(context.Context, string) (string, error)
 	getStackTemplateMutex       sync.RWMutex
 	getStackTemplateArgsForCall []struct {
@@ -446,6 +1126,46 @@ type FakeStackManager struct {
 		result1 string
 		result2 error
 	}
+	GroupNodeGroupsByInstanceRoleStub        func(context.Context) (map[string][]string, error)
+	groupNodeGroupsByInstanceRoleMutex       sync.RWMutex
+	groupNodeGroupsByInstanceRoleArgsForCall []struct {
+		arg1 context.Context
+	}
+	groupNodeGroupsByInstanceRoleReturns struct {
+		result1 map[string][]string
+		result2 error
+	}
+	groupNodeGroupsByInstanceRoleReturnsOnCall map[int]struct {
+		result1 map[string][]string
+		result2 error
+	}
+	GroupNodeGroupsBySecurityGroupStub        func(context.Context) (map[string][]string, error)
+	groupNodeGroupsBySecurityGroupMutex       sync.RWMutex
+	groupNodeGroupsBySecurityGroupArgsForCall []struct {
+		arg1 context.Context
+	}
+	groupNodeGroupsBySecurityGroupReturns struct {
+		result1 map[string][]string
+		result2 error
+	}
+	groupNodeGroupsBySecurityGroupReturnsOnCall map[int]struct {
+		result1 map[string][]string
+		result2 error
+	}
+	HasCNIPolicyStub        func(context.Context, *types.Stack) (bool, error)
+	hasCNIPolicyMutex       sync.RWMutex
+	hasCNIPolicyArgsForCall []struct {
+		arg1 context.Context
+		arg2 *types.Stack
+	}
+	hasCNIPolicyReturns struct {
+		result1 bool
+		result2 error
+	}
+	hasCNIPolicyReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	HasClusterStackFromListStub        func(context.Context, []string, string) (bool, error)
 	hasClusterStackFromListMutex       sync.RWMutex
 	hasClusterStackFromListArgsForCall []struct {
@@ -461,6 +1181,61 @@ type FakeStackManager struct {
 		result1 bool
 		result2 error
 	}
+	HasProtectedResourcesStub        func(context.Context, string) (bool, error)
+	hasProtectedResourcesMutex       sync.RWMutex
+	hasProtectedResourcesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	hasProtectedResourcesReturns struct {
+		result1 bool
+		result2 error
+	}
+	hasProtectedResourcesReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ImportUnmanagedNodeGroupStub        func(context.Context, string, string) error
+	importUnmanagedNodeGroupMutex       sync.RWMutex
+	importUnmanagedNodeGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	importUnmanagedNodeGroupReturns struct {
+		result1 error
+	}
+	importUnmanagedNodeGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	IsSpotNodeGroupStub        func(context.Context, *types.Stack) (bool, error)
+	isSpotNodeGroupMutex       sync.RWMutex
+	isSpotNodeGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 *types.Stack
+	}
+	isSpotNodeGroupReturns struct {
+		result1 bool
+		result2 error
+	}
+	isSpotNodeGroupReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	IsTerminationProtectedStub        func(context.Context, string) (bool, error)
+	isTerminationProtectedMutex       sync.RWMutex
+	isTerminationProtectedArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	isTerminationProtectedReturns struct {
+		result1 bool
+		result2 error
+	}
+	isTerminationProtectedReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ListClusterStackNamesStub        func(context.Context) ([]string, error)
 	listClusterStackNamesMutex       sync.RWMutex
 	listClusterStackNamesArgsForCall []struct {
@@ -487,6 +1262,19 @@ type FakeStackManager struct {
 		result1 []string
 		result2 error
 	}
+	ListInProgressStacksStub        func(context.Context) ([]*types.Stack, error)
+	listInProgressStacksMutex       sync.RWMutex
+	listInProgressStacksArgsForCall []struct {
+		arg1 context.Context
+	}
+	listInProgressStacksReturns struct {
+		result1 []*types.Stack
+		result2 error
+	}
+	listInProgressStacksReturnsOnCall map[int]struct {
+		result1 []*types.Stack
+		result2 error
+	}
 	ListNodeGroupStacksStub        func(context.Context) ([]manager.NodeGroupStack, error)
 	listNodeGroupStacksMutex       sync.RWMutex
 	listNodeGroupStacksArgsForCall []struct {
@@ -500,29 +1288,74 @@ type FakeStackManager struct {
 		result1 []manager.NodeGroupStack
 		result2 error
 	}
-	ListStacksStub        func(context.Context, ...types.StackStatus) ([]*types.Stack, error)
-	listStacksMutex       sync.RWMutex
-	listStacksArgsForCall []struct {
+	ListNodeGroupStacksByTypeStub        func(context.Context, v1alpha5.NodeGroupType) ([]manager.NodeGroupStack, error)
+	listNodeGroupStacksByTypeMutex       sync.RWMutex
+	listNodeGroupStacksByTypeArgsForCall []struct {
 		arg1 context.Context
-		arg2 []types.StackStatus
+		arg2 v1alpha5.NodeGroupType
 	}
-	listStacksReturns struct {
-		result1 []*types.Stack
+	listNodeGroupStacksByTypeReturns struct {
+		result1 []manager.NodeGroupStack
 		result2 error
 	}
-	listStacksReturnsOnCall map[int]struct {
-		result1 []*types.Stack
+	listNodeGroupStacksByTypeReturnsOnCall map[int]struct {
+		result1 []manager.NodeGroupStack
 		result2 error
 	}
-	ListStacksMatchingStub        func(context.Context, string, ...types.StackStatus) ([]*types.Stack, error)
-	listStacksMatchingMutex       sync.RWMutex
-	listStacksMatchingArgsForCall []struct {
+	ListNodeGroupStacksPagedStub        func(context.Context, int, string) ([]manager.NodeGroupStack, string, error)
+	listNodeGroupStacksPagedMutex       sync.RWMutex
+	listNodeGroupStacksPagedArgsForCall []struct {
 		arg1 context.Context
-		arg2 string
-		arg3 []types.StackStatus
+		arg2 int
+		arg3 string
 	}
-	listStacksMatchingReturns struct {
-		result1 []*types.Stack
+	listNodeGroupStacksPagedReturns struct {
+		result1 []manager.NodeGroupStack
+		result2 string
+		result3 error
+	}
+	listNodeGroupStacksPagedReturnsOnCall map[int]struct {
+		result1 []manager.NodeGroupStack
+		result2 string
+		result3 error
+	}
+	ListStackChangeSetsStub        func(context.Context, string) ([]manager.ChangeSetSummary, error)
+	listStackChangeSetsMutex       sync.RWMutex
+	listStackChangeSetsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	listStackChangeSetsReturns struct {
+		result1 []manager.ChangeSetSummary
+		result2 error
+	}
+	listStackChangeSetsReturnsOnCall map[int]struct {
+		result1 []manager.ChangeSetSummary
+		result2 error
+	}
+	ListStacksStub        func(context.Context, ...types.StackStatus) ([]*types.Stack, error)
+	listStacksMutex       sync.RWMutex
+	listStacksArgsForCall []struct {
+		arg1 context.Context
+		arg2 []types.StackStatus
+	}
+	listStacksReturns struct {
+		result1 []*types.Stack
+		result2 error
+	}
+	listStacksReturnsOnCall map[int]struct {
+		result1 []*types.Stack
+		result2 error
+	}
+	ListStacksMatchingStub        func(context.Context, string, ...types.StackStatus) ([]*types.Stack, error)
+	listStacksMatchingMutex       sync.RWMutex
+	listStacksMatchingArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []types.StackStatus
+	}
+	listStacksMatchingReturns struct {
+		result1 []*types.Stack
 		result2 error
 	}
 	listStacksMatchingReturnsOnCall map[int]struct {
@@ -680,13 +1513,14 @@ type FakeStackManager struct {
 		result1 *tasks.TaskTree
 		result2 error
 	}
-	NewTasksToDeleteNodeGroupsStub        func([]manager.NodeGroupStack, func(_ string) bool, bool, func(chan error, string) error) (*tasks.TaskTree, error)
+	NewTasksToDeleteNodeGroupsStub        func(context.Context, []manager.NodeGroupStack, func(_ string) bool, bool, func(chan error, string) error) (*tasks.TaskTree, error)
 	newTasksToDeleteNodeGroupsMutex       sync.RWMutex
 	newTasksToDeleteNodeGroupsArgsForCall []struct {
-		arg1 []manager.NodeGroupStack
-		arg2 func(_ string) bool
-		arg3 bool
-		arg4 func(chan error, string) error
+		arg1 context.Context
+		arg2 []manager.NodeGroupStack
+		arg3 func(_ string) bool
+		arg4 bool
+		arg5 func(chan error, string) error
 	}
 	newTasksToDeleteNodeGroupsReturns struct {
 		result1 *tasks.TaskTree
@@ -725,6 +1559,78 @@ type FakeStackManager struct {
 	newUnmanagedNodeGroupTaskReturnsOnCall map[int]struct {
 		result1 *tasks.TaskTree
 	}
+	NodeGroupStacksJSONStub        func(context.Context) ([]byte, error)
+	nodeGroupStacksJSONMutex       sync.RWMutex
+	nodeGroupStacksJSONArgsForCall []struct {
+		arg1 context.Context
+	}
+	nodeGroupStacksJSONReturns struct {
+		result1 []byte
+		result2 error
+	}
+	nodeGroupStacksJSONReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	NodeGroupTemplateDriftStub        func(context.Context, *v1alpha5.NodeGroup) (bool, string, error)
+	nodeGroupTemplateDriftMutex       sync.RWMutex
+	nodeGroupTemplateDriftArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha5.NodeGroup
+	}
+	nodeGroupTemplateDriftReturns struct {
+		result1 bool
+		result2 string
+		result3 error
+	}
+	nodeGroupTemplateDriftReturnsOnCall map[int]struct {
+		result1 bool
+		result2 string
+		result3 error
+	}
+	PropagateManagedNodeGroupTagsToSecurityGroupsStub        func(context.Context, string, map[string]string) error
+	propagateManagedNodeGroupTagsToSecurityGroupsMutex       sync.RWMutex
+	propagateManagedNodeGroupTagsToSecurityGroupsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]string
+	}
+	propagateManagedNodeGroupTagsToSecurityGroupsReturns struct {
+		result1 error
+	}
+	propagateManagedNodeGroupTagsToSecurityGroupsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PropagateManagedNodeGroupTagsToVolumesStub        func(context.Context, string, map[string]string) error
+	propagateManagedNodeGroupTagsToVolumesMutex       sync.RWMutex
+	propagateManagedNodeGroupTagsToVolumesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]string
+	}
+	propagateManagedNodeGroupTagsToVolumesReturns struct {
+		result1 error
+	}
+	propagateManagedNodeGroupTagsToVolumesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReconcileManagedNodeGroupTagsToASGStub        func(context.Context, string, map[string]string) error
+	reconcileManagedNodeGroupTagsToASGMutex       sync.RWMutex
+	reconcileManagedNodeGroupTagsToASGArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]string
+	}
+	reconcileManagedNodeGroupTagsToASGReturns struct {
+		result1 error
+	}
+	reconcileManagedNodeGroupTagsToASGReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RefreshClusterStackCacheStub        func()
+	refreshClusterStackCacheMutex       sync.RWMutex
+	refreshClusterStackCacheArgsForCall []struct {
+	}
 	RefreshFargatePodExecutionRoleARNStub        func(context.Context) error
 	refreshFargatePodExecutionRoleARNMutex       sync.RWMutex
 	refreshFargatePodExecutionRoleARNArgsForCall []struct {
@@ -736,6 +1642,71 @@ type FakeStackManager struct {
 	refreshFargatePodExecutionRoleARNReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RollbackStackStub        func(context.Context, string, ...string) error
+	rollbackStackMutex       sync.RWMutex
+	rollbackStackArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+	}
+	rollbackStackReturns struct {
+		result1 error
+	}
+	rollbackStackReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetAutoScalingGroupCapacityStub        func(context.Context, string, manager.ScalingConfig) error
+	setAutoScalingGroupCapacityMutex       sync.RWMutex
+	setAutoScalingGroupCapacityArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 manager.ScalingConfig
+	}
+	setAutoScalingGroupCapacityReturns struct {
+		result1 error
+	}
+	setAutoScalingGroupCapacityReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetDeploymentIDStub        func(string)
+	setDeploymentIDMutex       sync.RWMutex
+	setDeploymentIDArgsForCall []struct {
+		arg1 string
+	}
+	SetDescribeConcurrencyStub        func(int) error
+	setDescribeConcurrencyMutex       sync.RWMutex
+	setDescribeConcurrencyArgsForCall []struct {
+		arg1 int
+	}
+	setDescribeConcurrencyReturns struct {
+		result1 error
+	}
+	setDescribeConcurrencyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetStackNamerStub        func(manager.StackNamer)
+	setStackNamerMutex       sync.RWMutex
+	setStackNamerArgsForCall []struct {
+		arg1 manager.StackNamer
+	}
+	SetTemplateBucketStub        func(string)
+	setTemplateBucketMutex       sync.RWMutex
+	setTemplateBucketArgsForCall []struct {
+		arg1 string
+	}
+	SetTerminationProtectionStub        func(context.Context, string, bool) error
+	setTerminationProtectionMutex       sync.RWMutex
+	setTerminationProtectionArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}
+	setTerminationProtectionReturns struct {
+		result1 error
+	}
+	setTerminationProtectionReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StackStatusIsNotReadyStub        func(*types.Stack) bool
 	stackStatusIsNotReadyMutex       sync.RWMutex
 	stackStatusIsNotReadyArgsForCall []struct {
@@ -758,6 +1729,19 @@ type FakeStackManager struct {
 	stackStatusIsNotTransitionalReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	TotalDesiredCapacityStub        func(context.Context) (int, error)
+	totalDesiredCapacityMutex       sync.RWMutex
+	totalDesiredCapacityArgsForCall []struct {
+		arg1 context.Context
+	}
+	totalDesiredCapacityReturns struct {
+		result1 int
+		result2 error
+	}
+	totalDesiredCapacityReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	UpdateNodeGroupStackStub        func(context.Context, string, string, bool) error
 	updateNodeGroupStackMutex       sync.RWMutex
 	updateNodeGroupStackArgsForCall []struct {
@@ -784,10 +1768,245 @@ type FakeStackManager struct {
 	updateStackReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateStackWithChangesStub        func(context.Context, manager.UpdateStackOptions) (*manager.ChangeSummary, error)
+	updateStackWithChangesMutex       sync.RWMutex
+	updateStackWithChangesArgsForCall []struct {
+		arg1 context.Context
+		arg2 manager.UpdateStackOptions
+	}
+	updateStackWithChangesReturns struct {
+		result1 *manager.ChangeSummary
+		result2 error
+	}
+	updateStackWithChangesReturnsOnCall map[int]struct {
+		result1 *manager.ChangeSummary
+		result2 error
+	}
+	UsesCustomLaunchTemplateStub        func(context.Context, string) (bool, string, error)
+	usesCustomLaunchTemplateMutex       sync.RWMutex
+	usesCustomLaunchTemplateArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	usesCustomLaunchTemplateReturns struct {
+		result1 bool
+		result2 string
+		result3 error
+	}
+	usesCustomLaunchTemplateReturnsOnCall map[int]struct {
+		result1 bool
+		result2 string
+		result3 error
+	}
+	ValidateNodeGroupOwnershipStub        func(context.Context) ([]manager.OwnershipWarning, error)
+	validateNodeGroupOwnershipMutex       sync.RWMutex
+	validateNodeGroupOwnershipArgsForCall []struct {
+		arg1 context.Context
+	}
+	validateNodeGroupOwnershipReturns struct {
+		result1 []manager.OwnershipWarning
+		result2 error
+	}
+	validateNodeGroupOwnershipReturnsOnCall map[int]struct {
+		result1 []manager.OwnershipWarning
+		result2 error
+	}
+	ValidateNodeGroupSubnetAZsStub        func(context.Context, string) ([]string, error)
+	validateNodeGroupSubnetAZsMutex       sync.RWMutex
+	validateNodeGroupSubnetAZsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	validateNodeGroupSubnetAZsReturns struct {
+		result1 []string
+		result2 error
+	}
+	validateNodeGroupSubnetAZsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	ValidateTemplateStub        func(context.Context, manager.TemplateData) (*manager.ValidationResult, error)
+	validateTemplateMutex       sync.RWMutex
+	validateTemplateArgsForCall []struct {
+		arg1 context.Context
+		arg2 manager.TemplateData
+	}
+	validateTemplateReturns struct {
+		result1 *manager.ValidationResult
+		result2 error
+	}
+	validateTemplateReturnsOnCall map[int]struct {
+		result1 *manager.ValidationResult
+		result2 error
+	}
+	WaitForASGInServiceStub        func(context.Context, string, time.Duration) error
+	waitForASGInServiceMutex       sync.RWMutex
+	waitForASGInServiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Duration
+	}
+	waitForASGInServiceReturns struct {
+		result1 error
+	}
+	waitForASGInServiceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WaitForNodeGroupStacksStub        func(context.Context, []string, time.Duration) ([]manager.NodeGroupStackResult, error)
+	waitForNodeGroupStacksMutex       sync.RWMutex
+	waitForNodeGroupStacksArgsForCall []struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 time.Duration
+	}
+	waitForNodeGroupStacksReturns struct {
+		result1 []manager.NodeGroupStackResult
+		result2 error
+	}
+	waitForNodeGroupStacksReturnsOnCall map[int]struct {
+		result1 []manager.NodeGroupStackResult
+		result2 error
+	}
+	WriteNodeGroupInventoryCSVStub        func(context.Context, io.Writer) error
+	writeNodeGroupInventoryCSVMutex       sync.RWMutex
+	writeNodeGroupInventoryCSVArgsForCall []struct {
+		arg1 context.Context
+		arg2 io.Writer
+	}
+	writeNodeGroupInventoryCSVReturns struct {
+		result1 error
+	}
+	writeNodeGroupInventoryCSVReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeStackManager) AddAutoscalerTags(arg1 context.Context, arg2 string) error {
+	fake.addAutoscalerTagsMutex.Lock()
+	ret, specificReturn := fake.addAutoscalerTagsReturnsOnCall[len(fake.addAutoscalerTagsArgsForCall)]
+	fake.addAutoscalerTagsArgsForCall = append(fake.addAutoscalerTagsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AddAutoscalerTagsStub
+	fakeReturns := fake.addAutoscalerTagsReturns
+	fake.recordInvocation("AddAutoscalerTags", []interface{}{arg1, arg2})
+	fake.addAutoscalerTagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) AddAutoscalerTagsCallCount() int {
+	fake.addAutoscalerTagsMutex.RLock()
+	defer fake.addAutoscalerTagsMutex.RUnlock()
+	return len(fake.addAutoscalerTagsArgsForCall)
+}
+
+func (fake *FakeStackManager) AddAutoscalerTagsCalls(stub func(context.Context, string) error) {
+	fake.addAutoscalerTagsMutex.Lock()
+	defer fake.addAutoscalerTagsMutex.Unlock()
+	fake.AddAutoscalerTagsStub = stub
+}
+
+func (fake *FakeStackManager) AddAutoscalerTagsArgsForCall(i int) (context.Context, string) {
+	fake.addAutoscalerTagsMutex.RLock()
+	defer fake.addAutoscalerTagsMutex.RUnlock()
+	argsForCall := fake.addAutoscalerTagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) AddAutoscalerTagsReturns(result1 error) {
+	fake.addAutoscalerTagsMutex.Lock()
+	defer fake.addAutoscalerTagsMutex.Unlock()
+	fake.AddAutoscalerTagsStub = nil
+	fake.addAutoscalerTagsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) AddAutoscalerTagsReturnsOnCall(i int, result1 error) {
+	fake.addAutoscalerTagsMutex.Lock()
+	defer fake.addAutoscalerTagsMutex.Unlock()
+	fake.AddAutoscalerTagsStub = nil
+	if fake.addAutoscalerTagsReturnsOnCall == nil {
+		fake.addAutoscalerTagsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addAutoscalerTagsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) AddClusterStackTags(arg1 context.Context, arg2 map[string]string) error {
+	fake.addClusterStackTagsMutex.Lock()
+	ret, specificReturn := fake.addClusterStackTagsReturnsOnCall[len(fake.addClusterStackTagsArgsForCall)]
+	fake.addClusterStackTagsArgsForCall = append(fake.addClusterStackTagsArgsForCall, struct {
+		arg1 context.Context
+		arg2 map[string]string
+	}{arg1, arg2})
+	stub := fake.AddClusterStackTagsStub
+	fakeReturns := fake.addClusterStackTagsReturns
+	fake.recordInvocation("AddClusterStackTags", []interface{}{arg1, arg2})
+	fake.addClusterStackTagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) AddClusterStackTagsCallCount() int {
+	fake.addClusterStackTagsMutex.RLock()
+	defer fake.addClusterStackTagsMutex.RUnlock()
+	return len(fake.addClusterStackTagsArgsForCall)
+}
+
+func (fake *FakeStackManager) AddClusterStackTagsCalls(stub func(context.Context, map[string]string) error) {
+	fake.addClusterStackTagsMutex.Lock()
+	defer fake.addClusterStackTagsMutex.Unlock()
+	fake.AddClusterStackTagsStub = stub
+}
+
+func (fake *FakeStackManager) AddClusterStackTagsArgsForCall(i int) (context.Context, map[string]string) {
+	fake.addClusterStackTagsMutex.RLock()
+	defer fake.addClusterStackTagsMutex.RUnlock()
+	argsForCall := fake.addClusterStackTagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) AddClusterStackTagsReturns(result1 error) {
+	fake.addClusterStackTagsMutex.Lock()
+	defer fake.addClusterStackTagsMutex.Unlock()
+	fake.AddClusterStackTagsStub = nil
+	fake.addClusterStackTagsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) AddClusterStackTagsReturnsOnCall(i int, result1 error) {
+	fake.addClusterStackTagsMutex.Lock()
+	defer fake.addClusterStackTagsMutex.Unlock()
+	fake.AddClusterStackTagsStub = nil
+	if fake.addClusterStackTagsReturnsOnCall == nil {
+		fake.addClusterStackTagsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addClusterStackTagsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStackManager) AppendNewClusterStackResource(arg1 context.Context, arg2 bool) (bool, error) {
 	fake.appendNewClusterStackResourceMutex.Lock()
 	ret, specificReturn := fake.appendNewClusterStackResourceReturnsOnCall[len(fake.appendNewClusterStackResourceArgsForCall)]
@@ -853,23 +2072,19 @@ func (fake *FakeStackManager) AppendNewClusterStackResourceReturnsOnCall(i int,
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) CreateStack(arg1 context.Context, arg2 string, arg3 builder.ResourceSetReader, arg4 map[string]string, arg5 map[string]string, arg6 chan error) error {
-	fake.createStackMutex.Lock()
-	ret, specificReturn := fake.createStackReturnsOnCall[len(fake.createStackArgsForCall)]
-	fake.createStackArgsForCall = append(fake.createStackArgsForCall, struct {
+func (fake *FakeStackManager) ApplyTagsToAllClusterStacks(arg1 context.Context, arg2 map[string]string) error {
+	fake.applyTagsToAllClusterStacksMutex.Lock()
+	ret, specificReturn := fake.applyTagsToAllClusterStacksReturnsOnCall[len(fake.applyTagsToAllClusterStacksArgsForCall)]
+	fake.applyTagsToAllClusterStacksArgsForCall = append(fake.applyTagsToAllClusterStacksArgsForCall, struct {
 		arg1 context.Context
-		arg2 string
-		arg3 builder.ResourceSetReader
-		arg4 map[string]string
-		arg5 map[string]string
-		arg6 chan error
-	}{arg1, arg2, arg3, arg4, arg5, arg6})
-	stub := fake.CreateStackStub
-	fakeReturns := fake.createStackReturns
-	fake.recordInvocation("CreateStack", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
-	fake.createStackMutex.Unlock()
+		arg2 map[string]string
+	}{arg1, arg2})
+	stub := fake.ApplyTagsToAllClusterStacksStub
+	fakeReturns := fake.applyTagsToAllClusterStacksReturns
+	fake.recordInvocation("ApplyTagsToAllClusterStacks", []interface{}{arg1, arg2})
+	fake.applyTagsToAllClusterStacksMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -877,61 +2092,60 @@ func (fake *FakeStackManager) CreateStack(arg1 context.Context, arg2 string, arg
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) CreateStackCallCount() int {
-	fake.createStackMutex.RLock()
-	defer fake.createStackMutex.RUnlock()
-	return len(fake.createStackArgsForCall)
+func (fake *FakeStackManager) ApplyTagsToAllClusterStacksCallCount() int {
+	fake.applyTagsToAllClusterStacksMutex.RLock()
+	defer fake.applyTagsToAllClusterStacksMutex.RUnlock()
+	return len(fake.applyTagsToAllClusterStacksArgsForCall)
 }
 
-func (fake *FakeStackManager) CreateStackCalls(stub func(context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string, chan error) error) {
-	fake.createStackMutex.Lock()
-	defer fake.createStackMutex.Unlock()
-	fake.CreateStackStub = stub
+func (fake *FakeStackManager) ApplyTagsToAllClusterStacksCalls(stub func(context.Context, map[string]string) error) {
+	fake.applyTagsToAllClusterStacksMutex.Lock()
+	defer fake.applyTagsToAllClusterStacksMutex.Unlock()
+	fake.ApplyTagsToAllClusterStacksStub = stub
 }
 
-func (fake *FakeStackManager) CreateStackArgsForCall(i int) (context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string, chan error) {
-	fake.createStackMutex.RLock()
-	defer fake.createStackMutex.RUnlock()
-	argsForCall := fake.createStackArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+func (fake *FakeStackManager) ApplyTagsToAllClusterStacksArgsForCall(i int) (context.Context, map[string]string) {
+	fake.applyTagsToAllClusterStacksMutex.RLock()
+	defer fake.applyTagsToAllClusterStacksMutex.RUnlock()
+	argsForCall := fake.applyTagsToAllClusterStacksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) CreateStackReturns(result1 error) {
-	fake.createStackMutex.Lock()
-	defer fake.createStackMutex.Unlock()
-	fake.CreateStackStub = nil
-	fake.createStackReturns = struct {
+func (fake *FakeStackManager) ApplyTagsToAllClusterStacksReturns(result1 error) {
+	fake.applyTagsToAllClusterStacksMutex.Lock()
+	defer fake.applyTagsToAllClusterStacksMutex.Unlock()
+	fake.ApplyTagsToAllClusterStacksStub = nil
+	fake.applyTagsToAllClusterStacksReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) CreateStackReturnsOnCall(i int, result1 error) {
-	fake.createStackMutex.Lock()
-	defer fake.createStackMutex.Unlock()
-	fake.CreateStackStub = nil
-	if fake.createStackReturnsOnCall == nil {
-		fake.createStackReturnsOnCall = make(map[int]struct {
+func (fake *FakeStackManager) ApplyTagsToAllClusterStacksReturnsOnCall(i int, result1 error) {
+	fake.applyTagsToAllClusterStacksMutex.Lock()
+	defer fake.applyTagsToAllClusterStacksMutex.Unlock()
+	fake.ApplyTagsToAllClusterStacksStub = nil
+	if fake.applyTagsToAllClusterStacksReturnsOnCall == nil {
+		fake.applyTagsToAllClusterStacksReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.createStackReturnsOnCall[i] = struct {
+	fake.applyTagsToAllClusterStacksReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) DeleteStackBySpec(arg1 context.Context, arg2 *types.Stack) (*types.Stack, error) {
-	fake.deleteStackBySpecMutex.Lock()
-	ret, specificReturn := fake.deleteStackBySpecReturnsOnCall[len(fake.deleteStackBySpecArgsForCall)]
-	fake.deleteStackBySpecArgsForCall = append(fake.deleteStackBySpecArgsForCall, struct {
+func (fake *FakeStackManager) BackfillEksctlVersionTag(arg1 context.Context) ([]string, error) {
+	fake.backfillEksctlVersionTagMutex.Lock()
+	ret, specificReturn := fake.backfillEksctlVersionTagReturnsOnCall[len(fake.backfillEksctlVersionTagArgsForCall)]
+	fake.backfillEksctlVersionTagArgsForCall = append(fake.backfillEksctlVersionTagArgsForCall, struct {
 		arg1 context.Context
-		arg2 *types.Stack
-	}{arg1, arg2})
-	stub := fake.DeleteStackBySpecStub
-	fakeReturns := fake.deleteStackBySpecReturns
-	fake.recordInvocation("DeleteStackBySpec", []interface{}{arg1, arg2})
-	fake.deleteStackBySpecMutex.Unlock()
+	}{arg1})
+	stub := fake.BackfillEksctlVersionTagStub
+	fakeReturns := fake.backfillEksctlVersionTagReturns
+	fake.recordInvocation("BackfillEksctlVersionTag", []interface{}{arg1})
+	fake.backfillEksctlVersionTagMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -939,63 +2153,63 @@ func (fake *FakeStackManager) DeleteStackBySpec(arg1 context.Context, arg2 *type
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) DeleteStackBySpecCallCount() int {
-	fake.deleteStackBySpecMutex.RLock()
-	defer fake.deleteStackBySpecMutex.RUnlock()
-	return len(fake.deleteStackBySpecArgsForCall)
+func (fake *FakeStackManager) BackfillEksctlVersionTagCallCount() int {
+	fake.backfillEksctlVersionTagMutex.RLock()
+	defer fake.backfillEksctlVersionTagMutex.RUnlock()
+	return len(fake.backfillEksctlVersionTagArgsForCall)
 }
 
-func (fake *FakeStackManager) DeleteStackBySpecCalls(stub func(context.Context, *types.Stack) (*types.Stack, error)) {
-	fake.deleteStackBySpecMutex.Lock()
-	defer fake.deleteStackBySpecMutex.Unlock()
-	fake.DeleteStackBySpecStub = stub
+func (fake *FakeStackManager) BackfillEksctlVersionTagCalls(stub func(context.Context) ([]string, error)) {
+	fake.backfillEksctlVersionTagMutex.Lock()
+	defer fake.backfillEksctlVersionTagMutex.Unlock()
+	fake.BackfillEksctlVersionTagStub = stub
 }
 
-func (fake *FakeStackManager) DeleteStackBySpecArgsForCall(i int) (context.Context, *types.Stack) {
-	fake.deleteStackBySpecMutex.RLock()
-	defer fake.deleteStackBySpecMutex.RUnlock()
-	argsForCall := fake.deleteStackBySpecArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeStackManager) BackfillEksctlVersionTagArgsForCall(i int) context.Context {
+	fake.backfillEksctlVersionTagMutex.RLock()
+	defer fake.backfillEksctlVersionTagMutex.RUnlock()
+	argsForCall := fake.backfillEksctlVersionTagArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) DeleteStackBySpecReturns(result1 *types.Stack, result2 error) {
-	fake.deleteStackBySpecMutex.Lock()
-	defer fake.deleteStackBySpecMutex.Unlock()
-	fake.DeleteStackBySpecStub = nil
-	fake.deleteStackBySpecReturns = struct {
-		result1 *types.Stack
+func (fake *FakeStackManager) BackfillEksctlVersionTagReturns(result1 []string, result2 error) {
+	fake.backfillEksctlVersionTagMutex.Lock()
+	defer fake.backfillEksctlVersionTagMutex.Unlock()
+	fake.BackfillEksctlVersionTagStub = nil
+	fake.backfillEksctlVersionTagReturns = struct {
+		result1 []string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) DeleteStackBySpecReturnsOnCall(i int, result1 *types.Stack, result2 error) {
-	fake.deleteStackBySpecMutex.Lock()
-	defer fake.deleteStackBySpecMutex.Unlock()
-	fake.DeleteStackBySpecStub = nil
-	if fake.deleteStackBySpecReturnsOnCall == nil {
-		fake.deleteStackBySpecReturnsOnCall = make(map[int]struct {
-			result1 *types.Stack
+func (fake *FakeStackManager) BackfillEksctlVersionTagReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.backfillEksctlVersionTagMutex.Lock()
+	defer fake.backfillEksctlVersionTagMutex.Unlock()
+	fake.BackfillEksctlVersionTagStub = nil
+	if fake.backfillEksctlVersionTagReturnsOnCall == nil {
+		fake.backfillEksctlVersionTagReturnsOnCall = make(map[int]struct {
+			result1 []string
 			result2 error
 		})
 	}
-	fake.deleteStackBySpecReturnsOnCall[i] = struct {
-		result1 *types.Stack
+	fake.backfillEksctlVersionTagReturnsOnCall[i] = struct {
+		result1 []string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) DeleteStackBySpecSync(arg1 context.Context, arg2 *types.Stack, arg3 chan error) error {
-	fake.deleteStackBySpecSyncMutex.Lock()
-	ret, specificReturn := fake.deleteStackBySpecSyncReturnsOnCall[len(fake.deleteStackBySpecSyncArgsForCall)]
-	fake.deleteStackBySpecSyncArgsForCall = append(fake.deleteStackBySpecSyncArgsForCall, struct {
+func (fake *FakeStackManager) CancelStackUpdate(arg1 context.Context, arg2 string, arg3 bool) error {
+	fake.cancelStackUpdateMutex.Lock()
+	ret, specificReturn := fake.cancelStackUpdateReturnsOnCall[len(fake.cancelStackUpdateArgsForCall)]
+	fake.cancelStackUpdateArgsForCall = append(fake.cancelStackUpdateArgsForCall, struct {
 		arg1 context.Context
-		arg2 *types.Stack
-		arg3 chan error
+		arg2 string
+		arg3 bool
 	}{arg1, arg2, arg3})
-	stub := fake.DeleteStackBySpecSyncStub
-	fakeReturns := fake.deleteStackBySpecSyncReturns
-	fake.recordInvocation("DeleteStackBySpecSync", []interface{}{arg1, arg2, arg3})
-	fake.deleteStackBySpecSyncMutex.Unlock()
+	stub := fake.CancelStackUpdateStub
+	fakeReturns := fake.cancelStackUpdateReturns
+	fake.recordInvocation("CancelStackUpdate", []interface{}{arg1, arg2, arg3})
+	fake.cancelStackUpdateMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
@@ -1005,59 +2219,123 @@ func (fake *FakeStackManager) DeleteStackBySpecSync(arg1 context.Context, arg2 *
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) DeleteStackBySpecSyncCallCount() int {
-	fake.deleteStackBySpecSyncMutex.RLock()
-	defer fake.deleteStackBySpecSyncMutex.RUnlock()
-	return len(fake.deleteStackBySpecSyncArgsForCall)
+func (fake *FakeStackManager) CancelStackUpdateCallCount() int {
+	fake.cancelStackUpdateMutex.RLock()
+	defer fake.cancelStackUpdateMutex.RUnlock()
+	return len(fake.cancelStackUpdateArgsForCall)
 }
 
-func (fake *FakeStackManager) DeleteStackBySpecSyncCalls(stub func(context.Context, *types.Stack, chan error) error) {
-	fake.deleteStackBySpecSyncMutex.Lock()
-	defer fake.deleteStackBySpecSyncMutex.Unlock()
-	fake.DeleteStackBySpecSyncStub = stub
+func (fake *FakeStackManager) CancelStackUpdateCalls(stub func(context.Context, string, bool) error) {
+	fake.cancelStackUpdateMutex.Lock()
+	defer fake.cancelStackUpdateMutex.Unlock()
+	fake.CancelStackUpdateStub = stub
 }
 
-func (fake *FakeStackManager) DeleteStackBySpecSyncArgsForCall(i int) (context.Context, *types.Stack, chan error) {
-	fake.deleteStackBySpecSyncMutex.RLock()
-	defer fake.deleteStackBySpecSyncMutex.RUnlock()
-	argsForCall := fake.deleteStackBySpecSyncArgsForCall[i]
+func (fake *FakeStackManager) CancelStackUpdateArgsForCall(i int) (context.Context, string, bool) {
+	fake.cancelStackUpdateMutex.RLock()
+	defer fake.cancelStackUpdateMutex.RUnlock()
+	argsForCall := fake.cancelStackUpdateArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) DeleteStackBySpecSyncReturns(result1 error) {
-	fake.deleteStackBySpecSyncMutex.Lock()
-	defer fake.deleteStackBySpecSyncMutex.Unlock()
-	fake.DeleteStackBySpecSyncStub = nil
-	fake.deleteStackBySpecSyncReturns = struct {
+func (fake *FakeStackManager) CancelStackUpdateReturns(result1 error) {
+	fake.cancelStackUpdateMutex.Lock()
+	defer fake.cancelStackUpdateMutex.Unlock()
+	fake.CancelStackUpdateStub = nil
+	fake.cancelStackUpdateReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) DeleteStackBySpecSyncReturnsOnCall(i int, result1 error) {
-	fake.deleteStackBySpecSyncMutex.Lock()
-	defer fake.deleteStackBySpecSyncMutex.Unlock()
-	fake.DeleteStackBySpecSyncStub = nil
-	if fake.deleteStackBySpecSyncReturnsOnCall == nil {
-		fake.deleteStackBySpecSyncReturnsOnCall = make(map[int]struct {
+func (fake *FakeStackManager) CancelStackUpdateReturnsOnCall(i int, result1 error) {
+	fake.cancelStackUpdateMutex.Lock()
+	defer fake.cancelStackUpdateMutex.Unlock()
+	fake.CancelStackUpdateStub = nil
+	if fake.cancelStackUpdateReturnsOnCall == nil {
+		fake.cancelStackUpdateReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.deleteStackBySpecSyncReturnsOnCall[i] = struct {
+	fake.cancelStackUpdateReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) DeleteStackSync(arg1 context.Context, arg2 *types.Stack) error {
-	fake.deleteStackSyncMutex.Lock()
-	ret, specificReturn := fake.deleteStackSyncReturnsOnCall[len(fake.deleteStackSyncArgsForCall)]
-	fake.deleteStackSyncArgsForCall = append(fake.deleteStackSyncArgsForCall, struct {
+func (fake *FakeStackManager) CheckNodeGroupVersionCompatibility(arg1 context.Context) ([]string, error) {
+	fake.checkNodeGroupVersionCompatibilityMutex.Lock()
+	ret, specificReturn := fake.checkNodeGroupVersionCompatibilityReturnsOnCall[len(fake.checkNodeGroupVersionCompatibilityArgsForCall)]
+	fake.checkNodeGroupVersionCompatibilityArgsForCall = append(fake.checkNodeGroupVersionCompatibilityArgsForCall, struct {
 		arg1 context.Context
-		arg2 *types.Stack
+	}{arg1})
+	stub := fake.CheckNodeGroupVersionCompatibilityStub
+	fakeReturns := fake.checkNodeGroupVersionCompatibilityReturns
+	fake.recordInvocation("CheckNodeGroupVersionCompatibility", []interface{}{arg1})
+	fake.checkNodeGroupVersionCompatibilityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) CheckNodeGroupVersionCompatibilityCallCount() int {
+	fake.checkNodeGroupVersionCompatibilityMutex.RLock()
+	defer fake.checkNodeGroupVersionCompatibilityMutex.RUnlock()
+	return len(fake.checkNodeGroupVersionCompatibilityArgsForCall)
+}
+
+func (fake *FakeStackManager) CheckNodeGroupVersionCompatibilityCalls(stub func(context.Context) ([]string, error)) {
+	fake.checkNodeGroupVersionCompatibilityMutex.Lock()
+	defer fake.checkNodeGroupVersionCompatibilityMutex.Unlock()
+	fake.CheckNodeGroupVersionCompatibilityStub = stub
+}
+
+func (fake *FakeStackManager) CheckNodeGroupVersionCompatibilityArgsForCall(i int) context.Context {
+	fake.checkNodeGroupVersionCompatibilityMutex.RLock()
+	defer fake.checkNodeGroupVersionCompatibilityMutex.RUnlock()
+	argsForCall := fake.checkNodeGroupVersionCompatibilityArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) CheckNodeGroupVersionCompatibilityReturns(result1 []string, result2 error) {
+	fake.checkNodeGroupVersionCompatibilityMutex.Lock()
+	defer fake.checkNodeGroupVersionCompatibilityMutex.Unlock()
+	fake.CheckNodeGroupVersionCompatibilityStub = nil
+	fake.checkNodeGroupVersionCompatibilityReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) CheckNodeGroupVersionCompatibilityReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.checkNodeGroupVersionCompatibilityMutex.Lock()
+	defer fake.checkNodeGroupVersionCompatibilityMutex.Unlock()
+	fake.CheckNodeGroupVersionCompatibilityStub = nil
+	if fake.checkNodeGroupVersionCompatibilityReturnsOnCall == nil {
+		fake.checkNodeGroupVersionCompatibilityReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.checkNodeGroupVersionCompatibilityReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) CollectStackOutputs(arg1 *types.Stack, arg2 interface{}) error {
+	fake.collectStackOutputsMutex.Lock()
+	ret, specificReturn := fake.collectStackOutputsReturnsOnCall[len(fake.collectStackOutputsArgsForCall)]
+	fake.collectStackOutputsArgsForCall = append(fake.collectStackOutputsArgsForCall, struct {
+		arg1 *types.Stack
+		arg2 interface{}
 	}{arg1, arg2})
-	stub := fake.DeleteStackSyncStub
-	fakeReturns := fake.deleteStackSyncReturns
-	fake.recordInvocation("DeleteStackSync", []interface{}{arg1, arg2})
-	fake.deleteStackSyncMutex.Unlock()
+	stub := fake.CollectStackOutputsStub
+	fakeReturns := fake.collectStackOutputsReturns
+	fake.recordInvocation("CollectStackOutputs", []interface{}{arg1, arg2})
+	fake.collectStackOutputsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -1067,317 +2345,332 @@ func (fake *FakeStackManager) DeleteStackSync(arg1 context.Context, arg2 *types.
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) DeleteStackSyncCallCount() int {
-	fake.deleteStackSyncMutex.RLock()
-	defer fake.deleteStackSyncMutex.RUnlock()
-	return len(fake.deleteStackSyncArgsForCall)
+func (fake *FakeStackManager) CollectStackOutputsCallCount() int {
+	fake.collectStackOutputsMutex.RLock()
+	defer fake.collectStackOutputsMutex.RUnlock()
+	return len(fake.collectStackOutputsArgsForCall)
 }
 
-func (fake *FakeStackManager) DeleteStackSyncCalls(stub func(context.Context, *types.Stack) error) {
-	fake.deleteStackSyncMutex.Lock()
-	defer fake.deleteStackSyncMutex.Unlock()
-	fake.DeleteStackSyncStub = stub
+func (fake *FakeStackManager) CollectStackOutputsCalls(stub func(*types.Stack, interface{}) error) {
+	fake.collectStackOutputsMutex.Lock()
+	defer fake.collectStackOutputsMutex.Unlock()
+	fake.CollectStackOutputsStub = stub
 }
 
-func (fake *FakeStackManager) DeleteStackSyncArgsForCall(i int) (context.Context, *types.Stack) {
-	fake.deleteStackSyncMutex.RLock()
-	defer fake.deleteStackSyncMutex.RUnlock()
-	argsForCall := fake.deleteStackSyncArgsForCall[i]
+func (fake *FakeStackManager) CollectStackOutputsArgsForCall(i int) (*types.Stack, interface{}) {
+	fake.collectStackOutputsMutex.RLock()
+	defer fake.collectStackOutputsMutex.RUnlock()
+	argsForCall := fake.collectStackOutputsArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) DeleteStackSyncReturns(result1 error) {
-	fake.deleteStackSyncMutex.Lock()
-	defer fake.deleteStackSyncMutex.Unlock()
-	fake.DeleteStackSyncStub = nil
-	fake.deleteStackSyncReturns = struct {
+func (fake *FakeStackManager) CollectStackOutputsReturns(result1 error) {
+	fake.collectStackOutputsMutex.Lock()
+	defer fake.collectStackOutputsMutex.Unlock()
+	fake.CollectStackOutputsStub = nil
+	fake.collectStackOutputsReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) DeleteStackSyncReturnsOnCall(i int, result1 error) {
-	fake.deleteStackSyncMutex.Lock()
-	defer fake.deleteStackSyncMutex.Unlock()
-	fake.DeleteStackSyncStub = nil
-	if fake.deleteStackSyncReturnsOnCall == nil {
-		fake.deleteStackSyncReturnsOnCall = make(map[int]struct {
+func (fake *FakeStackManager) CollectStackOutputsReturnsOnCall(i int, result1 error) {
+	fake.collectStackOutputsMutex.Lock()
+	defer fake.collectStackOutputsMutex.Unlock()
+	fake.CollectStackOutputsStub = nil
+	if fake.collectStackOutputsReturnsOnCall == nil {
+		fake.collectStackOutputsReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.deleteStackSyncReturnsOnCall[i] = struct {
+	fake.collectStackOutputsReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) DeleteTasksForDeprecatedStacks(arg1 context.Context) (*tasks.TaskTree, error) {
-	fake.deleteTasksForDeprecatedStacksMutex.Lock()
-	ret, specificReturn := fake.deleteTasksForDeprecatedStacksReturnsOnCall[len(fake.deleteTasksForDeprecatedStacksArgsForCall)]
-	fake.deleteTasksForDeprecatedStacksArgsForCall = append(fake.deleteTasksForDeprecatedStacksArgsForCall, struct {
+func (fake *FakeStackManager) CreateNodeGroupStackSet(arg1 context.Context, arg2 *v1alpha5.NodeGroup, arg3 []string) error {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.createNodeGroupStackSetMutex.Lock()
+	ret, specificReturn := fake.createNodeGroupStackSetReturnsOnCall[len(fake.createNodeGroupStackSetArgsForCall)]
+	fake.createNodeGroupStackSetArgsForCall = append(fake.createNodeGroupStackSetArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.DeleteTasksForDeprecatedStacksStub
-	fakeReturns := fake.deleteTasksForDeprecatedStacksReturns
-	fake.recordInvocation("DeleteTasksForDeprecatedStacks", []interface{}{arg1})
-	fake.deleteTasksForDeprecatedStacksMutex.Unlock()
+		arg2 *v1alpha5.NodeGroup
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.CreateNodeGroupStackSetStub
+	fakeReturns := fake.createNodeGroupStackSetReturns
+	fake.recordInvocation("CreateNodeGroupStackSet", []interface{}{arg1, arg2, arg3Copy})
+	fake.createNodeGroupStackSetMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) DeleteTasksForDeprecatedStacksCallCount() int {
-	fake.deleteTasksForDeprecatedStacksMutex.RLock()
-	defer fake.deleteTasksForDeprecatedStacksMutex.RUnlock()
-	return len(fake.deleteTasksForDeprecatedStacksArgsForCall)
+func (fake *FakeStackManager) CreateNodeGroupStackSetCallCount() int {
+	fake.createNodeGroupStackSetMutex.RLock()
+	defer fake.createNodeGroupStackSetMutex.RUnlock()
+	return len(fake.createNodeGroupStackSetArgsForCall)
 }
 
-func (fake *FakeStackManager) DeleteTasksForDeprecatedStacksCalls(stub func(context.Context) (*tasks.TaskTree, error)) {
-	fake.deleteTasksForDeprecatedStacksMutex.Lock()
-	defer fake.deleteTasksForDeprecatedStacksMutex.Unlock()
-	fake.DeleteTasksForDeprecatedStacksStub = stub
+func (fake *FakeStackManager) CreateNodeGroupStackSetCalls(stub func(context.Context, *v1alpha5.NodeGroup, []string) error) {
+	fake.createNodeGroupStackSetMutex.Lock()
+	defer fake.createNodeGroupStackSetMutex.Unlock()
+	fake.CreateNodeGroupStackSetStub = stub
 }
 
-func (fake *FakeStackManager) DeleteTasksForDeprecatedStacksArgsForCall(i int) context.Context {
-	fake.deleteTasksForDeprecatedStacksMutex.RLock()
-	defer fake.deleteTasksForDeprecatedStacksMutex.RUnlock()
-	argsForCall := fake.deleteTasksForDeprecatedStacksArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) CreateNodeGroupStackSetArgsForCall(i int) (context.Context, *v1alpha5.NodeGroup, []string) {
+	fake.createNodeGroupStackSetMutex.RLock()
+	defer fake.createNodeGroupStackSetMutex.RUnlock()
+	argsForCall := fake.createNodeGroupStackSetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) DeleteTasksForDeprecatedStacksReturns(result1 *tasks.TaskTree, result2 error) {
-	fake.deleteTasksForDeprecatedStacksMutex.Lock()
-	defer fake.deleteTasksForDeprecatedStacksMutex.Unlock()
-	fake.DeleteTasksForDeprecatedStacksStub = nil
-	fake.deleteTasksForDeprecatedStacksReturns = struct {
-		result1 *tasks.TaskTree
-		result2 error
-	}{result1, result2}
+func (fake *FakeStackManager) CreateNodeGroupStackSetReturns(result1 error) {
+	fake.createNodeGroupStackSetMutex.Lock()
+	defer fake.createNodeGroupStackSetMutex.Unlock()
+	fake.CreateNodeGroupStackSetStub = nil
+	fake.createNodeGroupStackSetReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DeleteTasksForDeprecatedStacksReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
-	fake.deleteTasksForDeprecatedStacksMutex.Lock()
-	defer fake.deleteTasksForDeprecatedStacksMutex.Unlock()
-	fake.DeleteTasksForDeprecatedStacksStub = nil
-	if fake.deleteTasksForDeprecatedStacksReturnsOnCall == nil {
-		fake.deleteTasksForDeprecatedStacksReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
-			result2 error
+func (fake *FakeStackManager) CreateNodeGroupStackSetReturnsOnCall(i int, result1 error) {
+	fake.createNodeGroupStackSetMutex.Lock()
+	defer fake.createNodeGroupStackSetMutex.Unlock()
+	fake.CreateNodeGroupStackSetStub = nil
+	if fake.createNodeGroupStackSetReturnsOnCall == nil {
+		fake.createNodeGroupStackSetReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.deleteTasksForDeprecatedStacksReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
-		result2 error
-	}{result1, result2}
+	fake.createNodeGroupStackSetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DescribeClusterStack(arg1 context.Context) (*types.Stack, error) {
-	fake.describeClusterStackMutex.Lock()
-	ret, specificReturn := fake.describeClusterStackReturnsOnCall[len(fake.describeClusterStackArgsForCall)]
-	fake.describeClusterStackArgsForCall = append(fake.describeClusterStackArgsForCall, struct {
+func (fake *FakeStackManager) CreateNodeGroupStacks(arg1 context.Context, arg2 []manager.NodeGroupSpec, arg3 int) error {
+	var arg2Copy []manager.NodeGroupSpec
+	if arg2 != nil {
+		arg2Copy = make([]manager.NodeGroupSpec, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.createNodeGroupStacksMutex.Lock()
+	ret, specificReturn := fake.createNodeGroupStacksReturnsOnCall[len(fake.createNodeGroupStacksArgsForCall)]
+	fake.createNodeGroupStacksArgsForCall = append(fake.createNodeGroupStacksArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.DescribeClusterStackStub
-	fakeReturns := fake.describeClusterStackReturns
-	fake.recordInvocation("DescribeClusterStack", []interface{}{arg1})
-	fake.describeClusterStackMutex.Unlock()
+		arg2 []manager.NodeGroupSpec
+		arg3 int
+	}{arg1, arg2Copy, arg3})
+	stub := fake.CreateNodeGroupStacksStub
+	fakeReturns := fake.createNodeGroupStacksReturns
+	fake.recordInvocation("CreateNodeGroupStacks", []interface{}{arg1, arg2Copy, arg3})
+	fake.createNodeGroupStacksMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) DescribeClusterStackCallCount() int {
-	fake.describeClusterStackMutex.RLock()
-	defer fake.describeClusterStackMutex.RUnlock()
-	return len(fake.describeClusterStackArgsForCall)
+func (fake *FakeStackManager) CreateNodeGroupStacksCallCount() int {
+	fake.createNodeGroupStacksMutex.RLock()
+	defer fake.createNodeGroupStacksMutex.RUnlock()
+	return len(fake.createNodeGroupStacksArgsForCall)
 }
 
-func (fake *FakeStackManager) DescribeClusterStackCalls(stub func(context.Context) (*types.Stack, error)) {
-	fake.describeClusterStackMutex.Lock()
-	defer fake.describeClusterStackMutex.Unlock()
-	fake.DescribeClusterStackStub = stub
+func (fake *FakeStackManager) CreateNodeGroupStacksCalls(stub func(context.Context, []manager.NodeGroupSpec, int) error) {
+	fake.createNodeGroupStacksMutex.Lock()
+	defer fake.createNodeGroupStacksMutex.Unlock()
+	fake.CreateNodeGroupStacksStub = stub
 }
 
-func (fake *FakeStackManager) DescribeClusterStackArgsForCall(i int) context.Context {
-	fake.describeClusterStackMutex.RLock()
-	defer fake.describeClusterStackMutex.RUnlock()
-	argsForCall := fake.describeClusterStackArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) CreateNodeGroupStacksArgsForCall(i int) (context.Context, []manager.NodeGroupSpec, int) {
+	fake.createNodeGroupStacksMutex.RLock()
+	defer fake.createNodeGroupStacksMutex.RUnlock()
+	argsForCall := fake.createNodeGroupStacksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) DescribeClusterStackReturns(result1 *types.Stack, result2 error) {
-	fake.describeClusterStackMutex.Lock()
-	defer fake.describeClusterStackMutex.Unlock()
-	fake.DescribeClusterStackStub = nil
-	fake.describeClusterStackReturns = struct {
-		result1 *types.Stack
-		result2 error
-	}{result1, result2}
+func (fake *FakeStackManager) CreateNodeGroupStacksReturns(result1 error) {
+	fake.createNodeGroupStacksMutex.Lock()
+	defer fake.createNodeGroupStacksMutex.Unlock()
+	fake.CreateNodeGroupStacksStub = nil
+	fake.createNodeGroupStacksReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DescribeClusterStackReturnsOnCall(i int, result1 *types.Stack, result2 error) {
-	fake.describeClusterStackMutex.Lock()
-	defer fake.describeClusterStackMutex.Unlock()
-	fake.DescribeClusterStackStub = nil
-	if fake.describeClusterStackReturnsOnCall == nil {
-		fake.describeClusterStackReturnsOnCall = make(map[int]struct {
-			result1 *types.Stack
-			result2 error
+func (fake *FakeStackManager) CreateNodeGroupStacksReturnsOnCall(i int, result1 error) {
+	fake.createNodeGroupStacksMutex.Lock()
+	defer fake.createNodeGroupStacksMutex.Unlock()
+	fake.CreateNodeGroupStacksStub = nil
+	if fake.createNodeGroupStacksReturnsOnCall == nil {
+		fake.createNodeGroupStacksReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.describeClusterStackReturnsOnCall[i] = struct {
-		result1 *types.Stack
-		result2 error
-	}{result1, result2}
+	fake.createNodeGroupStacksReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DescribeIAMServiceAccountStacks(arg1 context.Context) ([]*types.Stack, error) {
-	fake.describeIAMServiceAccountStacksMutex.Lock()
-	ret, specificReturn := fake.describeIAMServiceAccountStacksReturnsOnCall[len(fake.describeIAMServiceAccountStacksArgsForCall)]
-	fake.describeIAMServiceAccountStacksArgsForCall = append(fake.describeIAMServiceAccountStacksArgsForCall, struct {
+func (fake *FakeStackManager) CreateStack(arg1 context.Context, arg2 string, arg3 builder.ResourceSetReader, arg4 map[string]string, arg5 map[string]string, arg6 chan error) error {
+	fake.createStackMutex.Lock()
+	ret, specificReturn := fake.createStackReturnsOnCall[len(fake.createStackArgsForCall)]
+	fake.createStackArgsForCall = append(fake.createStackArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.DescribeIAMServiceAccountStacksStub
-	fakeReturns := fake.describeIAMServiceAccountStacksReturns
-	fake.recordInvocation("DescribeIAMServiceAccountStacks", []interface{}{arg1})
-	fake.describeIAMServiceAccountStacksMutex.Unlock()
+		arg2 string
+		arg3 builder.ResourceSetReader
+		arg4 map[string]string
+		arg5 map[string]string
+		arg6 chan error
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.CreateStackStub
+	fakeReturns := fake.createStackReturns
+	fake.recordInvocation("CreateStack", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.createStackMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) DescribeIAMServiceAccountStacksCallCount() int {
-	fake.describeIAMServiceAccountStacksMutex.RLock()
-	defer fake.describeIAMServiceAccountStacksMutex.RUnlock()
-	return len(fake.describeIAMServiceAccountStacksArgsForCall)
+func (fake *FakeStackManager) CreateStackCallCount() int {
+	fake.createStackMutex.RLock()
+	defer fake.createStackMutex.RUnlock()
+	return len(fake.createStackArgsForCall)
 }
 
-func (fake *FakeStackManager) DescribeIAMServiceAccountStacksCalls(stub func(context.Context) ([]*types.Stack, error)) {
-	fake.describeIAMServiceAccountStacksMutex.Lock()
-	defer fake.describeIAMServiceAccountStacksMutex.Unlock()
-	fake.DescribeIAMServiceAccountStacksStub = stub
+func (fake *FakeStackManager) CreateStackCalls(stub func(context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string, chan error) error) {
+	fake.createStackMutex.Lock()
+	defer fake.createStackMutex.Unlock()
+	fake.CreateStackStub = stub
 }
 
-func (fake *FakeStackManager) DescribeIAMServiceAccountStacksArgsForCall(i int) context.Context {
-	fake.describeIAMServiceAccountStacksMutex.RLock()
-	defer fake.describeIAMServiceAccountStacksMutex.RUnlock()
-	argsForCall := fake.describeIAMServiceAccountStacksArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) CreateStackArgsForCall(i int) (context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string, chan error) {
+	fake.createStackMutex.RLock()
+	defer fake.createStackMutex.RUnlock()
+	argsForCall := fake.createStackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
-func (fake *FakeStackManager) DescribeIAMServiceAccountStacksReturns(result1 []*types.Stack, result2 error) {
-	fake.describeIAMServiceAccountStacksMutex.Lock()
-	defer fake.describeIAMServiceAccountStacksMutex.Unlock()
-	fake.DescribeIAMServiceAccountStacksStub = nil
-	fake.describeIAMServiceAccountStacksReturns = struct {
-		result1 []*types.Stack
-		result2 error
-	}{result1, result2}
+func (fake *FakeStackManager) CreateStackReturns(result1 error) {
+	fake.createStackMutex.Lock()
+	defer fake.createStackMutex.Unlock()
+	fake.CreateStackStub = nil
+	fake.createStackReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DescribeIAMServiceAccountStacksReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
-	fake.describeIAMServiceAccountStacksMutex.Lock()
-	defer fake.describeIAMServiceAccountStacksMutex.Unlock()
-	fake.DescribeIAMServiceAccountStacksStub = nil
-	if fake.describeIAMServiceAccountStacksReturnsOnCall == nil {
-		fake.describeIAMServiceAccountStacksReturnsOnCall = make(map[int]struct {
-			result1 []*types.Stack
-			result2 error
+func (fake *FakeStackManager) CreateStackReturnsOnCall(i int, result1 error) {
+	fake.createStackMutex.Lock()
+	defer fake.createStackMutex.Unlock()
+	fake.CreateStackStub = nil
+	if fake.createStackReturnsOnCall == nil {
+		fake.createStackReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.describeIAMServiceAccountStacksReturnsOnCall[i] = struct {
-		result1 []*types.Stack
-		result2 error
-	}{result1, result2}
+	fake.createStackReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStack(arg1 context.Context, arg2 string) (*types.Stack, error) {
-	fake.describeNodeGroupStackMutex.Lock()
-	ret, specificReturn := fake.describeNodeGroupStackReturnsOnCall[len(fake.describeNodeGroupStackArgsForCall)]
-	fake.describeNodeGroupStackArgsForCall = append(fake.describeNodeGroupStackArgsForCall, struct {
+func (fake *FakeStackManager) CreateStackIfNotExists(arg1 context.Context, arg2 string, arg3 builder.ResourceSetReader, arg4 map[string]string, arg5 map[string]string, arg6 chan error) error {
+	fake.createStackIfNotExistsMutex.Lock()
+	ret, specificReturn := fake.createStackIfNotExistsReturnsOnCall[len(fake.createStackIfNotExistsArgsForCall)]
+	fake.createStackIfNotExistsArgsForCall = append(fake.createStackIfNotExistsArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-	}{arg1, arg2})
-	stub := fake.DescribeNodeGroupStackStub
-	fakeReturns := fake.describeNodeGroupStackReturns
-	fake.recordInvocation("DescribeNodeGroupStack", []interface{}{arg1, arg2})
-	fake.describeNodeGroupStackMutex.Unlock()
+		arg3 builder.ResourceSetReader
+		arg4 map[string]string
+		arg5 map[string]string
+		arg6 chan error
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.CreateStackIfNotExistsStub
+	fakeReturns := fake.createStackIfNotExistsReturns
+	fake.recordInvocation("CreateStackIfNotExists", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.createStackIfNotExistsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStackCallCount() int {
-	fake.describeNodeGroupStackMutex.RLock()
-	defer fake.describeNodeGroupStackMutex.RUnlock()
-	return len(fake.describeNodeGroupStackArgsForCall)
+func (fake *FakeStackManager) CreateStackIfNotExistsCallCount() int {
+	fake.createStackIfNotExistsMutex.RLock()
+	defer fake.createStackIfNotExistsMutex.RUnlock()
+	return len(fake.createStackIfNotExistsArgsForCall)
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStackCalls(stub func(context.Context, string) (*types.Stack, error)) {
-	fake.describeNodeGroupStackMutex.Lock()
-	defer fake.describeNodeGroupStackMutex.Unlock()
-	fake.DescribeNodeGroupStackStub = stub
+func (fake *FakeStackManager) CreateStackIfNotExistsCalls(stub func(context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string, chan error) error) {
+	fake.createStackIfNotExistsMutex.Lock()
+	defer fake.createStackIfNotExistsMutex.Unlock()
+	fake.CreateStackIfNotExistsStub = stub
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStackArgsForCall(i int) (context.Context, string) {
-	fake.describeNodeGroupStackMutex.RLock()
-	defer fake.describeNodeGroupStackMutex.RUnlock()
-	argsForCall := fake.describeNodeGroupStackArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeStackManager) CreateStackIfNotExistsArgsForCall(i int) (context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string, chan error) {
+	fake.createStackIfNotExistsMutex.RLock()
+	defer fake.createStackIfNotExistsMutex.RUnlock()
+	argsForCall := fake.createStackIfNotExistsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStackReturns(result1 *types.Stack, result2 error) {
-	fake.describeNodeGroupStackMutex.Lock()
-	defer fake.describeNodeGroupStackMutex.Unlock()
-	fake.DescribeNodeGroupStackStub = nil
-	fake.describeNodeGroupStackReturns = struct {
-		result1 *types.Stack
-		result2 error
-	}{result1, result2}
+func (fake *FakeStackManager) CreateStackIfNotExistsReturns(result1 error) {
+	fake.createStackIfNotExistsMutex.Lock()
+	defer fake.createStackIfNotExistsMutex.Unlock()
+	fake.CreateStackIfNotExistsStub = nil
+	fake.createStackIfNotExistsReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStackReturnsOnCall(i int, result1 *types.Stack, result2 error) {
-	fake.describeNodeGroupStackMutex.Lock()
-	defer fake.describeNodeGroupStackMutex.Unlock()
-	fake.DescribeNodeGroupStackStub = nil
-	if fake.describeNodeGroupStackReturnsOnCall == nil {
-		fake.describeNodeGroupStackReturnsOnCall = make(map[int]struct {
-			result1 *types.Stack
-			result2 error
+func (fake *FakeStackManager) CreateStackIfNotExistsReturnsOnCall(i int, result1 error) {
+	fake.createStackIfNotExistsMutex.Lock()
+	defer fake.createStackIfNotExistsMutex.Unlock()
+	fake.CreateStackIfNotExistsStub = nil
+	if fake.createStackIfNotExistsReturnsOnCall == nil {
+		fake.createStackIfNotExistsReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.describeNodeGroupStackReturnsOnCall[i] = struct {
-		result1 *types.Stack
-		result2 error
-	}{result1, result2}
+	fake.createStackIfNotExistsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStacks(arg1 context.Context) ([]*types.Stack, error) {
-	fake.describeNodeGroupStacksMutex.Lock()
-	ret, specificReturn := fake.describeNodeGroupStacksReturnsOnCall[len(fake.describeNodeGroupStacksArgsForCall)]
-	fake.describeNodeGroupStacksArgsForCall = append(fake.describeNodeGroupStacksArgsForCall, struct {
+func (fake *FakeStackManager) CreateStackNoWait(arg1 context.Context, arg2 string, arg3 builder.ResourceSetReader, arg4 map[string]string, arg5 map[string]string) (string, error) {
+	fake.createStackNoWaitMutex.Lock()
+	ret, specificReturn := fake.createStackNoWaitReturnsOnCall[len(fake.createStackNoWaitArgsForCall)]
+	fake.createStackNoWaitArgsForCall = append(fake.createStackNoWaitArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.DescribeNodeGroupStacksStub
-	fakeReturns := fake.describeNodeGroupStacksReturns
-	fake.recordInvocation("DescribeNodeGroupStacks", []interface{}{arg1})
-	fake.describeNodeGroupStacksMutex.Unlock()
+		arg2 string
+		arg3 builder.ResourceSetReader
+		arg4 map[string]string
+		arg5 map[string]string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.CreateStackNoWaitStub
+	fakeReturns := fake.createStackNoWaitReturns
+	fake.recordInvocation("CreateStackNoWait", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.createStackNoWaitMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1385,257 +2678,331 @@ func (fake *FakeStackManager) DescribeNodeGroupStacks(arg1 context.Context) ([]*
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStacksCallCount() int {
-	fake.describeNodeGroupStacksMutex.RLock()
-	defer fake.describeNodeGroupStacksMutex.RUnlock()
-	return len(fake.describeNodeGroupStacksArgsForCall)
+func (fake *FakeStackManager) CreateStackNoWaitCallCount() int {
+	fake.createStackNoWaitMutex.RLock()
+	defer fake.createStackNoWaitMutex.RUnlock()
+	return len(fake.createStackNoWaitArgsForCall)
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStacksCalls(stub func(context.Context) ([]*types.Stack, error)) {
-	fake.describeNodeGroupStacksMutex.Lock()
-	defer fake.describeNodeGroupStacksMutex.Unlock()
-	fake.DescribeNodeGroupStacksStub = stub
+func (fake *FakeStackManager) CreateStackNoWaitCalls(stub func(context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string) (string, error)) {
+	fake.createStackNoWaitMutex.Lock()
+	defer fake.createStackNoWaitMutex.Unlock()
+	fake.CreateStackNoWaitStub = stub
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStacksArgsForCall(i int) context.Context {
-	fake.describeNodeGroupStacksMutex.RLock()
-	defer fake.describeNodeGroupStacksMutex.RUnlock()
-	argsForCall := fake.describeNodeGroupStacksArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) CreateStackNoWaitArgsForCall(i int) (context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string) {
+	fake.createStackNoWaitMutex.RLock()
+	defer fake.createStackNoWaitMutex.RUnlock()
+	argsForCall := fake.createStackNoWaitArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStacksReturns(result1 []*types.Stack, result2 error) {
-	fake.describeNodeGroupStacksMutex.Lock()
-	defer fake.describeNodeGroupStacksMutex.Unlock()
-	fake.DescribeNodeGroupStacksStub = nil
-	fake.describeNodeGroupStacksReturns = struct {
-		result1 []*types.Stack
+func (fake *FakeStackManager) CreateStackNoWaitReturns(result1 string, result2 error) {
+	fake.createStackNoWaitMutex.Lock()
+	defer fake.createStackNoWaitMutex.Unlock()
+	fake.CreateStackNoWaitStub = nil
+	fake.createStackNoWaitReturns = struct {
+		result1 string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStacksReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
-	fake.describeNodeGroupStacksMutex.Lock()
-	defer fake.describeNodeGroupStacksMutex.Unlock()
-	fake.DescribeNodeGroupStacksStub = nil
-	if fake.describeNodeGroupStacksReturnsOnCall == nil {
-		fake.describeNodeGroupStacksReturnsOnCall = make(map[int]struct {
-			result1 []*types.Stack
+func (fake *FakeStackManager) CreateStackNoWaitReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createStackNoWaitMutex.Lock()
+	defer fake.createStackNoWaitMutex.Unlock()
+	fake.CreateStackNoWaitStub = nil
+	if fake.createStackNoWaitReturnsOnCall == nil {
+		fake.createStackNoWaitReturnsOnCall = make(map[int]struct {
+			result1 string
 			result2 error
 		})
 	}
-	fake.describeNodeGroupStacksReturnsOnCall[i] = struct {
-		result1 []*types.Stack
+	fake.createStackNoWaitReturnsOnCall[i] = struct {
+		result1 string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStacksAndResources(arg1 context.Context) (map[string]manager.StackInfo, error) {
-	fake.describeNodeGroupStacksAndResourcesMutex.Lock()
-	ret, specificReturn := fake.describeNodeGroupStacksAndResourcesReturnsOnCall[len(fake.describeNodeGroupStacksAndResourcesArgsForCall)]
-	fake.describeNodeGroupStacksAndResourcesArgsForCall = append(fake.describeNodeGroupStacksAndResourcesArgsForCall, struct {
+func (fake *FakeStackManager) CreateStackWithCapabilities(arg1 context.Context, arg2 string, arg3 builder.ResourceSetReader, arg4 map[string]string, arg5 map[string]string, arg6 []string, arg7 chan error) error {
+	var arg6Copy []string
+	if arg6 != nil {
+		arg6Copy = make([]string, len(arg6))
+		copy(arg6Copy, arg6)
+	}
+	fake.createStackWithCapabilitiesMutex.Lock()
+	ret, specificReturn := fake.createStackWithCapabilitiesReturnsOnCall[len(fake.createStackWithCapabilitiesArgsForCall)]
+	fake.createStackWithCapabilitiesArgsForCall = append(fake.createStackWithCapabilitiesArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.DescribeNodeGroupStacksAndResourcesStub
-	fakeReturns := fake.describeNodeGroupStacksAndResourcesReturns
-	fake.recordInvocation("DescribeNodeGroupStacksAndResources", []interface{}{arg1})
-	fake.describeNodeGroupStacksAndResourcesMutex.Unlock()
+		arg2 string
+		arg3 builder.ResourceSetReader
+		arg4 map[string]string
+		arg5 map[string]string
+		arg6 []string
+		arg7 chan error
+	}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7})
+	stub := fake.CreateStackWithCapabilitiesStub
+	fakeReturns := fake.createStackWithCapabilitiesReturns
+	fake.recordInvocation("CreateStackWithCapabilities", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7})
+	fake.createStackWithCapabilitiesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStacksAndResourcesCallCount() int {
-	fake.describeNodeGroupStacksAndResourcesMutex.RLock()
-	defer fake.describeNodeGroupStacksAndResourcesMutex.RUnlock()
-	return len(fake.describeNodeGroupStacksAndResourcesArgsForCall)
+func (fake *FakeStackManager) CreateStackWithCapabilitiesCallCount() int {
+	fake.createStackWithCapabilitiesMutex.RLock()
+	defer fake.createStackWithCapabilitiesMutex.RUnlock()
+	return len(fake.createStackWithCapabilitiesArgsForCall)
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStacksAndResourcesCalls(stub func(context.Context) (map[string]manager.StackInfo, error)) {
-	fake.describeNodeGroupStacksAndResourcesMutex.Lock()
-	defer fake.describeNodeGroupStacksAndResourcesMutex.Unlock()
-	fake.DescribeNodeGroupStacksAndResourcesStub = stub
+func (fake *FakeStackManager) CreateStackWithCapabilitiesCalls(stub func(context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string, []string, chan error) error) {
+	fake.createStackWithCapabilitiesMutex.Lock()
+	defer fake.createStackWithCapabilitiesMutex.Unlock()
+	fake.CreateStackWithCapabilitiesStub = stub
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStacksAndResourcesArgsForCall(i int) context.Context {
-	fake.describeNodeGroupStacksAndResourcesMutex.RLock()
-	defer fake.describeNodeGroupStacksAndResourcesMutex.RUnlock()
-	argsForCall := fake.describeNodeGroupStacksAndResourcesArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) CreateStackWithCapabilitiesArgsForCall(i int) (context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string, []string, chan error) {
+	fake.createStackWithCapabilitiesMutex.RLock()
+	defer fake.createStackWithCapabilitiesMutex.RUnlock()
+	argsForCall := fake.createStackWithCapabilitiesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStacksAndResourcesReturns(result1 map[string]manager.StackInfo, result2 error) {
-	fake.describeNodeGroupStacksAndResourcesMutex.Lock()
-	defer fake.describeNodeGroupStacksAndResourcesMutex.Unlock()
-	fake.DescribeNodeGroupStacksAndResourcesStub = nil
-	fake.describeNodeGroupStacksAndResourcesReturns = struct {
-		result1 map[string]manager.StackInfo
-		result2 error
-	}{result1, result2}
+func (fake *FakeStackManager) CreateStackWithCapabilitiesReturns(result1 error) {
+	fake.createStackWithCapabilitiesMutex.Lock()
+	defer fake.createStackWithCapabilitiesMutex.Unlock()
+	fake.CreateStackWithCapabilitiesStub = nil
+	fake.createStackWithCapabilitiesReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DescribeNodeGroupStacksAndResourcesReturnsOnCall(i int, result1 map[string]manager.StackInfo, result2 error) {
-	fake.describeNodeGroupStacksAndResourcesMutex.Lock()
-	defer fake.describeNodeGroupStacksAndResourcesMutex.Unlock()
-	fake.DescribeNodeGroupStacksAndResourcesStub = nil
-	if fake.describeNodeGroupStacksAndResourcesReturnsOnCall == nil {
-		fake.describeNodeGroupStacksAndResourcesReturnsOnCall = make(map[int]struct {
-			result1 map[string]manager.StackInfo
-			result2 error
+func (fake *FakeStackManager) CreateStackWithCapabilitiesReturnsOnCall(i int, result1 error) {
+	fake.createStackWithCapabilitiesMutex.Lock()
+	defer fake.createStackWithCapabilitiesMutex.Unlock()
+	fake.CreateStackWithCapabilitiesStub = nil
+	if fake.createStackWithCapabilitiesReturnsOnCall == nil {
+		fake.createStackWithCapabilitiesReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.describeNodeGroupStacksAndResourcesReturnsOnCall[i] = struct {
-		result1 map[string]manager.StackInfo
-		result2 error
-	}{result1, result2}
+	fake.createStackWithCapabilitiesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DescribeStack(arg1 context.Context, arg2 *types.Stack) (*types.Stack, error) {
-	fake.describeStackMutex.Lock()
-	ret, specificReturn := fake.describeStackReturnsOnCall[len(fake.describeStackArgsForCall)]
-	fake.describeStackArgsForCall = append(fake.describeStackArgsForCall, struct {
+func (fake *FakeStackManager) CreateStackWithEventHandler(arg1 context.Context, arg2 string, arg3 builder.ResourceSetReader, arg4 map[string]string, arg5 map[string]string, arg6 func(manager.StackEvent), arg7 chan error) error {
+	fake.createStackWithEventHandlerMutex.Lock()
+	ret, specificReturn := fake.createStackWithEventHandlerReturnsOnCall[len(fake.createStackWithEventHandlerArgsForCall)]
+	fake.createStackWithEventHandlerArgsForCall = append(fake.createStackWithEventHandlerArgsForCall, struct {
 		arg1 context.Context
-		arg2 *types.Stack
+		arg2 string
+		arg3 builder.ResourceSetReader
+		arg4 map[string]string
+		arg5 map[string]string
+		arg6 func(manager.StackEvent)
+		arg7 chan error
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	stub := fake.CreateStackWithEventHandlerStub
+	fakeReturns := fake.createStackWithEventHandlerReturns
+	fake.recordInvocation("CreateStackWithEventHandler", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.createStackWithEventHandlerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) CreateStackWithEventHandlerCallCount() int {
+	fake.createStackWithEventHandlerMutex.RLock()
+	defer fake.createStackWithEventHandlerMutex.RUnlock()
+	return len(fake.createStackWithEventHandlerArgsForCall)
+}
+
+func (fake *FakeStackManager) CreateStackWithEventHandlerCalls(stub func(context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string, func(manager.StackEvent), chan error) error) {
+	fake.createStackWithEventHandlerMutex.Lock()
+	defer fake.createStackWithEventHandlerMutex.Unlock()
+	fake.CreateStackWithEventHandlerStub = stub
+}
+
+func (fake *FakeStackManager) CreateStackWithEventHandlerArgsForCall(i int) (context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string, func(manager.StackEvent), chan error) {
+	fake.createStackWithEventHandlerMutex.RLock()
+	defer fake.createStackWithEventHandlerMutex.RUnlock()
+	argsForCall := fake.createStackWithEventHandlerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeStackManager) CreateStackWithEventHandlerReturns(result1 error) {
+	fake.createStackWithEventHandlerMutex.Lock()
+	defer fake.createStackWithEventHandlerMutex.Unlock()
+	fake.CreateStackWithEventHandlerStub = nil
+	fake.createStackWithEventHandlerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) CreateStackWithEventHandlerReturnsOnCall(i int, result1 error) {
+	fake.createStackWithEventHandlerMutex.Lock()
+	defer fake.createStackWithEventHandlerMutex.Unlock()
+	fake.CreateStackWithEventHandlerStub = nil
+	if fake.createStackWithEventHandlerReturnsOnCall == nil {
+		fake.createStackWithEventHandlerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createStackWithEventHandlerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) DeleteNodeGroupStackSet(arg1 context.Context, arg2 string) error {
+	fake.deleteNodeGroupStackSetMutex.Lock()
+	ret, specificReturn := fake.deleteNodeGroupStackSetReturnsOnCall[len(fake.deleteNodeGroupStackSetArgsForCall)]
+	fake.deleteNodeGroupStackSetArgsForCall = append(fake.deleteNodeGroupStackSetArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
 	}{arg1, arg2})
-	stub := fake.DescribeStackStub
-	fakeReturns := fake.describeStackReturns
-	fake.recordInvocation("DescribeStack", []interface{}{arg1, arg2})
-	fake.describeStackMutex.Unlock()
+	stub := fake.DeleteNodeGroupStackSetStub
+	fakeReturns := fake.deleteNodeGroupStackSetReturns
+	fake.recordInvocation("DeleteNodeGroupStackSet", []interface{}{arg1, arg2})
+	fake.deleteNodeGroupStackSetMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) DescribeStackCallCount() int {
-	fake.describeStackMutex.RLock()
-	defer fake.describeStackMutex.RUnlock()
-	return len(fake.describeStackArgsForCall)
+func (fake *FakeStackManager) DeleteNodeGroupStackSetCallCount() int {
+	fake.deleteNodeGroupStackSetMutex.RLock()
+	defer fake.deleteNodeGroupStackSetMutex.RUnlock()
+	return len(fake.deleteNodeGroupStackSetArgsForCall)
 }
 
-func (fake *FakeStackManager) DescribeStackCalls(stub func(context.Context, *types.Stack) (*types.Stack, error)) {
-	fake.describeStackMutex.Lock()
-	defer fake.describeStackMutex.Unlock()
-	fake.DescribeStackStub = stub
+func (fake *FakeStackManager) DeleteNodeGroupStackSetCalls(stub func(context.Context, string) error) {
+	fake.deleteNodeGroupStackSetMutex.Lock()
+	defer fake.deleteNodeGroupStackSetMutex.Unlock()
+	fake.DeleteNodeGroupStackSetStub = stub
 }
 
-func (fake *FakeStackManager) DescribeStackArgsForCall(i int) (context.Context, *types.Stack) {
-	fake.describeStackMutex.RLock()
-	defer fake.describeStackMutex.RUnlock()
-	argsForCall := fake.describeStackArgsForCall[i]
+func (fake *FakeStackManager) DeleteNodeGroupStackSetArgsForCall(i int) (context.Context, string) {
+	fake.deleteNodeGroupStackSetMutex.RLock()
+	defer fake.deleteNodeGroupStackSetMutex.RUnlock()
+	argsForCall := fake.deleteNodeGroupStackSetArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) DescribeStackReturns(result1 *types.Stack, result2 error) {
-	fake.describeStackMutex.Lock()
-	defer fake.describeStackMutex.Unlock()
-	fake.DescribeStackStub = nil
-	fake.describeStackReturns = struct {
-		result1 *types.Stack
-		result2 error
-	}{result1, result2}
+func (fake *FakeStackManager) DeleteNodeGroupStackSetReturns(result1 error) {
+	fake.deleteNodeGroupStackSetMutex.Lock()
+	defer fake.deleteNodeGroupStackSetMutex.Unlock()
+	fake.DeleteNodeGroupStackSetStub = nil
+	fake.deleteNodeGroupStackSetReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DescribeStackReturnsOnCall(i int, result1 *types.Stack, result2 error) {
-	fake.describeStackMutex.Lock()
-	defer fake.describeStackMutex.Unlock()
-	fake.DescribeStackStub = nil
-	if fake.describeStackReturnsOnCall == nil {
-		fake.describeStackReturnsOnCall = make(map[int]struct {
-			result1 *types.Stack
-			result2 error
+func (fake *FakeStackManager) DeleteNodeGroupStackSetReturnsOnCall(i int, result1 error) {
+	fake.deleteNodeGroupStackSetMutex.Lock()
+	defer fake.deleteNodeGroupStackSetMutex.Unlock()
+	fake.DeleteNodeGroupStackSetStub = nil
+	if fake.deleteNodeGroupStackSetReturnsOnCall == nil {
+		fake.deleteNodeGroupStackSetReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.describeStackReturnsOnCall[i] = struct {
-		result1 *types.Stack
-		result2 error
-	}{result1, result2}
+	fake.deleteNodeGroupStackSetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DescribeStackChangeSet(arg1 context.Context, arg2 *types.Stack, arg3 string) (*cloudformation.DescribeChangeSetOutput, error) {
-	fake.describeStackChangeSetMutex.Lock()
-	ret, specificReturn := fake.describeStackChangeSetReturnsOnCall[len(fake.describeStackChangeSetArgsForCall)]
-	fake.describeStackChangeSetArgsForCall = append(fake.describeStackChangeSetArgsForCall, struct {
+func (fake *FakeStackManager) DeleteNodeGroupStacks(arg1 context.Context, arg2 []string, arg3 manager.DeleteOptions) error {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.deleteNodeGroupStacksMutex.Lock()
+	ret, specificReturn := fake.deleteNodeGroupStacksReturnsOnCall[len(fake.deleteNodeGroupStacksArgsForCall)]
+	fake.deleteNodeGroupStacksArgsForCall = append(fake.deleteNodeGroupStacksArgsForCall, struct {
 		arg1 context.Context
-		arg2 *types.Stack
-		arg3 string
-	}{arg1, arg2, arg3})
-	stub := fake.DescribeStackChangeSetStub
-	fakeReturns := fake.describeStackChangeSetReturns
-	fake.recordInvocation("DescribeStackChangeSet", []interface{}{arg1, arg2, arg3})
-	fake.describeStackChangeSetMutex.Unlock()
+		arg2 []string
+		arg3 manager.DeleteOptions
+	}{arg1, arg2Copy, arg3})
+	stub := fake.DeleteNodeGroupStacksStub
+	fakeReturns := fake.deleteNodeGroupStacksReturns
+	fake.recordInvocation("DeleteNodeGroupStacks", []interface{}{arg1, arg2Copy, arg3})
+	fake.deleteNodeGroupStacksMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) DescribeStackChangeSetCallCount() int {
-	fake.describeStackChangeSetMutex.RLock()
-	defer fake.describeStackChangeSetMutex.RUnlock()
-	return len(fake.describeStackChangeSetArgsForCall)
+func (fake *FakeStackManager) DeleteNodeGroupStacksCallCount() int {
+	fake.deleteNodeGroupStacksMutex.RLock()
+	defer fake.deleteNodeGroupStacksMutex.RUnlock()
+	return len(fake.deleteNodeGroupStacksArgsForCall)
 }
 
-func (fake *FakeStackManager) DescribeStackChangeSetCalls(stub func(context.Context, *types.Stack, string) (*cloudformation.DescribeChangeSetOutput, error)) {
-	fake.describeStackChangeSetMutex.Lock()
-	defer fake.describeStackChangeSetMutex.Unlock()
-	fake.DescribeStackChangeSetStub = stub
+func (fake *FakeStackManager) DeleteNodeGroupStacksCalls(stub func(context.Context, []string, manager.DeleteOptions) error) {
+	fake.deleteNodeGroupStacksMutex.Lock()
+	defer fake.deleteNodeGroupStacksMutex.Unlock()
+	fake.DeleteNodeGroupStacksStub = stub
 }
 
-func (fake *FakeStackManager) DescribeStackChangeSetArgsForCall(i int) (context.Context, *types.Stack, string) {
-	fake.describeStackChangeSetMutex.RLock()
-	defer fake.describeStackChangeSetMutex.RUnlock()
-	argsForCall := fake.describeStackChangeSetArgsForCall[i]
+func (fake *FakeStackManager) DeleteNodeGroupStacksArgsForCall(i int) (context.Context, []string, manager.DeleteOptions) {
+	fake.deleteNodeGroupStacksMutex.RLock()
+	defer fake.deleteNodeGroupStacksMutex.RUnlock()
+	argsForCall := fake.deleteNodeGroupStacksArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) DescribeStackChangeSetReturns(result1 *cloudformation.DescribeChangeSetOutput, result2 error) {
-	fake.describeStackChangeSetMutex.Lock()
-	defer fake.describeStackChangeSetMutex.Unlock()
-	fake.DescribeStackChangeSetStub = nil
-	fake.describeStackChangeSetReturns = struct {
-		result1 *cloudformation.DescribeChangeSetOutput
-		result2 error
-	}{result1, result2}
+func (fake *FakeStackManager) DeleteNodeGroupStacksReturns(result1 error) {
+	fake.deleteNodeGroupStacksMutex.Lock()
+	defer fake.deleteNodeGroupStacksMutex.Unlock()
+	fake.DeleteNodeGroupStacksStub = nil
+	fake.deleteNodeGroupStacksReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DescribeStackChangeSetReturnsOnCall(i int, result1 *cloudformation.DescribeChangeSetOutput, result2 error) {
-	fake.describeStackChangeSetMutex.Lock()
-	defer fake.describeStackChangeSetMutex.Unlock()
-	fake.DescribeStackChangeSetStub = nil
-	if fake.describeStackChangeSetReturnsOnCall == nil {
-		fake.describeStackChangeSetReturnsOnCall = make(map[int]struct {
-			result1 *cloudformation.DescribeChangeSetOutput
-			result2 error
+func (fake *FakeStackManager) DeleteNodeGroupStacksReturnsOnCall(i int, result1 error) {
+	fake.deleteNodeGroupStacksMutex.Lock()
+	defer fake.deleteNodeGroupStacksMutex.Unlock()
+	fake.DeleteNodeGroupStacksStub = nil
+	if fake.deleteNodeGroupStacksReturnsOnCall == nil {
+		fake.deleteNodeGroupStacksReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.describeStackChangeSetReturnsOnCall[i] = struct {
-		result1 *cloudformation.DescribeChangeSetOutput
-		result2 error
-	}{result1, result2}
+	fake.deleteNodeGroupStacksReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DescribeStackEvents(arg1 context.Context, arg2 *types.Stack) ([]types.StackEvent, error) {
-	fake.describeStackEventsMutex.Lock()
-	ret, specificReturn := fake.describeStackEventsReturnsOnCall[len(fake.describeStackEventsArgsForCall)]
-	fake.describeStackEventsArgsForCall = append(fake.describeStackEventsArgsForCall, struct {
+func (fake *FakeStackManager) DeleteStackBySpec(arg1 context.Context, arg2 *types.Stack) (*types.Stack, error) {
+	fake.deleteStackBySpecMutex.Lock()
+	ret, specificReturn := fake.deleteStackBySpecReturnsOnCall[len(fake.deleteStackBySpecArgsForCall)]
+	fake.deleteStackBySpecArgsForCall = append(fake.deleteStackBySpecArgsForCall, struct {
 		arg1 context.Context
 		arg2 *types.Stack
 	}{arg1, arg2})
-	stub := fake.DescribeStackEventsStub
-	fakeReturns := fake.describeStackEventsReturns
-	fake.recordInvocation("DescribeStackEvents", []interface{}{arg1, arg2})
-	fake.describeStackEventsMutex.Unlock()
+	stub := fake.DeleteStackBySpecStub
+	fakeReturns := fake.deleteStackBySpecReturns
+	fake.recordInvocation("DeleteStackBySpec", []interface{}{arg1, arg2})
+	fake.deleteStackBySpecMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -1645,133 +3012,128 @@ func (fake *FakeStackManager) DescribeStackEvents(arg1 context.Context, arg2 *ty
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) DescribeStackEventsCallCount() int {
-	fake.describeStackEventsMutex.RLock()
-	defer fake.describeStackEventsMutex.RUnlock()
-	return len(fake.describeStackEventsArgsForCall)
+func (fake *FakeStackManager) DeleteStackBySpecCallCount() int {
+	fake.deleteStackBySpecMutex.RLock()
+	defer fake.deleteStackBySpecMutex.RUnlock()
+	return len(fake.deleteStackBySpecArgsForCall)
 }
 
-func (fake *FakeStackManager) DescribeStackEventsCalls(stub func(context.Context, *types.Stack) ([]types.StackEvent, error)) {
-	fake.describeStackEventsMutex.Lock()
-	defer fake.describeStackEventsMutex.Unlock()
-	fake.DescribeStackEventsStub = stub
+func (fake *FakeStackManager) DeleteStackBySpecCalls(stub func(context.Context, *types.Stack) (*types.Stack, error)) {
+	fake.deleteStackBySpecMutex.Lock()
+	defer fake.deleteStackBySpecMutex.Unlock()
+	fake.DeleteStackBySpecStub = stub
 }
 
-func (fake *FakeStackManager) DescribeStackEventsArgsForCall(i int) (context.Context, *types.Stack) {
-	fake.describeStackEventsMutex.RLock()
-	defer fake.describeStackEventsMutex.RUnlock()
-	argsForCall := fake.describeStackEventsArgsForCall[i]
+func (fake *FakeStackManager) DeleteStackBySpecArgsForCall(i int) (context.Context, *types.Stack) {
+	fake.deleteStackBySpecMutex.RLock()
+	defer fake.deleteStackBySpecMutex.RUnlock()
+	argsForCall := fake.deleteStackBySpecArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) DescribeStackEventsReturns(result1 []types.StackEvent, result2 error) {
-	fake.describeStackEventsMutex.Lock()
-	defer fake.describeStackEventsMutex.Unlock()
-	fake.DescribeStackEventsStub = nil
-	fake.describeStackEventsReturns = struct {
-		result1 []types.StackEvent
+func (fake *FakeStackManager) DeleteStackBySpecReturns(result1 *types.Stack, result2 error) {
+	fake.deleteStackBySpecMutex.Lock()
+	defer fake.deleteStackBySpecMutex.Unlock()
+	fake.DeleteStackBySpecStub = nil
+	fake.deleteStackBySpecReturns = struct {
+		result1 *types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) DescribeStackEventsReturnsOnCall(i int, result1 []types.StackEvent, result2 error) {
-	fake.describeStackEventsMutex.Lock()
-	defer fake.describeStackEventsMutex.Unlock()
-	fake.DescribeStackEventsStub = nil
-	if fake.describeStackEventsReturnsOnCall == nil {
-		fake.describeStackEventsReturnsOnCall = make(map[int]struct {
-			result1 []types.StackEvent
+func (fake *FakeStackManager) DeleteStackBySpecReturnsOnCall(i int, result1 *types.Stack, result2 error) {
+	fake.deleteStackBySpecMutex.Lock()
+	defer fake.deleteStackBySpecMutex.Unlock()
+	fake.DeleteStackBySpecStub = nil
+	if fake.deleteStackBySpecReturnsOnCall == nil {
+		fake.deleteStackBySpecReturnsOnCall = make(map[int]struct {
+			result1 *types.Stack
 			result2 error
 		})
 	}
-	fake.describeStackEventsReturnsOnCall[i] = struct {
-		result1 []types.StackEvent
+	fake.deleteStackBySpecReturnsOnCall[i] = struct {
+		result1 *types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) DescribeStacks(arg1 context.Context) ([]*types.Stack, error) {
-	fake.describeStacksMutex.Lock()
-	ret, specificReturn := fake.describeStacksReturnsOnCall[len(fake.describeStacksArgsForCall)]
-	fake.describeStacksArgsForCall = append(fake.describeStacksArgsForCall, struct {
+func (fake *FakeStackManager) DeleteStackBySpecSync(arg1 context.Context, arg2 *types.Stack, arg3 chan error) error {
+	fake.deleteStackBySpecSyncMutex.Lock()
+	ret, specificReturn := fake.deleteStackBySpecSyncReturnsOnCall[len(fake.deleteStackBySpecSyncArgsForCall)]
+	fake.deleteStackBySpecSyncArgsForCall = append(fake.deleteStackBySpecSyncArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.DescribeStacksStub
-	fakeReturns := fake.describeStacksReturns
-	fake.recordInvocation("DescribeStacks", []interface{}{arg1})
-	fake.describeStacksMutex.Unlock()
+		arg2 *types.Stack
+		arg3 chan error
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteStackBySpecSyncStub
+	fakeReturns := fake.deleteStackBySpecSyncReturns
+	fake.recordInvocation("DeleteStackBySpecSync", []interface{}{arg1, arg2, arg3})
+	fake.deleteStackBySpecSyncMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) DescribeStacksCallCount() int {
-	fake.describeStacksMutex.RLock()
-	defer fake.describeStacksMutex.RUnlock()
-	return len(fake.describeStacksArgsForCall)
+func (fake *FakeStackManager) DeleteStackBySpecSyncCallCount() int {
+	fake.deleteStackBySpecSyncMutex.RLock()
+	defer fake.deleteStackBySpecSyncMutex.RUnlock()
+	return len(fake.deleteStackBySpecSyncArgsForCall)
 }
 
-func (fake *FakeStackManager) DescribeStacksCalls(stub func(context.Context) ([]*types.Stack, error)) {
-	fake.describeStacksMutex.Lock()
-	defer fake.describeStacksMutex.Unlock()
-	fake.DescribeStacksStub = stub
+func (fake *FakeStackManager) DeleteStackBySpecSyncCalls(stub func(context.Context, *types.Stack, chan error) error) {
+	fake.deleteStackBySpecSyncMutex.Lock()
+	defer fake.deleteStackBySpecSyncMutex.Unlock()
+	fake.DeleteStackBySpecSyncStub = stub
 }
 
-func (fake *FakeStackManager) DescribeStacksArgsForCall(i int) context.Context {
-	fake.describeStacksMutex.RLock()
-	defer fake.describeStacksMutex.RUnlock()
-	argsForCall := fake.describeStacksArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) DeleteStackBySpecSyncArgsForCall(i int) (context.Context, *types.Stack, chan error) {
+	fake.deleteStackBySpecSyncMutex.RLock()
+	defer fake.deleteStackBySpecSyncMutex.RUnlock()
+	argsForCall := fake.deleteStackBySpecSyncArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) DescribeStacksReturns(result1 []*types.Stack, result2 error) {
-	fake.describeStacksMutex.Lock()
-	defer fake.describeStacksMutex.Unlock()
-	fake.DescribeStacksStub = nil
-	fake.describeStacksReturns = struct {
-		result1 []*types.Stack
-		result2 error
-	}{result1, result2}
+func (fake *FakeStackManager) DeleteStackBySpecSyncReturns(result1 error) {
+	fake.deleteStackBySpecSyncMutex.Lock()
+	defer fake.deleteStackBySpecSyncMutex.Unlock()
+	fake.DeleteStackBySpecSyncStub = nil
+	fake.deleteStackBySpecSyncReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DescribeStacksReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
-	fake.describeStacksMutex.Lock()
-	defer fake.describeStacksMutex.Unlock()
-	fake.DescribeStacksStub = nil
-	if fake.describeStacksReturnsOnCall == nil {
-		fake.describeStacksReturnsOnCall = make(map[int]struct {
-			result1 []*types.Stack
-			result2 error
+func (fake *FakeStackManager) DeleteStackBySpecSyncReturnsOnCall(i int, result1 error) {
+	fake.deleteStackBySpecSyncMutex.Lock()
+	defer fake.deleteStackBySpecSyncMutex.Unlock()
+	fake.DeleteStackBySpecSyncStub = nil
+	if fake.deleteStackBySpecSyncReturnsOnCall == nil {
+		fake.deleteStackBySpecSyncReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.describeStacksReturnsOnCall[i] = struct {
-		result1 []*types.Stack
-		result2 error
-	}{result1, result2}
+	fake.deleteStackBySpecSyncReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) DoCreateStackRequest(arg1 context.Context, arg2 *types.Stack, arg3 manager.TemplateData, arg4 map[string]string, arg5 map[string]string, arg6 bool, arg7 bool) error {
-	fake.doCreateStackRequestMutex.Lock()
-	ret, specificReturn := fake.doCreateStackRequestReturnsOnCall[len(fake.doCreateStackRequestArgsForCall)]
-	fake.doCreateStackRequestArgsForCall = append(fake.doCreateStackRequestArgsForCall, struct {
+func (fake *FakeStackManager) DeleteStackChangeSet(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.deleteStackChangeSetMutex.Lock()
+	ret, specificReturn := fake.deleteStackChangeSetReturnsOnCall[len(fake.deleteStackChangeSetArgsForCall)]
+	fake.deleteStackChangeSetArgsForCall = append(fake.deleteStackChangeSetArgsForCall, struct {
 		arg1 context.Context
-		arg2 *types.Stack
-		arg3 manager.TemplateData
-		arg4 map[string]string
-		arg5 map[string]string
-		arg6 bool
-		arg7 bool
-	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
-	stub := fake.DoCreateStackRequestStub
-	fakeReturns := fake.doCreateStackRequestReturns
-	fake.recordInvocation("DoCreateStackRequest", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
-	fake.doCreateStackRequestMutex.Unlock()
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteStackChangeSetStub
+	fakeReturns := fake.deleteStackChangeSetReturns
+	fake.recordInvocation("DeleteStackChangeSet", []interface{}{arg1, arg2, arg3})
+	fake.deleteStackChangeSetMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1779,59 +3141,59 @@ func (fake *FakeStackManager) DoCreateStackRequest(arg1 context.Context, arg2 *t
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) DoCreateStackRequestCallCount() int {
-	fake.doCreateStackRequestMutex.RLock()
-	defer fake.doCreateStackRequestMutex.RUnlock()
-	return len(fake.doCreateStackRequestArgsForCall)
+func (fake *FakeStackManager) DeleteStackChangeSetCallCount() int {
+	fake.deleteStackChangeSetMutex.RLock()
+	defer fake.deleteStackChangeSetMutex.RUnlock()
+	return len(fake.deleteStackChangeSetArgsForCall)
 }
 
-func (fake *FakeStackManager) DoCreateStackRequestCalls(stub func(context.Context, *types.Stack, manager.TemplateData, map[string]string, map[string]string, bool, bool) error) {
-	fake.doCreateStackRequestMutex.Lock()
-	defer fake.doCreateStackRequestMutex.Unlock()
-	fake.DoCreateStackRequestStub = stub
+func (fake *FakeStackManager) DeleteStackChangeSetCalls(stub func(context.Context, string, string) error) {
+	fake.deleteStackChangeSetMutex.Lock()
+	defer fake.deleteStackChangeSetMutex.Unlock()
+	fake.DeleteStackChangeSetStub = stub
 }
 
-func (fake *FakeStackManager) DoCreateStackRequestArgsForCall(i int) (context.Context, *types.Stack, manager.TemplateData, map[string]string, map[string]string, bool, bool) {
-	fake.doCreateStackRequestMutex.RLock()
-	defer fake.doCreateStackRequestMutex.RUnlock()
-	argsForCall := fake.doCreateStackRequestArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+func (fake *FakeStackManager) DeleteStackChangeSetArgsForCall(i int) (context.Context, string, string) {
+	fake.deleteStackChangeSetMutex.RLock()
+	defer fake.deleteStackChangeSetMutex.RUnlock()
+	argsForCall := fake.deleteStackChangeSetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) DoCreateStackRequestReturns(result1 error) {
-	fake.doCreateStackRequestMutex.Lock()
-	defer fake.doCreateStackRequestMutex.Unlock()
-	fake.DoCreateStackRequestStub = nil
-	fake.doCreateStackRequestReturns = struct {
+func (fake *FakeStackManager) DeleteStackChangeSetReturns(result1 error) {
+	fake.deleteStackChangeSetMutex.Lock()
+	defer fake.deleteStackChangeSetMutex.Unlock()
+	fake.DeleteStackChangeSetStub = nil
+	fake.deleteStackChangeSetReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) DoCreateStackRequestReturnsOnCall(i int, result1 error) {
-	fake.doCreateStackRequestMutex.Lock()
-	defer fake.doCreateStackRequestMutex.Unlock()
-	fake.DoCreateStackRequestStub = nil
-	if fake.doCreateStackRequestReturnsOnCall == nil {
-		fake.doCreateStackRequestReturnsOnCall = make(map[int]struct {
+func (fake *FakeStackManager) DeleteStackChangeSetReturnsOnCall(i int, result1 error) {
+	fake.deleteStackChangeSetMutex.Lock()
+	defer fake.deleteStackChangeSetMutex.Unlock()
+	fake.DeleteStackChangeSetStub = nil
+	if fake.deleteStackChangeSetReturnsOnCall == nil {
+		fake.deleteStackChangeSetReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.doCreateStackRequestReturnsOnCall[i] = struct {
+	fake.deleteStackChangeSetReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) DoWaitUntilStackIsCreated(arg1 context.Context, arg2 *types.Stack) error {
-	fake.doWaitUntilStackIsCreatedMutex.Lock()
-	ret, specificReturn := fake.doWaitUntilStackIsCreatedReturnsOnCall[len(fake.doWaitUntilStackIsCreatedArgsForCall)]
-	fake.doWaitUntilStackIsCreatedArgsForCall = append(fake.doWaitUntilStackIsCreatedArgsForCall, struct {
+func (fake *FakeStackManager) DeleteStackSync(arg1 context.Context, arg2 *types.Stack) error {
+	fake.deleteStackSyncMutex.Lock()
+	ret, specificReturn := fake.deleteStackSyncReturnsOnCall[len(fake.deleteStackSyncArgsForCall)]
+	fake.deleteStackSyncArgsForCall = append(fake.deleteStackSyncArgsForCall, struct {
 		arg1 context.Context
 		arg2 *types.Stack
 	}{arg1, arg2})
-	stub := fake.DoWaitUntilStackIsCreatedStub
-	fakeReturns := fake.doWaitUntilStackIsCreatedReturns
-	fake.recordInvocation("DoWaitUntilStackIsCreated", []interface{}{arg1, arg2})
-	fake.doWaitUntilStackIsCreatedMutex.Unlock()
+	stub := fake.DeleteStackSyncStub
+	fakeReturns := fake.deleteStackSyncReturns
+	fake.recordInvocation("DeleteStackSync", []interface{}{arg1, arg2})
+	fake.deleteStackSyncMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -1841,183 +3203,194 @@ func (fake *FakeStackManager) DoWaitUntilStackIsCreated(arg1 context.Context, ar
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) DoWaitUntilStackIsCreatedCallCount() int {
-	fake.doWaitUntilStackIsCreatedMutex.RLock()
-	defer fake.doWaitUntilStackIsCreatedMutex.RUnlock()
-	return len(fake.doWaitUntilStackIsCreatedArgsForCall)
+func (fake *FakeStackManager) DeleteStackSyncCallCount() int {
+	fake.deleteStackSyncMutex.RLock()
+	defer fake.deleteStackSyncMutex.RUnlock()
+	return len(fake.deleteStackSyncArgsForCall)
 }
 
-func (fake *FakeStackManager) DoWaitUntilStackIsCreatedCalls(stub func(context.Context, *types.Stack) error) {
-	fake.doWaitUntilStackIsCreatedMutex.Lock()
-	defer fake.doWaitUntilStackIsCreatedMutex.Unlock()
-	fake.DoWaitUntilStackIsCreatedStub = stub
+func (fake *FakeStackManager) DeleteStackSyncCalls(stub func(context.Context, *types.Stack) error) {
+	fake.deleteStackSyncMutex.Lock()
+	defer fake.deleteStackSyncMutex.Unlock()
+	fake.DeleteStackSyncStub = stub
 }
 
-func (fake *FakeStackManager) DoWaitUntilStackIsCreatedArgsForCall(i int) (context.Context, *types.Stack) {
-	fake.doWaitUntilStackIsCreatedMutex.RLock()
-	defer fake.doWaitUntilStackIsCreatedMutex.RUnlock()
-	argsForCall := fake.doWaitUntilStackIsCreatedArgsForCall[i]
+func (fake *FakeStackManager) DeleteStackSyncArgsForCall(i int) (context.Context, *types.Stack) {
+	fake.deleteStackSyncMutex.RLock()
+	defer fake.deleteStackSyncMutex.RUnlock()
+	argsForCall := fake.deleteStackSyncArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) DoWaitUntilStackIsCreatedReturns(result1 error) {
-	fake.doWaitUntilStackIsCreatedMutex.Lock()
-	defer fake.doWaitUntilStackIsCreatedMutex.Unlock()
-	fake.DoWaitUntilStackIsCreatedStub = nil
-	fake.doWaitUntilStackIsCreatedReturns = struct {
+func (fake *FakeStackManager) DeleteStackSyncReturns(result1 error) {
+	fake.deleteStackSyncMutex.Lock()
+	defer fake.deleteStackSyncMutex.Unlock()
+	fake.DeleteStackSyncStub = nil
+	fake.deleteStackSyncReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) DoWaitUntilStackIsCreatedReturnsOnCall(i int, result1 error) {
-	fake.doWaitUntilStackIsCreatedMutex.Lock()
-	defer fake.doWaitUntilStackIsCreatedMutex.Unlock()
-	fake.DoWaitUntilStackIsCreatedStub = nil
-	if fake.doWaitUntilStackIsCreatedReturnsOnCall == nil {
-		fake.doWaitUntilStackIsCreatedReturnsOnCall = make(map[int]struct {
+func (fake *FakeStackManager) DeleteStackSyncReturnsOnCall(i int, result1 error) {
+	fake.deleteStackSyncMutex.Lock()
+	defer fake.deleteStackSyncMutex.Unlock()
+	fake.DeleteStackSyncStub = nil
+	if fake.deleteStackSyncReturnsOnCall == nil {
+		fake.deleteStackSyncReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.doWaitUntilStackIsCreatedReturnsOnCall[i] = struct {
+	fake.deleteStackSyncReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) EnsureMapPublicIPOnLaunchEnabled(arg1 context.Context) error {
-	fake.ensureMapPublicIPOnLaunchEnabledMutex.Lock()
-	ret, specificReturn := fake.ensureMapPublicIPOnLaunchEnabledReturnsOnCall[len(fake.ensureMapPublicIPOnLaunchEnabledArgsForCall)]
-	fake.ensureMapPublicIPOnLaunchEnabledArgsForCall = append(fake.ensureMapPublicIPOnLaunchEnabledArgsForCall, struct {
+func (fake *FakeStackManager) DeleteTasksForDeprecatedStacks(arg1 context.Context) (*tasks.TaskTree, error) {
+	fake.deleteTasksForDeprecatedStacksMutex.Lock()
+	ret, specificReturn := fake.deleteTasksForDeprecatedStacksReturnsOnCall[len(fake.deleteTasksForDeprecatedStacksArgsForCall)]
+	fake.deleteTasksForDeprecatedStacksArgsForCall = append(fake.deleteTasksForDeprecatedStacksArgsForCall, struct {
 		arg1 context.Context
 	}{arg1})
-	stub := fake.EnsureMapPublicIPOnLaunchEnabledStub
-	fakeReturns := fake.ensureMapPublicIPOnLaunchEnabledReturns
-	fake.recordInvocation("EnsureMapPublicIPOnLaunchEnabled", []interface{}{arg1})
-	fake.ensureMapPublicIPOnLaunchEnabledMutex.Unlock()
+	stub := fake.DeleteTasksForDeprecatedStacksStub
+	fakeReturns := fake.deleteTasksForDeprecatedStacksReturns
+	fake.recordInvocation("DeleteTasksForDeprecatedStacks", []interface{}{arg1})
+	fake.deleteTasksForDeprecatedStacksMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) EnsureMapPublicIPOnLaunchEnabledCallCount() int {
-	fake.ensureMapPublicIPOnLaunchEnabledMutex.RLock()
-	defer fake.ensureMapPublicIPOnLaunchEnabledMutex.RUnlock()
-	return len(fake.ensureMapPublicIPOnLaunchEnabledArgsForCall)
+func (fake *FakeStackManager) DeleteTasksForDeprecatedStacksCallCount() int {
+	fake.deleteTasksForDeprecatedStacksMutex.RLock()
+	defer fake.deleteTasksForDeprecatedStacksMutex.RUnlock()
+	return len(fake.deleteTasksForDeprecatedStacksArgsForCall)
 }
 
-func (fake *FakeStackManager) EnsureMapPublicIPOnLaunchEnabledCalls(stub func(context.Context) error) {
-	fake.ensureMapPublicIPOnLaunchEnabledMutex.Lock()
-	defer fake.ensureMapPublicIPOnLaunchEnabledMutex.Unlock()
-	fake.EnsureMapPublicIPOnLaunchEnabledStub = stub
+func (fake *FakeStackManager) DeleteTasksForDeprecatedStacksCalls(stub func(context.Context) (*tasks.TaskTree, error)) {
+	fake.deleteTasksForDeprecatedStacksMutex.Lock()
+	defer fake.deleteTasksForDeprecatedStacksMutex.Unlock()
+	fake.DeleteTasksForDeprecatedStacksStub = stub
 }
 
-func (fake *FakeStackManager) EnsureMapPublicIPOnLaunchEnabledArgsForCall(i int) context.Context {
-	fake.ensureMapPublicIPOnLaunchEnabledMutex.RLock()
-	defer fake.ensureMapPublicIPOnLaunchEnabledMutex.RUnlock()
-	argsForCall := fake.ensureMapPublicIPOnLaunchEnabledArgsForCall[i]
+func (fake *FakeStackManager) DeleteTasksForDeprecatedStacksArgsForCall(i int) context.Context {
+	fake.deleteTasksForDeprecatedStacksMutex.RLock()
+	defer fake.deleteTasksForDeprecatedStacksMutex.RUnlock()
+	argsForCall := fake.deleteTasksForDeprecatedStacksArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) EnsureMapPublicIPOnLaunchEnabledReturns(result1 error) {
-	fake.ensureMapPublicIPOnLaunchEnabledMutex.Lock()
-	defer fake.ensureMapPublicIPOnLaunchEnabledMutex.Unlock()
-	fake.EnsureMapPublicIPOnLaunchEnabledStub = nil
-	fake.ensureMapPublicIPOnLaunchEnabledReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeStackManager) DeleteTasksForDeprecatedStacksReturns(result1 *tasks.TaskTree, result2 error) {
+	fake.deleteTasksForDeprecatedStacksMutex.Lock()
+	defer fake.deleteTasksForDeprecatedStacksMutex.Unlock()
+	fake.DeleteTasksForDeprecatedStacksStub = nil
+	fake.deleteTasksForDeprecatedStacksReturns = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) EnsureMapPublicIPOnLaunchEnabledReturnsOnCall(i int, result1 error) {
-	fake.ensureMapPublicIPOnLaunchEnabledMutex.Lock()
-	defer fake.ensureMapPublicIPOnLaunchEnabledMutex.Unlock()
-	fake.EnsureMapPublicIPOnLaunchEnabledStub = nil
-	if fake.ensureMapPublicIPOnLaunchEnabledReturnsOnCall == nil {
-		fake.ensureMapPublicIPOnLaunchEnabledReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeStackManager) DeleteTasksForDeprecatedStacksReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
+	fake.deleteTasksForDeprecatedStacksMutex.Lock()
+	defer fake.deleteTasksForDeprecatedStacksMutex.Unlock()
+	fake.DeleteTasksForDeprecatedStacksStub = nil
+	if fake.deleteTasksForDeprecatedStacksReturnsOnCall == nil {
+		fake.deleteTasksForDeprecatedStacksReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+			result2 error
 		})
 	}
-	fake.ensureMapPublicIPOnLaunchEnabledReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.deleteTasksForDeprecatedStacksReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) FixClusterCompatibility(arg1 context.Context) error {
-	fake.fixClusterCompatibilityMutex.Lock()
-	ret, specificReturn := fake.fixClusterCompatibilityReturnsOnCall[len(fake.fixClusterCompatibilityArgsForCall)]
-	fake.fixClusterCompatibilityArgsForCall = append(fake.fixClusterCompatibilityArgsForCall, struct {
+func (fake *FakeStackManager) DescribeAllClusterStacks(arg1 context.Context) (*types.Stack, []*types.Stack, []*types.Stack, error) {
+	fake.describeAllClusterStacksMutex.Lock()
+	ret, specificReturn := fake.describeAllClusterStacksReturnsOnCall[len(fake.describeAllClusterStacksArgsForCall)]
+	fake.describeAllClusterStacksArgsForCall = append(fake.describeAllClusterStacksArgsForCall, struct {
 		arg1 context.Context
 	}{arg1})
-	stub := fake.FixClusterCompatibilityStub
-	fakeReturns := fake.fixClusterCompatibilityReturns
-	fake.recordInvocation("FixClusterCompatibility", []interface{}{arg1})
-	fake.fixClusterCompatibilityMutex.Unlock()
+	stub := fake.DescribeAllClusterStacksStub
+	fakeReturns := fake.describeAllClusterStacksReturns
+	fake.recordInvocation("DescribeAllClusterStacks", []interface{}{arg1})
+	fake.describeAllClusterStacksMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2, ret.result3, ret.result4
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
 }
 
-func (fake *FakeStackManager) FixClusterCompatibilityCallCount() int {
-	fake.fixClusterCompatibilityMutex.RLock()
-	defer fake.fixClusterCompatibilityMutex.RUnlock()
-	return len(fake.fixClusterCompatibilityArgsForCall)
+func (fake *FakeStackManager) DescribeAllClusterStacksCallCount() int {
+	fake.describeAllClusterStacksMutex.RLock()
+	defer fake.describeAllClusterStacksMutex.RUnlock()
+	return len(fake.describeAllClusterStacksArgsForCall)
 }
 
-func (fake *FakeStackManager) FixClusterCompatibilityCalls(stub func(context.Context) error) {
-	fake.fixClusterCompatibilityMutex.Lock()
-	defer fake.fixClusterCompatibilityMutex.Unlock()
-	fake.FixClusterCompatibilityStub = stub
+func (fake *FakeStackManager) DescribeAllClusterStacksCalls(stub func(context.Context) (*types.Stack, []*types.Stack, []*types.Stack, error)) {
+	fake.describeAllClusterStacksMutex.Lock()
+	defer fake.describeAllClusterStacksMutex.Unlock()
+	fake.DescribeAllClusterStacksStub = stub
 }
 
-func (fake *FakeStackManager) FixClusterCompatibilityArgsForCall(i int) context.Context {
-	fake.fixClusterCompatibilityMutex.RLock()
-	defer fake.fixClusterCompatibilityMutex.RUnlock()
-	argsForCall := fake.fixClusterCompatibilityArgsForCall[i]
+func (fake *FakeStackManager) DescribeAllClusterStacksArgsForCall(i int) context.Context {
+	fake.describeAllClusterStacksMutex.RLock()
+	defer fake.describeAllClusterStacksMutex.RUnlock()
+	argsForCall := fake.describeAllClusterStacksArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) FixClusterCompatibilityReturns(result1 error) {
-	fake.fixClusterCompatibilityMutex.Lock()
-	defer fake.fixClusterCompatibilityMutex.Unlock()
-	fake.FixClusterCompatibilityStub = nil
-	fake.fixClusterCompatibilityReturns = struct {
-		result1 error
-	}{result1}
-}
-
-func (fake *FakeStackManager) FixClusterCompatibilityReturnsOnCall(i int, result1 error) {
-	fake.fixClusterCompatibilityMutex.Lock()
-	defer fake.fixClusterCompatibilityMutex.Unlock()
-	fake.FixClusterCompatibilityStub = nil
-	if fake.fixClusterCompatibilityReturnsOnCall == nil {
-		fake.fixClusterCompatibilityReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeStackManager) DescribeAllClusterStacksReturns(result1 *types.Stack, result2 []*types.Stack, result3 []*types.Stack, result4 error) {
+	fake.describeAllClusterStacksMutex.Lock()
+	defer fake.describeAllClusterStacksMutex.Unlock()
+	fake.DescribeAllClusterStacksStub = nil
+	fake.describeAllClusterStacksReturns = struct {
+		result1 *types.Stack
+		result2 []*types.Stack
+		result3 []*types.Stack
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeStackManager) DescribeAllClusterStacksReturnsOnCall(i int, result1 *types.Stack, result2 []*types.Stack, result3 []*types.Stack, result4 error) {
+	fake.describeAllClusterStacksMutex.Lock()
+	defer fake.describeAllClusterStacksMutex.Unlock()
+	fake.DescribeAllClusterStacksStub = nil
+	if fake.describeAllClusterStacksReturnsOnCall == nil {
+		fake.describeAllClusterStacksReturnsOnCall = make(map[int]struct {
+			result1 *types.Stack
+			result2 []*types.Stack
+			result3 []*types.Stack
+			result4 error
 		})
 	}
-	fake.fixClusterCompatibilityReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.describeAllClusterStacksReturnsOnCall[i] = struct {
+		result1 *types.Stack
+		result2 []*types.Stack
+		result3 []*types.Stack
+		result4 error
+	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacity(arg1 context.Context, arg2 string) (typesa.AutoScalingGroup, error) {
-	fake.getAutoScalingGroupDesiredCapacityMutex.Lock()
-	ret, specificReturn := fake.getAutoScalingGroupDesiredCapacityReturnsOnCall[len(fake.getAutoScalingGroupDesiredCapacityArgsForCall)]
-	fake.getAutoScalingGroupDesiredCapacityArgsForCall = append(fake.getAutoScalingGroupDesiredCapacityArgsForCall, struct {
+func (fake *FakeStackManager) DescribeClusterStack(arg1 context.Context) (*types.Stack, error) {
+	fake.describeClusterStackMutex.Lock()
+	ret, specificReturn := fake.describeClusterStackReturnsOnCall[len(fake.describeClusterStackArgsForCall)]
+	fake.describeClusterStackArgsForCall = append(fake.describeClusterStackArgsForCall, struct {
 		arg1 context.Context
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.GetAutoScalingGroupDesiredCapacityStub
-	fakeReturns := fake.getAutoScalingGroupDesiredCapacityReturns
-	fake.recordInvocation("GetAutoScalingGroupDesiredCapacity", []interface{}{arg1, arg2})
-	fake.getAutoScalingGroupDesiredCapacityMutex.Unlock()
+	}{arg1})
+	stub := fake.DescribeClusterStackStub
+	fakeReturns := fake.describeClusterStackReturns
+	fake.recordInvocation("DescribeClusterStack", []interface{}{arg1})
+	fake.describeClusterStackMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2025,64 +3398,63 @@ func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacity(arg1 context.Co
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacityCallCount() int {
-	fake.getAutoScalingGroupDesiredCapacityMutex.RLock()
-	defer fake.getAutoScalingGroupDesiredCapacityMutex.RUnlock()
-	return len(fake.getAutoScalingGroupDesiredCapacityArgsForCall)
+func (fake *FakeStackManager) DescribeClusterStackCallCount() int {
+	fake.describeClusterStackMutex.RLock()
+	defer fake.describeClusterStackMutex.RUnlock()
+	return len(fake.describeClusterStackArgsForCall)
 }
 
-func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacityCalls(stub func(context.Context, string) (typesa.AutoScalingGroup, error)) {
-	fake.getAutoScalingGroupDesiredCapacityMutex.Lock()
-	defer fake.getAutoScalingGroupDesiredCapacityMutex.Unlock()
-	fake.GetAutoScalingGroupDesiredCapacityStub = stub
+func (fake *FakeStackManager) DescribeClusterStackCalls(stub func(context.Context) (*types.Stack, error)) {
+	fake.describeClusterStackMutex.Lock()
+	defer fake.describeClusterStackMutex.Unlock()
+	fake.DescribeClusterStackStub = stub
 }
 
-func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacityArgsForCall(i int) (context.Context, string) {
-	fake.getAutoScalingGroupDesiredCapacityMutex.RLock()
-	defer fake.getAutoScalingGroupDesiredCapacityMutex.RUnlock()
-	argsForCall := fake.getAutoScalingGroupDesiredCapacityArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeStackManager) DescribeClusterStackArgsForCall(i int) context.Context {
+	fake.describeClusterStackMutex.RLock()
+	defer fake.describeClusterStackMutex.RUnlock()
+	argsForCall := fake.describeClusterStackArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacityReturns(result1 typesa.AutoScalingGroup, result2 error) {
-	fake.getAutoScalingGroupDesiredCapacityMutex.Lock()
-	defer fake.getAutoScalingGroupDesiredCapacityMutex.Unlock()
-	fake.GetAutoScalingGroupDesiredCapacityStub = nil
-	fake.getAutoScalingGroupDesiredCapacityReturns = struct {
-		result1 typesa.AutoScalingGroup
+func (fake *FakeStackManager) DescribeClusterStackReturns(result1 *types.Stack, result2 error) {
+	fake.describeClusterStackMutex.Lock()
+	defer fake.describeClusterStackMutex.Unlock()
+	fake.DescribeClusterStackStub = nil
+	fake.describeClusterStackReturns = struct {
+		result1 *types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacityReturnsOnCall(i int, result1 typesa.AutoScalingGroup, result2 error) {
-	fake.getAutoScalingGroupDesiredCapacityMutex.Lock()
-	defer fake.getAutoScalingGroupDesiredCapacityMutex.Unlock()
-	fake.GetAutoScalingGroupDesiredCapacityStub = nil
-	if fake.getAutoScalingGroupDesiredCapacityReturnsOnCall == nil {
-		fake.getAutoScalingGroupDesiredCapacityReturnsOnCall = make(map[int]struct {
-			result1 typesa.AutoScalingGroup
+func (fake *FakeStackManager) DescribeClusterStackReturnsOnCall(i int, result1 *types.Stack, result2 error) {
+	fake.describeClusterStackMutex.Lock()
+	defer fake.describeClusterStackMutex.Unlock()
+	fake.DescribeClusterStackStub = nil
+	if fake.describeClusterStackReturnsOnCall == nil {
+		fake.describeClusterStackReturnsOnCall = make(map[int]struct {
+			result1 *types.Stack
 			result2 error
 		})
 	}
-	fake.getAutoScalingGroupDesiredCapacityReturnsOnCall[i] = struct {
-		result1 typesa.AutoScalingGroup
+	fake.describeClusterStackReturnsOnCall[i] = struct {
+		result1 *types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetAutoScalingGroupName(arg1 context.Context, arg2 *types.Stack) (string, error) {
-	fake.getAutoScalingGroupNameMutex.Lock()
-	ret, specificReturn := fake.getAutoScalingGroupNameReturnsOnCall[len(fake.getAutoScalingGroupNameArgsForCall)]
-	fake.getAutoScalingGroupNameArgsForCall = append(fake.getAutoScalingGroupNameArgsForCall, struct {
+func (fake *FakeStackManager) DescribeFargateProfileStacks(arg1 context.Context) ([]*types.Stack, error) {
+	fake.describeFargateProfileStacksMutex.Lock()
+	ret, specificReturn := fake.describeFargateProfileStacksReturnsOnCall[len(fake.describeFargateProfileStacksArgsForCall)]
+	fake.describeFargateProfileStacksArgsForCall = append(fake.describeFargateProfileStacksArgsForCall, struct {
 		arg1 context.Context
-		arg2 *types.Stack
-	}{arg1, arg2})
-	stub := fake.GetAutoScalingGroupNameStub
-	fakeReturns := fake.getAutoScalingGroupNameReturns
-	fake.recordInvocation("GetAutoScalingGroupName", []interface{}{arg1, arg2})
-	fake.getAutoScalingGroupNameMutex.Unlock()
+	}{arg1})
+	stub := fake.DescribeFargateProfileStacksStub
+	fakeReturns := fake.describeFargateProfileStacksReturns
+	fake.recordInvocation("DescribeFargateProfileStacks", []interface{}{arg1})
+	fake.describeFargateProfileStacksMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2090,61 +3462,61 @@ func (fake *FakeStackManager) GetAutoScalingGroupName(arg1 context.Context, arg2
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetAutoScalingGroupNameCallCount() int {
-	fake.getAutoScalingGroupNameMutex.RLock()
-	defer fake.getAutoScalingGroupNameMutex.RUnlock()
-	return len(fake.getAutoScalingGroupNameArgsForCall)
+func (fake *FakeStackManager) DescribeFargateProfileStacksCallCount() int {
+	fake.describeFargateProfileStacksMutex.RLock()
+	defer fake.describeFargateProfileStacksMutex.RUnlock()
+	return len(fake.describeFargateProfileStacksArgsForCall)
 }
 
-func (fake *FakeStackManager) GetAutoScalingGroupNameCalls(stub func(context.Context, *types.Stack) (string, error)) {
-	fake.getAutoScalingGroupNameMutex.Lock()
-	defer fake.getAutoScalingGroupNameMutex.Unlock()
-	fake.GetAutoScalingGroupNameStub = stub
+func (fake *FakeStackManager) DescribeFargateProfileStacksCalls(stub func(context.Context) ([]*types.Stack, error)) {
+	fake.describeFargateProfileStacksMutex.Lock()
+	defer fake.describeFargateProfileStacksMutex.Unlock()
+	fake.DescribeFargateProfileStacksStub = stub
 }
 
-func (fake *FakeStackManager) GetAutoScalingGroupNameArgsForCall(i int) (context.Context, *types.Stack) {
-	fake.getAutoScalingGroupNameMutex.RLock()
-	defer fake.getAutoScalingGroupNameMutex.RUnlock()
-	argsForCall := fake.getAutoScalingGroupNameArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeStackManager) DescribeFargateProfileStacksArgsForCall(i int) context.Context {
+	fake.describeFargateProfileStacksMutex.RLock()
+	defer fake.describeFargateProfileStacksMutex.RUnlock()
+	argsForCall := fake.describeFargateProfileStacksArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) GetAutoScalingGroupNameReturns(result1 string, result2 error) {
-	fake.getAutoScalingGroupNameMutex.Lock()
-	defer fake.getAutoScalingGroupNameMutex.Unlock()
-	fake.GetAutoScalingGroupNameStub = nil
-	fake.getAutoScalingGroupNameReturns = struct {
-		result1 string
+func (fake *FakeStackManager) DescribeFargateProfileStacksReturns(result1 []*types.Stack, result2 error) {
+	fake.describeFargateProfileStacksMutex.Lock()
+	defer fake.describeFargateProfileStacksMutex.Unlock()
+	fake.DescribeFargateProfileStacksStub = nil
+	fake.describeFargateProfileStacksReturns = struct {
+		result1 []*types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetAutoScalingGroupNameReturnsOnCall(i int, result1 string, result2 error) {
-	fake.getAutoScalingGroupNameMutex.Lock()
-	defer fake.getAutoScalingGroupNameMutex.Unlock()
-	fake.GetAutoScalingGroupNameStub = nil
-	if fake.getAutoScalingGroupNameReturnsOnCall == nil {
-		fake.getAutoScalingGroupNameReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeStackManager) DescribeFargateProfileStacksReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
+	fake.describeFargateProfileStacksMutex.Lock()
+	defer fake.describeFargateProfileStacksMutex.Unlock()
+	fake.DescribeFargateProfileStacksStub = nil
+	if fake.describeFargateProfileStacksReturnsOnCall == nil {
+		fake.describeFargateProfileStacksReturnsOnCall = make(map[int]struct {
+			result1 []*types.Stack
 			result2 error
 		})
 	}
-	fake.getAutoScalingGroupNameReturnsOnCall[i] = struct {
-		result1 string
+	fake.describeFargateProfileStacksReturnsOnCall[i] = struct {
+		result1 []*types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetClusterStackIfExists(arg1 context.Context) (*types.Stack, error) {
-	fake.getClusterStackIfExistsMutex.Lock()
-	ret, specificReturn := fake.getClusterStackIfExistsReturnsOnCall[len(fake.getClusterStackIfExistsArgsForCall)]
-	fake.getClusterStackIfExistsArgsForCall = append(fake.getClusterStackIfExistsArgsForCall, struct {
+func (fake *FakeStackManager) DescribeIAMServiceAccountStacks(arg1 context.Context) ([]*types.Stack, error) {
+	fake.describeIAMServiceAccountStacksMutex.Lock()
+	ret, specificReturn := fake.describeIAMServiceAccountStacksReturnsOnCall[len(fake.describeIAMServiceAccountStacksArgsForCall)]
+	fake.describeIAMServiceAccountStacksArgsForCall = append(fake.describeIAMServiceAccountStacksArgsForCall, struct {
 		arg1 context.Context
 	}{arg1})
-	stub := fake.GetClusterStackIfExistsStub
-	fakeReturns := fake.getClusterStackIfExistsReturns
-	fake.recordInvocation("GetClusterStackIfExists", []interface{}{arg1})
-	fake.getClusterStackIfExistsMutex.Unlock()
+	stub := fake.DescribeIAMServiceAccountStacksStub
+	fakeReturns := fake.describeIAMServiceAccountStacksReturns
+	fake.recordInvocation("DescribeIAMServiceAccountStacks", []interface{}{arg1})
+	fake.describeIAMServiceAccountStacksMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -2154,63 +3526,64 @@ func (fake *FakeStackManager) GetClusterStackIfExists(arg1 context.Context) (*ty
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetClusterStackIfExistsCallCount() int {
-	fake.getClusterStackIfExistsMutex.RLock()
-	defer fake.getClusterStackIfExistsMutex.RUnlock()
-	return len(fake.getClusterStackIfExistsArgsForCall)
+func (fake *FakeStackManager) DescribeIAMServiceAccountStacksCallCount() int {
+	fake.describeIAMServiceAccountStacksMutex.RLock()
+	defer fake.describeIAMServiceAccountStacksMutex.RUnlock()
+	return len(fake.describeIAMServiceAccountStacksArgsForCall)
 }
 
-func (fake *FakeStackManager) GetClusterStackIfExistsCalls(stub func(context.Context) (*types.Stack, error)) {
-	fake.getClusterStackIfExistsMutex.Lock()
-	defer fake.getClusterStackIfExistsMutex.Unlock()
-	fake.GetClusterStackIfExistsStub = stub
+func (fake *FakeStackManager) DescribeIAMServiceAccountStacksCalls(stub func(context.Context) ([]*types.Stack, error)) {
+	fake.describeIAMServiceAccountStacksMutex.Lock()
+	defer fake.describeIAMServiceAccountStacksMutex.Unlock()
+	fake.DescribeIAMServiceAccountStacksStub = stub
 }
 
-func (fake *FakeStackManager) GetClusterStackIfExistsArgsForCall(i int) context.Context {
-	fake.getClusterStackIfExistsMutex.RLock()
-	defer fake.getClusterStackIfExistsMutex.RUnlock()
-	argsForCall := fake.getClusterStackIfExistsArgsForCall[i]
+func (fake *FakeStackManager) DescribeIAMServiceAccountStacksArgsForCall(i int) context.Context {
+	fake.describeIAMServiceAccountStacksMutex.RLock()
+	defer fake.describeIAMServiceAccountStacksMutex.RUnlock()
+	argsForCall := fake.describeIAMServiceAccountStacksArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) GetClusterStackIfExistsReturns(result1 *types.Stack, result2 error) {
-	fake.getClusterStackIfExistsMutex.Lock()
-	defer fake.getClusterStackIfExistsMutex.Unlock()
-	fake.GetClusterStackIfExistsStub = nil
-	fake.getClusterStackIfExistsReturns = struct {
-		result1 *types.Stack
+func (fake *FakeStackManager) DescribeIAMServiceAccountStacksReturns(result1 []*types.Stack, result2 error) {
+	fake.describeIAMServiceAccountStacksMutex.Lock()
+	defer fake.describeIAMServiceAccountStacksMutex.Unlock()
+	fake.DescribeIAMServiceAccountStacksStub = nil
+	fake.describeIAMServiceAccountStacksReturns = struct {
+		result1 []*types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetClusterStackIfExistsReturnsOnCall(i int, result1 *types.Stack, result2 error) {
-	fake.getClusterStackIfExistsMutex.Lock()
-	defer fake.getClusterStackIfExistsMutex.Unlock()
-	fake.GetClusterStackIfExistsStub = nil
-	if fake.getClusterStackIfExistsReturnsOnCall == nil {
-		fake.getClusterStackIfExistsReturnsOnCall = make(map[int]struct {
-			result1 *types.Stack
+func (fake *FakeStackManager) DescribeIAMServiceAccountStacksReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
+	fake.describeIAMServiceAccountStacksMutex.Lock()
+	defer fake.describeIAMServiceAccountStacksMutex.Unlock()
+	fake.DescribeIAMServiceAccountStacksStub = nil
+	if fake.describeIAMServiceAccountStacksReturnsOnCall == nil {
+		fake.describeIAMServiceAccountStacksReturnsOnCall = make(map[int]struct {
+			result1 []*types.Stack
 			result2 error
 		})
 	}
-	fake.getClusterStackIfExistsReturnsOnCall[i] = struct {
-		result1 *types.Stack
+	fake.describeIAMServiceAccountStacksReturnsOnCall[i] = struct {
+		result1 []*types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetFargateStack(arg1 context.Context) (*types.Stack, error) {
-	fake.getFargateStackMutex.Lock()
-	ret, specificReturn := fake.getFargateStackReturnsOnCall[len(fake.getFargateStackArgsForCall)]
-	fake.getFargateStackArgsForCall = append(fake.getFargateStackArgsForCall, struct {
+func (fake *FakeStackManager) DescribeNodeGroupAMI(arg1 context.Context, arg2 string) (*manager.NodeGroupAMI, error) {
+	fake.describeNodeGroupAMIMutex.Lock()
+	ret, specificReturn := fake.describeNodeGroupAMIReturnsOnCall[len(fake.describeNodeGroupAMIArgsForCall)]
+	fake.describeNodeGroupAMIArgsForCall = append(fake.describeNodeGroupAMIArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.GetFargateStackStub
-	fakeReturns := fake.getFargateStackReturns
-	fake.recordInvocation("GetFargateStack", []interface{}{arg1})
-	fake.getFargateStackMutex.Unlock()
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DescribeNodeGroupAMIStub
+	fakeReturns := fake.describeNodeGroupAMIReturns
+	fake.recordInvocation("DescribeNodeGroupAMI", []interface{}{arg1, arg2})
+	fake.describeNodeGroupAMIMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2218,124 +3591,129 @@ func (fake *FakeStackManager) GetFargateStack(arg1 context.Context) (*types.Stac
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetFargateStackCallCount() int {
-	fake.getFargateStackMutex.RLock()
-	defer fake.getFargateStackMutex.RUnlock()
-	return len(fake.getFargateStackArgsForCall)
+func (fake *FakeStackManager) DescribeNodeGroupAMICallCount() int {
+	fake.describeNodeGroupAMIMutex.RLock()
+	defer fake.describeNodeGroupAMIMutex.RUnlock()
+	return len(fake.describeNodeGroupAMIArgsForCall)
 }
 
-func (fake *FakeStackManager) GetFargateStackCalls(stub func(context.Context) (*types.Stack, error)) {
-	fake.getFargateStackMutex.Lock()
-	defer fake.getFargateStackMutex.Unlock()
-	fake.GetFargateStackStub = stub
+func (fake *FakeStackManager) DescribeNodeGroupAMICalls(stub func(context.Context, string) (*manager.NodeGroupAMI, error)) {
+	fake.describeNodeGroupAMIMutex.Lock()
+	defer fake.describeNodeGroupAMIMutex.Unlock()
+	fake.DescribeNodeGroupAMIStub = stub
 }
 
-func (fake *FakeStackManager) GetFargateStackArgsForCall(i int) context.Context {
-	fake.getFargateStackMutex.RLock()
-	defer fake.getFargateStackMutex.RUnlock()
-	argsForCall := fake.getFargateStackArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) DescribeNodeGroupAMIArgsForCall(i int) (context.Context, string) {
+	fake.describeNodeGroupAMIMutex.RLock()
+	defer fake.describeNodeGroupAMIMutex.RUnlock()
+	argsForCall := fake.describeNodeGroupAMIArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) GetFargateStackReturns(result1 *types.Stack, result2 error) {
-	fake.getFargateStackMutex.Lock()
-	defer fake.getFargateStackMutex.Unlock()
-	fake.GetFargateStackStub = nil
-	fake.getFargateStackReturns = struct {
-		result1 *types.Stack
+func (fake *FakeStackManager) DescribeNodeGroupAMIReturns(result1 *manager.NodeGroupAMI, result2 error) {
+	fake.describeNodeGroupAMIMutex.Lock()
+	defer fake.describeNodeGroupAMIMutex.Unlock()
+	fake.DescribeNodeGroupAMIStub = nil
+	fake.describeNodeGroupAMIReturns = struct {
+		result1 *manager.NodeGroupAMI
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetFargateStackReturnsOnCall(i int, result1 *types.Stack, result2 error) {
-	fake.getFargateStackMutex.Lock()
-	defer fake.getFargateStackMutex.Unlock()
-	fake.GetFargateStackStub = nil
-	if fake.getFargateStackReturnsOnCall == nil {
-		fake.getFargateStackReturnsOnCall = make(map[int]struct {
-			result1 *types.Stack
+func (fake *FakeStackManager) DescribeNodeGroupAMIReturnsOnCall(i int, result1 *manager.NodeGroupAMI, result2 error) {
+	fake.describeNodeGroupAMIMutex.Lock()
+	defer fake.describeNodeGroupAMIMutex.Unlock()
+	fake.DescribeNodeGroupAMIStub = nil
+	if fake.describeNodeGroupAMIReturnsOnCall == nil {
+		fake.describeNodeGroupAMIReturnsOnCall = make(map[int]struct {
+			result1 *manager.NodeGroupAMI
 			result2 error
 		})
 	}
-	fake.getFargateStackReturnsOnCall[i] = struct {
-		result1 *types.Stack
+	fake.describeNodeGroupAMIReturnsOnCall[i] = struct {
+		result1 *manager.NodeGroupAMI
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetIAMAddonName(arg1 *types.Stack) string {
-	fake.getIAMAddonNameMutex.Lock()
-	ret, specificReturn := fake.getIAMAddonNameReturnsOnCall[len(fake.getIAMAddonNameArgsForCall)]
-	fake.getIAMAddonNameArgsForCall = append(fake.getIAMAddonNameArgsForCall, struct {
-		arg1 *types.Stack
-	}{arg1})
-	stub := fake.GetIAMAddonNameStub
-	fakeReturns := fake.getIAMAddonNameReturns
-	fake.recordInvocation("GetIAMAddonName", []interface{}{arg1})
-	fake.getIAMAddonNameMutex.Unlock()
+func (fake *FakeStackManager) DescribeNodeGroupDeletionImpact(arg1 context.Context, arg2 string) (*manager.DeletionImpact, error) {
+	fake.describeNodeGroupDeletionImpactMutex.Lock()
+	ret, specificReturn := fake.describeNodeGroupDeletionImpactReturnsOnCall[len(fake.describeNodeGroupDeletionImpactArgsForCall)]
+	fake.describeNodeGroupDeletionImpactArgsForCall = append(fake.describeNodeGroupDeletionImpactArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DescribeNodeGroupDeletionImpactStub
+	fakeReturns := fake.describeNodeGroupDeletionImpactReturns
+	fake.recordInvocation("DescribeNodeGroupDeletionImpact", []interface{}{arg1, arg2})
+	fake.describeNodeGroupDeletionImpactMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetIAMAddonNameCallCount() int {
-	fake.getIAMAddonNameMutex.RLock()
-	defer fake.getIAMAddonNameMutex.RUnlock()
-	return len(fake.getIAMAddonNameArgsForCall)
+func (fake *FakeStackManager) DescribeNodeGroupDeletionImpactCallCount() int {
+	fake.describeNodeGroupDeletionImpactMutex.RLock()
+	defer fake.describeNodeGroupDeletionImpactMutex.RUnlock()
+	return len(fake.describeNodeGroupDeletionImpactArgsForCall)
 }
 
-func (fake *FakeStackManager) GetIAMAddonNameCalls(stub func(*types.Stack) string) {
-	fake.getIAMAddonNameMutex.Lock()
-	defer fake.getIAMAddonNameMutex.Unlock()
-	fake.GetIAMAddonNameStub = stub
+func (fake *FakeStackManager) DescribeNodeGroupDeletionImpactCalls(stub func(context.Context, string) (*manager.DeletionImpact, error)) {
+	fake.describeNodeGroupDeletionImpactMutex.Lock()
+	defer fake.describeNodeGroupDeletionImpactMutex.Unlock()
+	fake.DescribeNodeGroupDeletionImpactStub = stub
 }
 
-func (fake *FakeStackManager) GetIAMAddonNameArgsForCall(i int) *types.Stack {
-	fake.getIAMAddonNameMutex.RLock()
-	defer fake.getIAMAddonNameMutex.RUnlock()
-	argsForCall := fake.getIAMAddonNameArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) DescribeNodeGroupDeletionImpactArgsForCall(i int) (context.Context, string) {
+	fake.describeNodeGroupDeletionImpactMutex.RLock()
+	defer fake.describeNodeGroupDeletionImpactMutex.RUnlock()
+	argsForCall := fake.describeNodeGroupDeletionImpactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) GetIAMAddonNameReturns(result1 string) {
-	fake.getIAMAddonNameMutex.Lock()
-	defer fake.getIAMAddonNameMutex.Unlock()
-	fake.GetIAMAddonNameStub = nil
-	fake.getIAMAddonNameReturns = struct {
-		result1 string
-	}{result1}
+func (fake *FakeStackManager) DescribeNodeGroupDeletionImpactReturns(result1 *manager.DeletionImpact, result2 error) {
+	fake.describeNodeGroupDeletionImpactMutex.Lock()
+	defer fake.describeNodeGroupDeletionImpactMutex.Unlock()
+	fake.DescribeNodeGroupDeletionImpactStub = nil
+	fake.describeNodeGroupDeletionImpactReturns = struct {
+		result1 *manager.DeletionImpact
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetIAMAddonNameReturnsOnCall(i int, result1 string) {
-	fake.getIAMAddonNameMutex.Lock()
-	defer fake.getIAMAddonNameMutex.Unlock()
-	fake.GetIAMAddonNameStub = nil
-	if fake.getIAMAddonNameReturnsOnCall == nil {
-		fake.getIAMAddonNameReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeStackManager) DescribeNodeGroupDeletionImpactReturnsOnCall(i int, result1 *manager.DeletionImpact, result2 error) {
+	fake.describeNodeGroupDeletionImpactMutex.Lock()
+	defer fake.describeNodeGroupDeletionImpactMutex.Unlock()
+	fake.DescribeNodeGroupDeletionImpactStub = nil
+	if fake.describeNodeGroupDeletionImpactReturnsOnCall == nil {
+		fake.describeNodeGroupDeletionImpactReturnsOnCall = make(map[int]struct {
+			result1 *manager.DeletionImpact
+			result2 error
 		})
 	}
-	fake.getIAMAddonNameReturnsOnCall[i] = struct {
-		result1 string
-	}{result1}
+	fake.describeNodeGroupDeletionImpactReturnsOnCall[i] = struct {
+		result1 *manager.DeletionImpact
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetIAMAddonsStacks(arg1 context.Context) ([]*types.Stack, error) {
-	fake.getIAMAddonsStacksMutex.Lock()
-	ret, specificReturn := fake.getIAMAddonsStacksReturnsOnCall[len(fake.getIAMAddonsStacksArgsForCall)]
-	fake.getIAMAddonsStacksArgsForCall = append(fake.getIAMAddonsStacksArgsForCall, struct {
+func (fake *FakeStackManager) DescribeNodeGroupStack(arg1 context.Context, arg2 string) (*types.Stack, error) {
+	fake.describeNodeGroupStackMutex.Lock()
+	ret, specificReturn := fake.describeNodeGroupStackReturnsOnCall[len(fake.describeNodeGroupStackArgsForCall)]
+	fake.describeNodeGroupStackArgsForCall = append(fake.describeNodeGroupStackArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.GetIAMAddonsStacksStub
-	fakeReturns := fake.getIAMAddonsStacksReturns
-	fake.recordInvocation("GetIAMAddonsStacks", []interface{}{arg1})
-	fake.getIAMAddonsStacksMutex.Unlock()
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DescribeNodeGroupStackStub
+	fakeReturns := fake.describeNodeGroupStackReturns
+	fake.recordInvocation("DescribeNodeGroupStack", []interface{}{arg1, arg2})
+	fake.describeNodeGroupStackMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2343,63 +3721,64 @@ func (fake *FakeStackManager) GetIAMAddonsStacks(arg1 context.Context) ([]*types
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetIAMAddonsStacksCallCount() int {
-	fake.getIAMAddonsStacksMutex.RLock()
-	defer fake.getIAMAddonsStacksMutex.RUnlock()
-	return len(fake.getIAMAddonsStacksArgsForCall)
+func (fake *FakeStackManager) DescribeNodeGroupStackCallCount() int {
+	fake.describeNodeGroupStackMutex.RLock()
+	defer fake.describeNodeGroupStackMutex.RUnlock()
+	return len(fake.describeNodeGroupStackArgsForCall)
 }
 
-func (fake *FakeStackManager) GetIAMAddonsStacksCalls(stub func(context.Context) ([]*types.Stack, error)) {
-	fake.getIAMAddonsStacksMutex.Lock()
-	defer fake.getIAMAddonsStacksMutex.Unlock()
-	fake.GetIAMAddonsStacksStub = stub
+func (fake *FakeStackManager) DescribeNodeGroupStackCalls(stub func(context.Context, string) (*types.Stack, error)) {
+	fake.describeNodeGroupStackMutex.Lock()
+	defer fake.describeNodeGroupStackMutex.Unlock()
+	fake.DescribeNodeGroupStackStub = stub
 }
 
-func (fake *FakeStackManager) GetIAMAddonsStacksArgsForCall(i int) context.Context {
-	fake.getIAMAddonsStacksMutex.RLock()
-	defer fake.getIAMAddonsStacksMutex.RUnlock()
-	argsForCall := fake.getIAMAddonsStacksArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) DescribeNodeGroupStackArgsForCall(i int) (context.Context, string) {
+	fake.describeNodeGroupStackMutex.RLock()
+	defer fake.describeNodeGroupStackMutex.RUnlock()
+	argsForCall := fake.describeNodeGroupStackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) GetIAMAddonsStacksReturns(result1 []*types.Stack, result2 error) {
-	fake.getIAMAddonsStacksMutex.Lock()
-	defer fake.getIAMAddonsStacksMutex.Unlock()
-	fake.GetIAMAddonsStacksStub = nil
-	fake.getIAMAddonsStacksReturns = struct {
-		result1 []*types.Stack
+func (fake *FakeStackManager) DescribeNodeGroupStackReturns(result1 *types.Stack, result2 error) {
+	fake.describeNodeGroupStackMutex.Lock()
+	defer fake.describeNodeGroupStackMutex.Unlock()
+	fake.DescribeNodeGroupStackStub = nil
+	fake.describeNodeGroupStackReturns = struct {
+		result1 *types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetIAMAddonsStacksReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
-	fake.getIAMAddonsStacksMutex.Lock()
-	defer fake.getIAMAddonsStacksMutex.Unlock()
-	fake.GetIAMAddonsStacksStub = nil
-	if fake.getIAMAddonsStacksReturnsOnCall == nil {
-		fake.getIAMAddonsStacksReturnsOnCall = make(map[int]struct {
-			result1 []*types.Stack
+func (fake *FakeStackManager) DescribeNodeGroupStackReturnsOnCall(i int, result1 *types.Stack, result2 error) {
+	fake.describeNodeGroupStackMutex.Lock()
+	defer fake.describeNodeGroupStackMutex.Unlock()
+	fake.DescribeNodeGroupStackStub = nil
+	if fake.describeNodeGroupStackReturnsOnCall == nil {
+		fake.describeNodeGroupStackReturnsOnCall = make(map[int]struct {
+			result1 *types.Stack
 			result2 error
 		})
 	}
-	fake.getIAMAddonsStacksReturnsOnCall[i] = struct {
-		result1 []*types.Stack
+	fake.describeNodeGroupStackReturnsOnCall[i] = struct {
+		result1 *types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetIAMServiceAccounts(arg1 context.Context) ([]*v1alpha5.ClusterIAMServiceAccount, error) {
-	fake.getIAMServiceAccountsMutex.Lock()
-	ret, specificReturn := fake.getIAMServiceAccountsReturnsOnCall[len(fake.getIAMServiceAccountsArgsForCall)]
-	fake.getIAMServiceAccountsArgsForCall = append(fake.getIAMServiceAccountsArgsForCall, struct {
+func (fake *FakeStackManager) DescribeNodeGroupStackAndResources(arg1 context.Context, arg2 string) (manager.StackInfo, error) {
+	fake.describeNodeGroupStackAndResourcesMutex.Lock()
+	ret, specificReturn := fake.describeNodeGroupStackAndResourcesReturnsOnCall[len(fake.describeNodeGroupStackAndResourcesArgsForCall)]
+	fake.describeNodeGroupStackAndResourcesArgsForCall = append(fake.describeNodeGroupStackAndResourcesArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.GetIAMServiceAccountsStub
-	fakeReturns := fake.getIAMServiceAccountsReturns
-	fake.recordInvocation("GetIAMServiceAccounts", []interface{}{arg1})
-	fake.getIAMServiceAccountsMutex.Unlock()
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DescribeNodeGroupStackAndResourcesStub
+	fakeReturns := fake.describeNodeGroupStackAndResourcesReturns
+	fake.recordInvocation("DescribeNodeGroupStackAndResources", []interface{}{arg1, arg2})
+	fake.describeNodeGroupStackAndResourcesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2407,63 +3786,64 @@ func (fake *FakeStackManager) GetIAMServiceAccounts(arg1 context.Context) ([]*v1
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetIAMServiceAccountsCallCount() int {
-	fake.getIAMServiceAccountsMutex.RLock()
-	defer fake.getIAMServiceAccountsMutex.RUnlock()
-	return len(fake.getIAMServiceAccountsArgsForCall)
+func (fake *FakeStackManager) DescribeNodeGroupStackAndResourcesCallCount() int {
+	fake.describeNodeGroupStackAndResourcesMutex.RLock()
+	defer fake.describeNodeGroupStackAndResourcesMutex.RUnlock()
+	return len(fake.describeNodeGroupStackAndResourcesArgsForCall)
 }
 
-func (fake *FakeStackManager) GetIAMServiceAccountsCalls(stub func(context.Context) ([]*v1alpha5.ClusterIAMServiceAccount, error)) {
-	fake.getIAMServiceAccountsMutex.Lock()
-	defer fake.getIAMServiceAccountsMutex.Unlock()
-	fake.GetIAMServiceAccountsStub = stub
+func (fake *FakeStackManager) DescribeNodeGroupStackAndResourcesCalls(stub func(context.Context, string) (manager.StackInfo, error)) {
+	fake.describeNodeGroupStackAndResourcesMutex.Lock()
+	defer fake.describeNodeGroupStackAndResourcesMutex.Unlock()
+	fake.DescribeNodeGroupStackAndResourcesStub = stub
 }
 
-func (fake *FakeStackManager) GetIAMServiceAccountsArgsForCall(i int) context.Context {
-	fake.getIAMServiceAccountsMutex.RLock()
-	defer fake.getIAMServiceAccountsMutex.RUnlock()
-	argsForCall := fake.getIAMServiceAccountsArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) DescribeNodeGroupStackAndResourcesArgsForCall(i int) (context.Context, string) {
+	fake.describeNodeGroupStackAndResourcesMutex.RLock()
+	defer fake.describeNodeGroupStackAndResourcesMutex.RUnlock()
+	argsForCall := fake.describeNodeGroupStackAndResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) GetIAMServiceAccountsReturns(result1 []*v1alpha5.ClusterIAMServiceAccount, result2 error) {
-	fake.getIAMServiceAccountsMutex.Lock()
-	defer fake.getIAMServiceAccountsMutex.Unlock()
-	fake.GetIAMServiceAccountsStub = nil
-	fake.getIAMServiceAccountsReturns = struct {
-		result1 []*v1alpha5.ClusterIAMServiceAccount
+func (fake *FakeStackManager) DescribeNodeGroupStackAndResourcesReturns(result1 manager.StackInfo, result2 error) {
+	fake.describeNodeGroupStackAndResourcesMutex.Lock()
+	defer fake.describeNodeGroupStackAndResourcesMutex.Unlock()
+	fake.DescribeNodeGroupStackAndResourcesStub = nil
+	fake.describeNodeGroupStackAndResourcesReturns = struct {
+		result1 manager.StackInfo
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetIAMServiceAccountsReturnsOnCall(i int, result1 []*v1alpha5.ClusterIAMServiceAccount, result2 error) {
-	fake.getIAMServiceAccountsMutex.Lock()
-	defer fake.getIAMServiceAccountsMutex.Unlock()
-	fake.GetIAMServiceAccountsStub = nil
-	if fake.getIAMServiceAccountsReturnsOnCall == nil {
-		fake.getIAMServiceAccountsReturnsOnCall = make(map[int]struct {
-			result1 []*v1alpha5.ClusterIAMServiceAccount
+func (fake *FakeStackManager) DescribeNodeGroupStackAndResourcesReturnsOnCall(i int, result1 manager.StackInfo, result2 error) {
+	fake.describeNodeGroupStackAndResourcesMutex.Lock()
+	defer fake.describeNodeGroupStackAndResourcesMutex.Unlock()
+	fake.DescribeNodeGroupStackAndResourcesStub = nil
+	if fake.describeNodeGroupStackAndResourcesReturnsOnCall == nil {
+		fake.describeNodeGroupStackAndResourcesReturnsOnCall = make(map[int]struct {
+			result1 manager.StackInfo
 			result2 error
 		})
 	}
-	fake.getIAMServiceAccountsReturnsOnCall[i] = struct {
-		result1 []*v1alpha5.ClusterIAMServiceAccount
+	fake.describeNodeGroupStackAndResourcesReturnsOnCall[i] = struct {
+		result1 manager.StackInfo
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetKarpenterStack(arg1 context.Context) (*types.Stack, error) {
-	fake.getKarpenterStackMutex.Lock()
-	ret, specificReturn := fake.getKarpenterStackReturnsOnCall[len(fake.getKarpenterStackArgsForCall)]
-	fake.getKarpenterStackArgsForCall = append(fake.getKarpenterStackArgsForCall, struct {
+func (fake *FakeStackManager) DescribeNodeGroupStackByID(arg1 context.Context, arg2 string) (*types.Stack, error) {
+	fake.describeNodeGroupStackByIDMutex.Lock()
+	ret, specificReturn := fake.describeNodeGroupStackByIDReturnsOnCall[len(fake.describeNodeGroupStackByIDArgsForCall)]
+	fake.describeNodeGroupStackByIDArgsForCall = append(fake.describeNodeGroupStackByIDArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.GetKarpenterStackStub
-	fakeReturns := fake.getKarpenterStackReturns
-	fake.recordInvocation("GetKarpenterStack", []interface{}{arg1})
-	fake.getKarpenterStackMutex.Unlock()
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DescribeNodeGroupStackByIDStub
+	fakeReturns := fake.describeNodeGroupStackByIDReturns
+	fake.recordInvocation("DescribeNodeGroupStackByID", []interface{}{arg1, arg2})
+	fake.describeNodeGroupStackByIDMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2471,62 +3851,62 @@ func (fake *FakeStackManager) GetKarpenterStack(arg1 context.Context) (*types.St
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetKarpenterStackCallCount() int {
-	fake.getKarpenterStackMutex.RLock()
-	defer fake.getKarpenterStackMutex.RUnlock()
-	return len(fake.getKarpenterStackArgsForCall)
+func (fake *FakeStackManager) DescribeNodeGroupStackByIDCallCount() int {
+	fake.describeNodeGroupStackByIDMutex.RLock()
+	defer fake.describeNodeGroupStackByIDMutex.RUnlock()
+	return len(fake.describeNodeGroupStackByIDArgsForCall)
 }
 
-func (fake *FakeStackManager) GetKarpenterStackCalls(stub func(context.Context) (*types.Stack, error)) {
-	fake.getKarpenterStackMutex.Lock()
-	defer fake.getKarpenterStackMutex.Unlock()
-	fake.GetKarpenterStackStub = stub
+func (fake *FakeStackManager) DescribeNodeGroupStackByIDCalls(stub func(context.Context, string) (*types.Stack, error)) {
+	fake.describeNodeGroupStackByIDMutex.Lock()
+	defer fake.describeNodeGroupStackByIDMutex.Unlock()
+	fake.DescribeNodeGroupStackByIDStub = stub
 }
 
-func (fake *FakeStackManager) GetKarpenterStackArgsForCall(i int) context.Context {
-	fake.getKarpenterStackMutex.RLock()
-	defer fake.getKarpenterStackMutex.RUnlock()
-	argsForCall := fake.getKarpenterStackArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) DescribeNodeGroupStackByIDArgsForCall(i int) (context.Context, string) {
+	fake.describeNodeGroupStackByIDMutex.RLock()
+	defer fake.describeNodeGroupStackByIDMutex.RUnlock()
+	argsForCall := fake.describeNodeGroupStackByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) GetKarpenterStackReturns(result1 *types.Stack, result2 error) {
-	fake.getKarpenterStackMutex.Lock()
-	defer fake.getKarpenterStackMutex.Unlock()
-	fake.GetKarpenterStackStub = nil
-	fake.getKarpenterStackReturns = struct {
+func (fake *FakeStackManager) DescribeNodeGroupStackByIDReturns(result1 *types.Stack, result2 error) {
+	fake.describeNodeGroupStackByIDMutex.Lock()
+	defer fake.describeNodeGroupStackByIDMutex.Unlock()
+	fake.DescribeNodeGroupStackByIDStub = nil
+	fake.describeNodeGroupStackByIDReturns = struct {
 		result1 *types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetKarpenterStackReturnsOnCall(i int, result1 *types.Stack, result2 error) {
-	fake.getKarpenterStackMutex.Lock()
-	defer fake.getKarpenterStackMutex.Unlock()
-	fake.GetKarpenterStackStub = nil
-	if fake.getKarpenterStackReturnsOnCall == nil {
-		fake.getKarpenterStackReturnsOnCall = make(map[int]struct {
+func (fake *FakeStackManager) DescribeNodeGroupStackByIDReturnsOnCall(i int, result1 *types.Stack, result2 error) {
+	fake.describeNodeGroupStackByIDMutex.Lock()
+	defer fake.describeNodeGroupStackByIDMutex.Unlock()
+	fake.DescribeNodeGroupStackByIDStub = nil
+	if fake.describeNodeGroupStackByIDReturnsOnCall == nil {
+		fake.describeNodeGroupStackByIDReturnsOnCall = make(map[int]struct {
 			result1 *types.Stack
 			result2 error
 		})
 	}
-	fake.getKarpenterStackReturnsOnCall[i] = struct {
+	fake.describeNodeGroupStackByIDReturnsOnCall[i] = struct {
 		result1 *types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetManagedNodeGroupTemplate(arg1 context.Context, arg2 manager.GetNodegroupOption) (string, error) {
-	fake.getManagedNodeGroupTemplateMutex.Lock()
-	ret, specificReturn := fake.getManagedNodeGroupTemplateReturnsOnCall[len(fake.getManagedNodeGroupTemplateArgsForCall)]
-	fake.getManagedNodeGroupTemplateArgsForCall = append(fake.getManagedNodeGroupTemplateArgsForCall, struct {
+func (fake *FakeStackManager) DescribeNodeGroupStackSetInstances(arg1 context.Context, arg2 string) ([]manager.StackSetInstanceStatus, error) {
+	fake.describeNodeGroupStackSetInstancesMutex.Lock()
+	ret, specificReturn := fake.describeNodeGroupStackSetInstancesReturnsOnCall[len(fake.describeNodeGroupStackSetInstancesArgsForCall)]
+	fake.describeNodeGroupStackSetInstancesArgsForCall = append(fake.describeNodeGroupStackSetInstancesArgsForCall, struct {
 		arg1 context.Context
-		arg2 manager.GetNodegroupOption
+		arg2 string
 	}{arg1, arg2})
-	stub := fake.GetManagedNodeGroupTemplateStub
-	fakeReturns := fake.getManagedNodeGroupTemplateReturns
-	fake.recordInvocation("GetManagedNodeGroupTemplate", []interface{}{arg1, arg2})
-	fake.getManagedNodeGroupTemplateMutex.Unlock()
+	stub := fake.DescribeNodeGroupStackSetInstancesStub
+	fakeReturns := fake.describeNodeGroupStackSetInstancesReturns
+	fake.recordInvocation("DescribeNodeGroupStackSetInstances", []interface{}{arg1, arg2})
+	fake.describeNodeGroupStackSetInstancesMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -2536,125 +3916,127 @@ func (fake *FakeStackManager) GetManagedNodeGroupTemplate(arg1 context.Context,
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetManagedNodeGroupTemplateCallCount() int {
-	fake.getManagedNodeGroupTemplateMutex.RLock()
-	defer fake.getManagedNodeGroupTemplateMutex.RUnlock()
-	return len(fake.getManagedNodeGroupTemplateArgsForCall)
+func (fake *FakeStackManager) DescribeNodeGroupStackSetInstancesCallCount() int {
+	fake.describeNodeGroupStackSetInstancesMutex.RLock()
+	defer fake.describeNodeGroupStackSetInstancesMutex.RUnlock()
+	return len(fake.describeNodeGroupStackSetInstancesArgsForCall)
 }
 
-func (fake *FakeStackManager) GetManagedNodeGroupTemplateCalls(stub func(context.Context, manager.GetNodegroupOption) (string, error)) {
-	fake.getManagedNodeGroupTemplateMutex.Lock()
-	defer fake.getManagedNodeGroupTemplateMutex.Unlock()
-	fake.GetManagedNodeGroupTemplateStub = stub
+func (fake *FakeStackManager) DescribeNodeGroupStackSetInstancesCalls(stub func(context.Context, string) ([]manager.StackSetInstanceStatus, error)) {
+	fake.describeNodeGroupStackSetInstancesMutex.Lock()
+	defer fake.describeNodeGroupStackSetInstancesMutex.Unlock()
+	fake.DescribeNodeGroupStackSetInstancesStub = stub
 }
 
-func (fake *FakeStackManager) GetManagedNodeGroupTemplateArgsForCall(i int) (context.Context, manager.GetNodegroupOption) {
-	fake.getManagedNodeGroupTemplateMutex.RLock()
-	defer fake.getManagedNodeGroupTemplateMutex.RUnlock()
-	argsForCall := fake.getManagedNodeGroupTemplateArgsForCall[i]
+func (fake *FakeStackManager) DescribeNodeGroupStackSetInstancesArgsForCall(i int) (context.Context, string) {
+	fake.describeNodeGroupStackSetInstancesMutex.RLock()
+	defer fake.describeNodeGroupStackSetInstancesMutex.RUnlock()
+	argsForCall := fake.describeNodeGroupStackSetInstancesArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) GetManagedNodeGroupTemplateReturns(result1 string, result2 error) {
-	fake.getManagedNodeGroupTemplateMutex.Lock()
-	defer fake.getManagedNodeGroupTemplateMutex.Unlock()
-	fake.GetManagedNodeGroupTemplateStub = nil
-	fake.getManagedNodeGroupTemplateReturns = struct {
-		result1 string
+func (fake *FakeStackManager) DescribeNodeGroupStackSetInstancesReturns(result1 []manager.StackSetInstanceStatus, result2 error) {
+	fake.describeNodeGroupStackSetInstancesMutex.Lock()
+	defer fake.describeNodeGroupStackSetInstancesMutex.Unlock()
+	fake.DescribeNodeGroupStackSetInstancesStub = nil
+	fake.describeNodeGroupStackSetInstancesReturns = struct {
+		result1 []manager.StackSetInstanceStatus
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetManagedNodeGroupTemplateReturnsOnCall(i int, result1 string, result2 error) {
-	fake.getManagedNodeGroupTemplateMutex.Lock()
-	defer fake.getManagedNodeGroupTemplateMutex.Unlock()
-	fake.GetManagedNodeGroupTemplateStub = nil
-	if fake.getManagedNodeGroupTemplateReturnsOnCall == nil {
-		fake.getManagedNodeGroupTemplateReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeStackManager) DescribeNodeGroupStackSetInstancesReturnsOnCall(i int, result1 []manager.StackSetInstanceStatus, result2 error) {
+	fake.describeNodeGroupStackSetInstancesMutex.Lock()
+	defer fake.describeNodeGroupStackSetInstancesMutex.Unlock()
+	fake.DescribeNodeGroupStackSetInstancesStub = nil
+	if fake.describeNodeGroupStackSetInstancesReturnsOnCall == nil {
+		fake.describeNodeGroupStackSetInstancesReturnsOnCall = make(map[int]struct {
+			result1 []manager.StackSetInstanceStatus
 			result2 error
 		})
 	}
-	fake.getManagedNodeGroupTemplateReturnsOnCall[i] = struct {
-		result1 string
+	fake.describeNodeGroupStackSetInstancesReturnsOnCall[i] = struct {
+		result1 []manager.StackSetInstanceStatus
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetNodeGroupName(arg1 *types.Stack) string {
-	fake.getNodeGroupNameMutex.Lock()
-	ret, specificReturn := fake.getNodeGroupNameReturnsOnCall[len(fake.getNodeGroupNameArgsForCall)]
-	fake.getNodeGroupNameArgsForCall = append(fake.getNodeGroupNameArgsForCall, struct {
-		arg1 *types.Stack
+func (fake *FakeStackManager) DescribeNodeGroupStacks(arg1 context.Context) ([]*types.Stack, error) {
+	fake.describeNodeGroupStacksMutex.Lock()
+	ret, specificReturn := fake.describeNodeGroupStacksReturnsOnCall[len(fake.describeNodeGroupStacksArgsForCall)]
+	fake.describeNodeGroupStacksArgsForCall = append(fake.describeNodeGroupStacksArgsForCall, struct {
+		arg1 context.Context
 	}{arg1})
-	stub := fake.GetNodeGroupNameStub
-	fakeReturns := fake.getNodeGroupNameReturns
-	fake.recordInvocation("GetNodeGroupName", []interface{}{arg1})
-	fake.getNodeGroupNameMutex.Unlock()
+	stub := fake.DescribeNodeGroupStacksStub
+	fakeReturns := fake.describeNodeGroupStacksReturns
+	fake.recordInvocation("DescribeNodeGroupStacks", []interface{}{arg1})
+	fake.describeNodeGroupStacksMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetNodeGroupNameCallCount() int {
-	fake.getNodeGroupNameMutex.RLock()
-	defer fake.getNodeGroupNameMutex.RUnlock()
-	return len(fake.getNodeGroupNameArgsForCall)
+func (fake *FakeStackManager) DescribeNodeGroupStacksCallCount() int {
+	fake.describeNodeGroupStacksMutex.RLock()
+	defer fake.describeNodeGroupStacksMutex.RUnlock()
+	return len(fake.describeNodeGroupStacksArgsForCall)
 }
 
-func (fake *FakeStackManager) GetNodeGroupNameCalls(stub func(*types.Stack) string) {
-	fake.getNodeGroupNameMutex.Lock()
-	defer fake.getNodeGroupNameMutex.Unlock()
-	fake.GetNodeGroupNameStub = stub
+func (fake *FakeStackManager) DescribeNodeGroupStacksCalls(stub func(context.Context) ([]*types.Stack, error)) {
+	fake.describeNodeGroupStacksMutex.Lock()
+	defer fake.describeNodeGroupStacksMutex.Unlock()
+	fake.DescribeNodeGroupStacksStub = stub
 }
 
-func (fake *FakeStackManager) GetNodeGroupNameArgsForCall(i int) *types.Stack {
-	fake.getNodeGroupNameMutex.RLock()
-	defer fake.getNodeGroupNameMutex.RUnlock()
-	argsForCall := fake.getNodeGroupNameArgsForCall[i]
+func (fake *FakeStackManager) DescribeNodeGroupStacksArgsForCall(i int) context.Context {
+	fake.describeNodeGroupStacksMutex.RLock()
+	defer fake.describeNodeGroupStacksMutex.RUnlock()
+	argsForCall := fake.describeNodeGroupStacksArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) GetNodeGroupNameReturns(result1 string) {
-	fake.getNodeGroupNameMutex.Lock()
-	defer fake.getNodeGroupNameMutex.Unlock()
-	fake.GetNodeGroupNameStub = nil
-	fake.getNodeGroupNameReturns = struct {
-		result1 string
-	}{result1}
+func (fake *FakeStackManager) DescribeNodeGroupStacksReturns(result1 []*types.Stack, result2 error) {
+	fake.describeNodeGroupStacksMutex.Lock()
+	defer fake.describeNodeGroupStacksMutex.Unlock()
+	fake.DescribeNodeGroupStacksStub = nil
+	fake.describeNodeGroupStacksReturns = struct {
+		result1 []*types.Stack
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetNodeGroupNameReturnsOnCall(i int, result1 string) {
-	fake.getNodeGroupNameMutex.Lock()
-	defer fake.getNodeGroupNameMutex.Unlock()
-	fake.GetNodeGroupNameStub = nil
-	if fake.getNodeGroupNameReturnsOnCall == nil {
-		fake.getNodeGroupNameReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeStackManager) DescribeNodeGroupStacksReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
+	fake.describeNodeGroupStacksMutex.Lock()
+	defer fake.describeNodeGroupStacksMutex.Unlock()
+	fake.DescribeNodeGroupStacksStub = nil
+	if fake.describeNodeGroupStacksReturnsOnCall == nil {
+		fake.describeNodeGroupStacksReturnsOnCall = make(map[int]struct {
+			result1 []*types.Stack
+			result2 error
 		})
 	}
-	fake.getNodeGroupNameReturnsOnCall[i] = struct {
-		result1 string
-	}{result1}
+	fake.describeNodeGroupStacksReturnsOnCall[i] = struct {
+		result1 []*types.Stack
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetNodeGroupStackType(arg1 context.Context, arg2 manager.GetNodegroupOption) (v1alpha5.NodeGroupType, error) {
-	fake.getNodeGroupStackTypeMutex.Lock()
-	ret, specificReturn := fake.getNodeGroupStackTypeReturnsOnCall[len(fake.getNodeGroupStackTypeArgsForCall)]
-	fake.getNodeGroupStackTypeArgsForCall = append(fake.getNodeGroupStackTypeArgsForCall, struct {
+func (fake *FakeStackManager) DescribeNodeGroupStacksAndResources(arg1 context.Context) (map[string]manager.StackInfo, error) {
+	fake.describeNodeGroupStacksAndResourcesMutex.Lock()
+	ret, specificReturn := fake.describeNodeGroupStacksAndResourcesReturnsOnCall[len(fake.describeNodeGroupStacksAndResourcesArgsForCall)]
+	fake.describeNodeGroupStacksAndResourcesArgsForCall = append(fake.describeNodeGroupStacksAndResourcesArgsForCall, struct {
 		arg1 context.Context
-		arg2 manager.GetNodegroupOption
-	}{arg1, arg2})
-	stub := fake.GetNodeGroupStackTypeStub
-	fakeReturns := fake.getNodeGroupStackTypeReturns
-	fake.recordInvocation("GetNodeGroupStackType", []interface{}{arg1, arg2})
-	fake.getNodeGroupStackTypeMutex.Unlock()
+	}{arg1})
+	stub := fake.DescribeNodeGroupStacksAndResourcesStub
+	fakeReturns := fake.describeNodeGroupStacksAndResourcesReturns
+	fake.recordInvocation("DescribeNodeGroupStacksAndResources", []interface{}{arg1})
+	fake.describeNodeGroupStacksAndResourcesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2662,64 +4044,65 @@ func (fake *FakeStackManager) GetNodeGroupStackType(arg1 context.Context, arg2 m
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetNodeGroupStackTypeCallCount() int {
-	fake.getNodeGroupStackTypeMutex.RLock()
-	defer fake.getNodeGroupStackTypeMutex.RUnlock()
-	return len(fake.getNodeGroupStackTypeArgsForCall)
+func (fake *FakeStackManager) DescribeNodeGroupStacksAndResourcesCallCount() int {
+	fake.describeNodeGroupStacksAndResourcesMutex.RLock()
+	defer fake.describeNodeGroupStacksAndResourcesMutex.RUnlock()
+	return len(fake.describeNodeGroupStacksAndResourcesArgsForCall)
 }
 
-func (fake *FakeStackManager) GetNodeGroupStackTypeCalls(stub func(context.Context, manager.GetNodegroupOption) (v1alpha5.NodeGroupType, error)) {
-	fake.getNodeGroupStackTypeMutex.Lock()
-	defer fake.getNodeGroupStackTypeMutex.Unlock()
-	fake.GetNodeGroupStackTypeStub = stub
+func (fake *FakeStackManager) DescribeNodeGroupStacksAndResourcesCalls(stub func(context.Context) (map[string]manager.StackInfo, error)) {
+	fake.describeNodeGroupStacksAndResourcesMutex.Lock()
+	defer fake.describeNodeGroupStacksAndResourcesMutex.Unlock()
+	fake.DescribeNodeGroupStacksAndResourcesStub = stub
 }
 
-func (fake *FakeStackManager) GetNodeGroupStackTypeArgsForCall(i int) (context.Context, manager.GetNodegroupOption) {
-	fake.getNodeGroupStackTypeMutex.RLock()
-	defer fake.getNodeGroupStackTypeMutex.RUnlock()
-	argsForCall := fake.getNodeGroupStackTypeArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeStackManager) DescribeNodeGroupStacksAndResourcesArgsForCall(i int) context.Context {
+	fake.describeNodeGroupStacksAndResourcesMutex.RLock()
+	defer fake.describeNodeGroupStacksAndResourcesMutex.RUnlock()
+	argsForCall := fake.describeNodeGroupStacksAndResourcesArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) GetNodeGroupStackTypeReturns(result1 v1alpha5.NodeGroupType, result2 error) {
-	fake.getNodeGroupStackTypeMutex.Lock()
-	defer fake.getNodeGroupStackTypeMutex.Unlock()
-	fake.GetNodeGroupStackTypeStub = nil
-	fake.getNodeGroupStackTypeReturns = struct {
-		result1 v1alpha5.NodeGroupType
+func (fake *FakeStackManager) DescribeNodeGroupStacksAndResourcesReturns(result1 map[string]manager.StackInfo, result2 error) {
+	fake.describeNodeGroupStacksAndResourcesMutex.Lock()
+	defer fake.describeNodeGroupStacksAndResourcesMutex.Unlock()
+	fake.DescribeNodeGroupStacksAndResourcesStub = nil
+	fake.describeNodeGroupStacksAndResourcesReturns = struct {
+		result1 map[string]manager.StackInfo
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetNodeGroupStackTypeReturnsOnCall(i int, result1 v1alpha5.NodeGroupType, result2 error) {
-	fake.getNodeGroupStackTypeMutex.Lock()
-	defer fake.getNodeGroupStackTypeMutex.Unlock()
-	fake.GetNodeGroupStackTypeStub = nil
-	if fake.getNodeGroupStackTypeReturnsOnCall == nil {
-		fake.getNodeGroupStackTypeReturnsOnCall = make(map[int]struct {
-			result1 v1alpha5.NodeGroupType
+func (fake *FakeStackManager) DescribeNodeGroupStacksAndResourcesReturnsOnCall(i int, result1 map[string]manager.StackInfo, result2 error) {
+	fake.describeNodeGroupStacksAndResourcesMutex.Lock()
+	defer fake.describeNodeGroupStacksAndResourcesMutex.Unlock()
+	fake.DescribeNodeGroupStacksAndResourcesStub = nil
+	if fake.describeNodeGroupStacksAndResourcesReturnsOnCall == nil {
+		fake.describeNodeGroupStacksAndResourcesReturnsOnCall = make(map[int]struct {
+			result1 map[string]manager.StackInfo
 			result2 error
 		})
 	}
-	fake.getNodeGroupStackTypeReturnsOnCall[i] = struct {
-		result1 v1alpha5.NodeGroupType
+	fake.describeNodeGroupStacksAndResourcesReturnsOnCall[i] = struct {
+		result1 map[string]manager.StackInfo
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetStackTemplate(arg1 context.Context, arg2 string) (string, error) {
-	fake.getStackTemplateMutex.Lock()
-	ret, specificReturn := fake.getStackTemplateReturnsOnCall[len(fake.getStackTemplateArgsForCall)]
-	fake.getStackTemplateArgsForCall = append(fake.getStackTemplateArgsForCall, struct {
+func (fake *FakeStackManager) DescribeNodeGroupStacksCreatedBetween(arg1 context.Context, arg2 time.Time, arg3 time.Time) ([]*types.Stack, error) {
+	fake.describeNodeGroupStacksCreatedBetweenMutex.Lock()
+	ret, specificReturn := fake.describeNodeGroupStacksCreatedBetweenReturnsOnCall[len(fake.describeNodeGroupStacksCreatedBetweenArgsForCall)]
+	fake.describeNodeGroupStacksCreatedBetweenArgsForCall = append(fake.describeNodeGroupStacksCreatedBetweenArgsForCall, struct {
 		arg1 context.Context
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.GetStackTemplateStub
-	fakeReturns := fake.getStackTemplateReturns
-	fake.recordInvocation("GetStackTemplate", []interface{}{arg1, arg2})
-	fake.getStackTemplateMutex.Unlock()
+		arg2 time.Time
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.DescribeNodeGroupStacksCreatedBetweenStub
+	fakeReturns := fake.describeNodeGroupStacksCreatedBetweenReturns
+	fake.recordInvocation("DescribeNodeGroupStacksCreatedBetween", []interface{}{arg1, arg2, arg3})
+	fake.describeNodeGroupStacksCreatedBetweenMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2727,62 +4110,62 @@ func (fake *FakeStackManager) GetStackTemplate(arg1 context.Context, arg2 string
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetStackTemplateCallCount() int {
-	fake.getStackTemplateMutex.RLock()
-	defer fake.getStackTemplateMutex.RUnlock()
-	return len(fake.getStackTemplateArgsForCall)
+func (fake *FakeStackManager) DescribeNodeGroupStacksCreatedBetweenCallCount() int {
+	fake.describeNodeGroupStacksCreatedBetweenMutex.RLock()
+	defer fake.describeNodeGroupStacksCreatedBetweenMutex.RUnlock()
+	return len(fake.describeNodeGroupStacksCreatedBetweenArgsForCall)
 }
 
-func (fake *FakeStackManager) GetStackTemplateCalls(stub func(context.Context, string) (string, error)) {
-	fake.getStackTemplateMutex.Lock()
-	defer fake.getStackTemplateMutex.Unlock()
-	fake.GetStackTemplateStub = stub
+func (fake *FakeStackManager) DescribeNodeGroupStacksCreatedBetweenCalls(stub func(context.Context, time.Time, time.Time) ([]*types.Stack, error)) {
+	fake.describeNodeGroupStacksCreatedBetweenMutex.Lock()
+	defer fake.describeNodeGroupStacksCreatedBetweenMutex.Unlock()
+	fake.DescribeNodeGroupStacksCreatedBetweenStub = stub
 }
 
-func (fake *FakeStackManager) GetStackTemplateArgsForCall(i int) (context.Context, string) {
-	fake.getStackTemplateMutex.RLock()
-	defer fake.getStackTemplateMutex.RUnlock()
-	argsForCall := fake.getStackTemplateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeStackManager) DescribeNodeGroupStacksCreatedBetweenArgsForCall(i int) (context.Context, time.Time, time.Time) {
+	fake.describeNodeGroupStacksCreatedBetweenMutex.RLock()
+	defer fake.describeNodeGroupStacksCreatedBetweenMutex.RUnlock()
+	argsForCall := fake.describeNodeGroupStacksCreatedBetweenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) GetStackTemplateReturns(result1 string, result2 error) {
-	fake.getStackTemplateMutex.Lock()
-	defer fake.getStackTemplateMutex.Unlock()
-	fake.GetStackTemplateStub = nil
-	fake.getStackTemplateReturns = struct {
-		result1 string
+func (fake *FakeStackManager) DescribeNodeGroupStacksCreatedBetweenReturns(result1 []*types.Stack, result2 error) {
+	fake.describeNodeGroupStacksCreatedBetweenMutex.Lock()
+	defer fake.describeNodeGroupStacksCreatedBetweenMutex.Unlock()
+	fake.DescribeNodeGroupStacksCreatedBetweenStub = nil
+	fake.describeNodeGroupStacksCreatedBetweenReturns = struct {
+		result1 []*types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetStackTemplateReturnsOnCall(i int, result1 string, result2 error) {
-	fake.getStackTemplateMutex.Lock()
-	defer fake.getStackTemplateMutex.Unlock()
-	fake.GetStackTemplateStub = nil
-	if fake.getStackTemplateReturnsOnCall == nil {
-		fake.getStackTemplateReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeStackManager) DescribeNodeGroupStacksCreatedBetweenReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
+	fake.describeNodeGroupStacksCreatedBetweenMutex.Lock()
+	defer fake.describeNodeGroupStacksCreatedBetweenMutex.Unlock()
+	fake.DescribeNodeGroupStacksCreatedBetweenStub = nil
+	if fake.describeNodeGroupStacksCreatedBetweenReturnsOnCall == nil {
+		fake.describeNodeGroupStacksCreatedBetweenReturnsOnCall = make(map[int]struct {
+			result1 []*types.Stack
 			result2 error
 		})
 	}
-	fake.getStackTemplateReturnsOnCall[i] = struct {
-		result1 string
+	fake.describeNodeGroupStacksCreatedBetweenReturnsOnCall[i] = struct {
+		result1 []*types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetUnmanagedNodeGroupAutoScalingGroupName(arg1 context.Context, arg2 *types.Stack) (string, error) {
-	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Lock()
-	ret, specificReturn := fake.getUnmanagedNodeGroupAutoScalingGroupNameReturnsOnCall[len(fake.getUnmanagedNodeGroupAutoScalingGroupNameArgsForCall)]
-	fake.getUnmanagedNodeGroupAutoScalingGroupNameArgsForCall = append(fake.getUnmanagedNodeGroupAutoScalingGroupNameArgsForCall, struct {
+func (fake *FakeStackManager) DescribeNodeGroupStacksMatchingTags(arg1 context.Context, arg2 map[string]string) ([]*types.Stack, error) {
+	fake.describeNodeGroupStacksMatchingTagsMutex.Lock()
+	ret, specificReturn := fake.describeNodeGroupStacksMatchingTagsReturnsOnCall[len(fake.describeNodeGroupStacksMatchingTagsArgsForCall)]
+	fake.describeNodeGroupStacksMatchingTagsArgsForCall = append(fake.describeNodeGroupStacksMatchingTagsArgsForCall, struct {
 		arg1 context.Context
-		arg2 *types.Stack
+		arg2 map[string]string
 	}{arg1, arg2})
-	stub := fake.GetUnmanagedNodeGroupAutoScalingGroupNameStub
-	fakeReturns := fake.getUnmanagedNodeGroupAutoScalingGroupNameReturns
-	fake.recordInvocation("GetUnmanagedNodeGroupAutoScalingGroupName", []interface{}{arg1, arg2})
-	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Unlock()
+	stub := fake.DescribeNodeGroupStacksMatchingTagsStub
+	fakeReturns := fake.describeNodeGroupStacksMatchingTagsReturns
+	fake.recordInvocation("DescribeNodeGroupStacksMatchingTags", []interface{}{arg1, arg2})
+	fake.describeNodeGroupStacksMatchingTagsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -2792,70 +4175,64 @@ func (fake *FakeStackManager) GetUnmanagedNodeGroupAutoScalingGroupName(arg1 con
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetUnmanagedNodeGroupAutoScalingGroupNameCallCount() int {
-	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.RLock()
-	defer fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.RUnlock()
-	return len(fake.getUnmanagedNodeGroupAutoScalingGroupNameArgsForCall)
+func (fake *FakeStackManager) DescribeNodeGroupStacksMatchingTagsCallCount() int {
+	fake.describeNodeGroupStacksMatchingTagsMutex.RLock()
+	defer fake.describeNodeGroupStacksMatchingTagsMutex.RUnlock()
+	return len(fake.describeNodeGroupStacksMatchingTagsArgsForCall)
 }
 
-func (fake *FakeStackManager) GetUnmanagedNodeGroupAutoScalingGroupNameCalls(stub func(context.Context, *types.Stack) (string, error)) {
-	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Lock()
-	defer fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Unlock()
-	fake.GetUnmanagedNodeGroupAutoScalingGroupNameStub = stub
+func (fake *FakeStackManager) DescribeNodeGroupStacksMatchingTagsCalls(stub func(context.Context, map[string]string) ([]*types.Stack, error)) {
+	fake.describeNodeGroupStacksMatchingTagsMutex.Lock()
+	defer fake.describeNodeGroupStacksMatchingTagsMutex.Unlock()
+	fake.DescribeNodeGroupStacksMatchingTagsStub = stub
 }
 
-func (fake *FakeStackManager) GetUnmanagedNodeGroupAutoScalingGroupNameArgsForCall(i int) (context.Context, *types.Stack) {
-	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.RLock()
-	defer fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.RUnlock()
-	argsForCall := fake.getUnmanagedNodeGroupAutoScalingGroupNameArgsForCall[i]
+func (fake *FakeStackManager) DescribeNodeGroupStacksMatchingTagsArgsForCall(i int) (context.Context, map[string]string) {
+	fake.describeNodeGroupStacksMatchingTagsMutex.RLock()
+	defer fake.describeNodeGroupStacksMatchingTagsMutex.RUnlock()
+	argsForCall := fake.describeNodeGroupStacksMatchingTagsArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) GetUnmanagedNodeGroupAutoScalingGroupNameReturns(result1 string, result2 error) {
-	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Lock()
-	defer fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Unlock()
-	fake.GetUnmanagedNodeGroupAutoScalingGroupNameStub = nil
-	fake.getUnmanagedNodeGroupAutoScalingGroupNameReturns = struct {
-		result1 string
+func (fake *FakeStackManager) DescribeNodeGroupStacksMatchingTagsReturns(result1 []*types.Stack, result2 error) {
+	fake.describeNodeGroupStacksMatchingTagsMutex.Lock()
+	defer fake.describeNodeGroupStacksMatchingTagsMutex.Unlock()
+	fake.DescribeNodeGroupStacksMatchingTagsStub = nil
+	fake.describeNodeGroupStacksMatchingTagsReturns = struct {
+		result1 []*types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetUnmanagedNodeGroupAutoScalingGroupNameReturnsOnCall(i int, result1 string, result2 error) {
-	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Lock()
-	defer fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Unlock()
-	fake.GetUnmanagedNodeGroupAutoScalingGroupNameStub = nil
-	if fake.getUnmanagedNodeGroupAutoScalingGroupNameReturnsOnCall == nil {
-		fake.getUnmanagedNodeGroupAutoScalingGroupNameReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeStackManager) DescribeNodeGroupStacksMatchingTagsReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
+	fake.describeNodeGroupStacksMatchingTagsMutex.Lock()
+	defer fake.describeNodeGroupStacksMatchingTagsMutex.Unlock()
+	fake.DescribeNodeGroupStacksMatchingTagsStub = nil
+	if fake.describeNodeGroupStacksMatchingTagsReturnsOnCall == nil {
+		fake.describeNodeGroupStacksMatchingTagsReturnsOnCall = make(map[int]struct {
+			result1 []*types.Stack
 			result2 error
 		})
 	}
-	fake.getUnmanagedNodeGroupAutoScalingGroupNameReturnsOnCall[i] = struct {
-		result1 string
+	fake.describeNodeGroupStacksMatchingTagsReturnsOnCall[i] = struct {
+		result1 []*types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) HasClusterStackFromList(arg1 context.Context, arg2 []string, arg3 string) (bool, error) {
-	var arg2Copy []string
-	if arg2 != nil {
-		arg2Copy = make([]string, len(arg2))
-		copy(arg2Copy, arg2)
-	}
-	fake.hasClusterStackFromListMutex.Lock()
-	ret, specificReturn := fake.hasClusterStackFromListReturnsOnCall[len(fake.hasClusterStackFromListArgsForCall)]
-	fake.hasClusterStackFromListArgsForCall = append(fake.hasClusterStackFromListArgsForCall, struct {
+func (fake *FakeStackManager) DescribeStack(arg1 context.Context, arg2 *types.Stack) (*types.Stack, error) {
+	fake.describeStackMutex.Lock()
+	ret, specificReturn := fake.describeStackReturnsOnCall[len(fake.describeStackArgsForCall)]
+	fake.describeStackArgsForCall = append(fake.describeStackArgsForCall, struct {
 		arg1 context.Context
-		arg2 []string
-		arg3 string
-	}{arg1, arg2Copy, arg3})
-	stub := fake.HasClusterStackFromListStub
-	fakeReturns := fake.hasClusterStackFromListReturns
-	fake.recordInvocation("HasClusterStackFromList", []interface{}{arg1, arg2Copy, arg3})
-	fake.hasClusterStackFromListMutex.Unlock()
+		arg2 *types.Stack
+	}{arg1, arg2})
+	stub := fake.DescribeStackStub
+	fakeReturns := fake.describeStackReturns
+	fake.recordInvocation("DescribeStack", []interface{}{arg1, arg2})
+	fake.describeStackMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2863,63 +4240,65 @@ func (fake *FakeStackManager) HasClusterStackFromList(arg1 context.Context, arg2
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) HasClusterStackFromListCallCount() int {
-	fake.hasClusterStackFromListMutex.RLock()
-	defer fake.hasClusterStackFromListMutex.RUnlock()
-	return len(fake.hasClusterStackFromListArgsForCall)
+func (fake *FakeStackManager) DescribeStackCallCount() int {
+	fake.describeStackMutex.RLock()
+	defer fake.describeStackMutex.RUnlock()
+	return len(fake.describeStackArgsForCall)
 }
 
-func (fake *FakeStackManager) HasClusterStackFromListCalls(stub func(context.Context, []string, string) (bool, error)) {
-	fake.hasClusterStackFromListMutex.Lock()
-	defer fake.hasClusterStackFromListMutex.Unlock()
-	fake.HasClusterStackFromListStub = stub
+func (fake *FakeStackManager) DescribeStackCalls(stub func(context.Context, *types.Stack) (*types.Stack, error)) {
+	fake.describeStackMutex.Lock()
+	defer fake.describeStackMutex.Unlock()
+	fake.DescribeStackStub = stub
 }
 
-func (fake *FakeStackManager) HasClusterStackFromListArgsForCall(i int) (context.Context, []string, string) {
-	fake.hasClusterStackFromListMutex.RLock()
-	defer fake.hasClusterStackFromListMutex.RUnlock()
-	argsForCall := fake.hasClusterStackFromListArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeStackManager) DescribeStackArgsForCall(i int) (context.Context, *types.Stack) {
+	fake.describeStackMutex.RLock()
+	defer fake.describeStackMutex.RUnlock()
+	argsForCall := fake.describeStackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) HasClusterStackFromListReturns(result1 bool, result2 error) {
-	fake.hasClusterStackFromListMutex.Lock()
-	defer fake.hasClusterStackFromListMutex.Unlock()
-	fake.HasClusterStackFromListStub = nil
-	fake.hasClusterStackFromListReturns = struct {
-		result1 bool
+func (fake *FakeStackManager) DescribeStackReturns(result1 *types.Stack, result2 error) {
+	fake.describeStackMutex.Lock()
+	defer fake.describeStackMutex.Unlock()
+	fake.DescribeStackStub = nil
+	fake.describeStackReturns = struct {
+		result1 *types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) HasClusterStackFromListReturnsOnCall(i int, result1 bool, result2 error) {
-	fake.hasClusterStackFromListMutex.Lock()
-	defer fake.hasClusterStackFromListMutex.Unlock()
-	fake.HasClusterStackFromListStub = nil
-	if fake.hasClusterStackFromListReturnsOnCall == nil {
-		fake.hasClusterStackFromListReturnsOnCall = make(map[int]struct {
-			result1 bool
+func (fake *FakeStackManager) DescribeStackReturnsOnCall(i int, result1 *types.Stack, result2 error) {
+	fake.describeStackMutex.Lock()
+	defer fake.describeStackMutex.Unlock()
+	fake.DescribeStackStub = nil
+	if fake.describeStackReturnsOnCall == nil {
+		fake.describeStackReturnsOnCall = make(map[int]struct {
+			result1 *types.Stack
 			result2 error
 		})
 	}
-	fake.hasClusterStackFromListReturnsOnCall[i] = struct {
-		result1 bool
+	fake.describeStackReturnsOnCall[i] = struct {
+		result1 *types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListClusterStackNames(arg1 context.Context) ([]string, error) {
-	fake.listClusterStackNamesMutex.Lock()
-	ret, specificReturn := fake.listClusterStackNamesReturnsOnCall[len(fake.listClusterStackNamesArgsForCall)]
-	fake.listClusterStackNamesArgsForCall = append(fake.listClusterStackNamesArgsForCall, struct {
+func (fake *FakeStackManager) DescribeStackChangeSet(arg1 context.Context, arg2 *types.Stack, arg3 string) (*cloudformation.DescribeChangeSetOutput, error) {
+	fake.describeStackChangeSetMutex.Lock()
+	ret, specificReturn := fake.describeStackChangeSetReturnsOnCall[len(fake.describeStackChangeSetArgsForCall)]
+	fake.describeStackChangeSetArgsForCall = append(fake.describeStackChangeSetArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.ListClusterStackNamesStub
-	fakeReturns := fake.listClusterStackNamesReturns
-	fake.recordInvocation("ListClusterStackNames", []interface{}{arg1})
-	fake.listClusterStackNamesMutex.Unlock()
+		arg2 *types.Stack
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DescribeStackChangeSetStub
+	fakeReturns := fake.describeStackChangeSetReturns
+	fake.recordInvocation("DescribeStackChangeSet", []interface{}{arg1, arg2, arg3})
+	fake.describeStackChangeSetMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2927,63 +4306,64 @@ func (fake *FakeStackManager) ListClusterStackNames(arg1 context.Context) ([]str
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) ListClusterStackNamesCallCount() int {
-	fake.listClusterStackNamesMutex.RLock()
-	defer fake.listClusterStackNamesMutex.RUnlock()
-	return len(fake.listClusterStackNamesArgsForCall)
+func (fake *FakeStackManager) DescribeStackChangeSetCallCount() int {
+	fake.describeStackChangeSetMutex.RLock()
+	defer fake.describeStackChangeSetMutex.RUnlock()
+	return len(fake.describeStackChangeSetArgsForCall)
 }
 
-func (fake *FakeStackManager) ListClusterStackNamesCalls(stub func(context.Context) ([]string, error)) {
-	fake.listClusterStackNamesMutex.Lock()
-	defer fake.listClusterStackNamesMutex.Unlock()
-	fake.ListClusterStackNamesStub = stub
+func (fake *FakeStackManager) DescribeStackChangeSetCalls(stub func(context.Context, *types.Stack, string) (*cloudformation.DescribeChangeSetOutput, error)) {
+	fake.describeStackChangeSetMutex.Lock()
+	defer fake.describeStackChangeSetMutex.Unlock()
+	fake.DescribeStackChangeSetStub = stub
 }
 
-func (fake *FakeStackManager) ListClusterStackNamesArgsForCall(i int) context.Context {
-	fake.listClusterStackNamesMutex.RLock()
-	defer fake.listClusterStackNamesMutex.RUnlock()
-	argsForCall := fake.listClusterStackNamesArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) DescribeStackChangeSetArgsForCall(i int) (context.Context, *types.Stack, string) {
+	fake.describeStackChangeSetMutex.RLock()
+	defer fake.describeStackChangeSetMutex.RUnlock()
+	argsForCall := fake.describeStackChangeSetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) ListClusterStackNamesReturns(result1 []string, result2 error) {
-	fake.listClusterStackNamesMutex.Lock()
-	defer fake.listClusterStackNamesMutex.Unlock()
-	fake.ListClusterStackNamesStub = nil
-	fake.listClusterStackNamesReturns = struct {
-		result1 []string
+func (fake *FakeStackManager) DescribeStackChangeSetReturns(result1 *cloudformation.DescribeChangeSetOutput, result2 error) {
+	fake.describeStackChangeSetMutex.Lock()
+	defer fake.describeStackChangeSetMutex.Unlock()
+	fake.DescribeStackChangeSetStub = nil
+	fake.describeStackChangeSetReturns = struct {
+		result1 *cloudformation.DescribeChangeSetOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListClusterStackNamesReturnsOnCall(i int, result1 []string, result2 error) {
-	fake.listClusterStackNamesMutex.Lock()
-	defer fake.listClusterStackNamesMutex.Unlock()
-	fake.ListClusterStackNamesStub = nil
-	if fake.listClusterStackNamesReturnsOnCall == nil {
-		fake.listClusterStackNamesReturnsOnCall = make(map[int]struct {
-			result1 []string
+func (fake *FakeStackManager) DescribeStackChangeSetReturnsOnCall(i int, result1 *cloudformation.DescribeChangeSetOutput, result2 error) {
+	fake.describeStackChangeSetMutex.Lock()
+	defer fake.describeStackChangeSetMutex.Unlock()
+	fake.DescribeStackChangeSetStub = nil
+	if fake.describeStackChangeSetReturnsOnCall == nil {
+		fake.describeStackChangeSetReturnsOnCall = make(map[int]struct {
+			result1 *cloudformation.DescribeChangeSetOutput
 			result2 error
 		})
 	}
-	fake.listClusterStackNamesReturnsOnCall[i] = struct {
-		result1 []string
+	fake.describeStackChangeSetReturnsOnCall[i] = struct {
+		result1 *cloudformation.DescribeChangeSetOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListIAMServiceAccountStacks(arg1 context.Context) ([]string, error) {
-	fake.listIAMServiceAccountStacksMutex.Lock()
-	ret, specificReturn := fake.listIAMServiceAccountStacksReturnsOnCall[len(fake.listIAMServiceAccountStacksArgsForCall)]
-	fake.listIAMServiceAccountStacksArgsForCall = append(fake.listIAMServiceAccountStacksArgsForCall, struct {
+func (fake *FakeStackManager) DescribeStackEvents(arg1 context.Context, arg2 *types.Stack) ([]types.StackEvent, error) {
+	fake.describeStackEventsMutex.Lock()
+	ret, specificReturn := fake.describeStackEventsReturnsOnCall[len(fake.describeStackEventsArgsForCall)]
+	fake.describeStackEventsArgsForCall = append(fake.describeStackEventsArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.ListIAMServiceAccountStacksStub
-	fakeReturns := fake.listIAMServiceAccountStacksReturns
-	fake.recordInvocation("ListIAMServiceAccountStacks", []interface{}{arg1})
-	fake.listIAMServiceAccountStacksMutex.Unlock()
+		arg2 *types.Stack
+	}{arg1, arg2})
+	stub := fake.DescribeStackEventsStub
+	fakeReturns := fake.describeStackEventsReturns
+	fake.recordInvocation("DescribeStackEvents", []interface{}{arg1, arg2})
+	fake.describeStackEventsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2991,128 +4371,131 @@ func (fake *FakeStackManager) ListIAMServiceAccountStacks(arg1 context.Context)
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) ListIAMServiceAccountStacksCallCount() int {
-	fake.listIAMServiceAccountStacksMutex.RLock()
-	defer fake.listIAMServiceAccountStacksMutex.RUnlock()
-	return len(fake.listIAMServiceAccountStacksArgsForCall)
+func (fake *FakeStackManager) DescribeStackEventsCallCount() int {
+	fake.describeStackEventsMutex.RLock()
+	defer fake.describeStackEventsMutex.RUnlock()
+	return len(fake.describeStackEventsArgsForCall)
 }
 
-func (fake *FakeStackManager) ListIAMServiceAccountStacksCalls(stub func(context.Context) ([]string, error)) {
-	fake.listIAMServiceAccountStacksMutex.Lock()
-	defer fake.listIAMServiceAccountStacksMutex.Unlock()
-	fake.ListIAMServiceAccountStacksStub = stub
+func (fake *FakeStackManager) DescribeStackEventsCalls(stub func(context.Context, *types.Stack) ([]types.StackEvent, error)) {
+	fake.describeStackEventsMutex.Lock()
+	defer fake.describeStackEventsMutex.Unlock()
+	fake.DescribeStackEventsStub = stub
 }
 
-func (fake *FakeStackManager) ListIAMServiceAccountStacksArgsForCall(i int) context.Context {
-	fake.listIAMServiceAccountStacksMutex.RLock()
-	defer fake.listIAMServiceAccountStacksMutex.RUnlock()
-	argsForCall := fake.listIAMServiceAccountStacksArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) DescribeStackEventsArgsForCall(i int) (context.Context, *types.Stack) {
+	fake.describeStackEventsMutex.RLock()
+	defer fake.describeStackEventsMutex.RUnlock()
+	argsForCall := fake.describeStackEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) ListIAMServiceAccountStacksReturns(result1 []string, result2 error) {
-	fake.listIAMServiceAccountStacksMutex.Lock()
-	defer fake.listIAMServiceAccountStacksMutex.Unlock()
-	fake.ListIAMServiceAccountStacksStub = nil
-	fake.listIAMServiceAccountStacksReturns = struct {
-		result1 []string
+func (fake *FakeStackManager) DescribeStackEventsReturns(result1 []types.StackEvent, result2 error) {
+	fake.describeStackEventsMutex.Lock()
+	defer fake.describeStackEventsMutex.Unlock()
+	fake.DescribeStackEventsStub = nil
+	fake.describeStackEventsReturns = struct {
+		result1 []types.StackEvent
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListIAMServiceAccountStacksReturnsOnCall(i int, result1 []string, result2 error) {
-	fake.listIAMServiceAccountStacksMutex.Lock()
-	defer fake.listIAMServiceAccountStacksMutex.Unlock()
-	fake.ListIAMServiceAccountStacksStub = nil
-	if fake.listIAMServiceAccountStacksReturnsOnCall == nil {
-		fake.listIAMServiceAccountStacksReturnsOnCall = make(map[int]struct {
-			result1 []string
+func (fake *FakeStackManager) DescribeStackEventsReturnsOnCall(i int, result1 []types.StackEvent, result2 error) {
+	fake.describeStackEventsMutex.Lock()
+	defer fake.describeStackEventsMutex.Unlock()
+	fake.DescribeStackEventsStub = nil
+	if fake.describeStackEventsReturnsOnCall == nil {
+		fake.describeStackEventsReturnsOnCall = make(map[int]struct {
+			result1 []types.StackEvent
 			result2 error
 		})
 	}
-	fake.listIAMServiceAccountStacksReturnsOnCall[i] = struct {
-		result1 []string
+	fake.describeStackEventsReturnsOnCall[i] = struct {
+		result1 []types.StackEvent
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListNodeGroupStacks(arg1 context.Context) ([]manager.NodeGroupStack, error) {
-	fake.listNodeGroupStacksMutex.Lock()
-	ret, specificReturn := fake.listNodeGroupStacksReturnsOnCall[len(fake.listNodeGroupStacksArgsForCall)]
-	fake.listNodeGroupStacksArgsForCall = append(fake.listNodeGroupStacksArgsForCall, struct {
+func (fake *FakeStackManager) DescribeStackIfExists(arg1 context.Context, arg2 *types.Stack) (*types.Stack, bool, error) {
+	fake.describeStackIfExistsMutex.Lock()
+	ret, specificReturn := fake.describeStackIfExistsReturnsOnCall[len(fake.describeStackIfExistsArgsForCall)]
+	fake.describeStackIfExistsArgsForCall = append(fake.describeStackIfExistsArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.ListNodeGroupStacksStub
-	fakeReturns := fake.listNodeGroupStacksReturns
-	fake.recordInvocation("ListNodeGroupStacks", []interface{}{arg1})
-	fake.listNodeGroupStacksMutex.Unlock()
+		arg2 *types.Stack
+	}{arg1, arg2})
+	stub := fake.DescribeStackIfExistsStub
+	fakeReturns := fake.describeStackIfExistsReturns
+	fake.recordInvocation("DescribeStackIfExists", []interface{}{arg1, arg2})
+	fake.describeStackIfExistsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeStackManager) ListNodeGroupStacksCallCount() int {
-	fake.listNodeGroupStacksMutex.RLock()
-	defer fake.listNodeGroupStacksMutex.RUnlock()
-	return len(fake.listNodeGroupStacksArgsForCall)
+func (fake *FakeStackManager) DescribeStackIfExistsCallCount() int {
+	fake.describeStackIfExistsMutex.RLock()
+	defer fake.describeStackIfExistsMutex.RUnlock()
+	return len(fake.describeStackIfExistsArgsForCall)
 }
 
-func (fake *FakeStackManager) ListNodeGroupStacksCalls(stub func(context.Context) ([]manager.NodeGroupStack, error)) {
-	fake.listNodeGroupStacksMutex.Lock()
-	defer fake.listNodeGroupStacksMutex.Unlock()
-	fake.ListNodeGroupStacksStub = stub
+func (fake *FakeStackManager) DescribeStackIfExistsCalls(stub func(context.Context, *types.Stack) (*types.Stack, bool, error)) {
+	fake.describeStackIfExistsMutex.Lock()
+	defer fake.describeStackIfExistsMutex.Unlock()
+	fake.DescribeStackIfExistsStub = stub
 }
 
-func (fake *FakeStackManager) ListNodeGroupStacksArgsForCall(i int) context.Context {
-	fake.listNodeGroupStacksMutex.RLock()
-	defer fake.listNodeGroupStacksMutex.RUnlock()
-	argsForCall := fake.listNodeGroupStacksArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) DescribeStackIfExistsArgsForCall(i int) (context.Context, *types.Stack) {
+	fake.describeStackIfExistsMutex.RLock()
+	defer fake.describeStackIfExistsMutex.RUnlock()
+	argsForCall := fake.describeStackIfExistsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) ListNodeGroupStacksReturns(result1 []manager.NodeGroupStack, result2 error) {
-	fake.listNodeGroupStacksMutex.Lock()
-	defer fake.listNodeGroupStacksMutex.Unlock()
-	fake.ListNodeGroupStacksStub = nil
-	fake.listNodeGroupStacksReturns = struct {
-		result1 []manager.NodeGroupStack
-		result2 error
-	}{result1, result2}
+func (fake *FakeStackManager) DescribeStackIfExistsReturns(result1 *types.Stack, result2 bool, result3 error) {
+	fake.describeStackIfExistsMutex.Lock()
+	defer fake.describeStackIfExistsMutex.Unlock()
+	fake.DescribeStackIfExistsStub = nil
+	fake.describeStackIfExistsReturns = struct {
+		result1 *types.Stack
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeStackManager) ListNodeGroupStacksReturnsOnCall(i int, result1 []manager.NodeGroupStack, result2 error) {
-	fake.listNodeGroupStacksMutex.Lock()
-	defer fake.listNodeGroupStacksMutex.Unlock()
-	fake.ListNodeGroupStacksStub = nil
-	if fake.listNodeGroupStacksReturnsOnCall == nil {
-		fake.listNodeGroupStacksReturnsOnCall = make(map[int]struct {
-			result1 []manager.NodeGroupStack
-			result2 error
+func (fake *FakeStackManager) DescribeStackIfExistsReturnsOnCall(i int, result1 *types.Stack, result2 bool, result3 error) {
+	fake.describeStackIfExistsMutex.Lock()
+	defer fake.describeStackIfExistsMutex.Unlock()
+	fake.DescribeStackIfExistsStub = nil
+	if fake.describeStackIfExistsReturnsOnCall == nil {
+		fake.describeStackIfExistsReturnsOnCall = make(map[int]struct {
+			result1 *types.Stack
+			result2 bool
+			result3 error
 		})
 	}
-	fake.listNodeGroupStacksReturnsOnCall[i] = struct {
-		result1 []manager.NodeGroupStack
-		result2 error
-	}{result1, result2}
+	fake.describeStackIfExistsReturnsOnCall[i] = struct {
+		result1 *types.Stack
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeStackManager) ListStacks(arg1 context.Context, arg2 ...types.StackStatus) ([]*types.Stack, error) {
-	fake.listStacksMutex.Lock()
-	ret, specificReturn := fake.listStacksReturnsOnCall[len(fake.listStacksArgsForCall)]
-	fake.listStacksArgsForCall = append(fake.listStacksArgsForCall, struct {
+func (fake *FakeStackManager) DescribeStacks(arg1 context.Context) ([]*types.Stack, error) {
+	fake.describeStacksMutex.Lock()
+	ret, specificReturn := fake.describeStacksReturnsOnCall[len(fake.describeStacksArgsForCall)]
+	fake.describeStacksArgsForCall = append(fake.describeStacksArgsForCall, struct {
 		arg1 context.Context
-		arg2 []types.StackStatus
-	}{arg1, arg2})
-	stub := fake.ListStacksStub
-	fakeReturns := fake.listStacksReturns
-	fake.recordInvocation("ListStacks", []interface{}{arg1, arg2})
-	fake.listStacksMutex.Unlock()
+	}{arg1})
+	stub := fake.DescribeStacksStub
+	fakeReturns := fake.describeStacksReturns
+	fake.recordInvocation("DescribeStacks", []interface{}{arg1})
+	fake.describeStacksMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2...)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -3120,65 +4503,65 @@ func (fake *FakeStackManager) ListStacks(arg1 context.Context, arg2 ...types.Sta
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) ListStacksCallCount() int {
-	fake.listStacksMutex.RLock()
-	defer fake.listStacksMutex.RUnlock()
-	return len(fake.listStacksArgsForCall)
+func (fake *FakeStackManager) DescribeStacksCallCount() int {
+	fake.describeStacksMutex.RLock()
+	defer fake.describeStacksMutex.RUnlock()
+	return len(fake.describeStacksArgsForCall)
 }
 
-func (fake *FakeStackManager) ListStacksCalls(stub func(context.Context, ...types.StackStatus) ([]*types.Stack, error)) {
-	fake.listStacksMutex.Lock()
-	defer fake.listStacksMutex.Unlock()
-	fake.ListStacksStub = stub
+func (fake *FakeStackManager) DescribeStacksCalls(stub func(context.Context) ([]*types.Stack, error)) {
+	fake.describeStacksMutex.Lock()
+	defer fake.describeStacksMutex.Unlock()
+	fake.DescribeStacksStub = stub
 }
 
-func (fake *FakeStackManager) ListStacksArgsForCall(i int) (context.Context, []types.StackStatus) {
-	fake.listStacksMutex.RLock()
-	defer fake.listStacksMutex.RUnlock()
-	argsForCall := fake.listStacksArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeStackManager) DescribeStacksArgsForCall(i int) context.Context {
+	fake.describeStacksMutex.RLock()
+	defer fake.describeStacksMutex.RUnlock()
+	argsForCall := fake.describeStacksArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) ListStacksReturns(result1 []*types.Stack, result2 error) {
-	fake.listStacksMutex.Lock()
-	defer fake.listStacksMutex.Unlock()
-	fake.ListStacksStub = nil
-	fake.listStacksReturns = struct {
+func (fake *FakeStackManager) DescribeStacksReturns(result1 []*types.Stack, result2 error) {
+	fake.describeStacksMutex.Lock()
+	defer fake.describeStacksMutex.Unlock()
+	fake.DescribeStacksStub = nil
+	fake.describeStacksReturns = struct {
 		result1 []*types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListStacksReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
-	fake.listStacksMutex.Lock()
-	defer fake.listStacksMutex.Unlock()
-	fake.ListStacksStub = nil
-	if fake.listStacksReturnsOnCall == nil {
-		fake.listStacksReturnsOnCall = make(map[int]struct {
+func (fake *FakeStackManager) DescribeStacksReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
+	fake.describeStacksMutex.Lock()
+	defer fake.describeStacksMutex.Unlock()
+	fake.DescribeStacksStub = nil
+	if fake.describeStacksReturnsOnCall == nil {
+		fake.describeStacksReturnsOnCall = make(map[int]struct {
 			result1 []*types.Stack
 			result2 error
 		})
 	}
-	fake.listStacksReturnsOnCall[i] = struct {
+	fake.describeStacksReturnsOnCall[i] = struct {
 		result1 []*types.Stack
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListStacksMatching(arg1 context.Context, arg2 string, arg3 ...types.StackStatus) ([]*types.Stack, error) {
-	fake.listStacksMatchingMutex.Lock()
-	ret, specificReturn := fake.listStacksMatchingReturnsOnCall[len(fake.listStacksMatchingArgsForCall)]
-	fake.listStacksMatchingArgsForCall = append(fake.listStacksMatchingArgsForCall, struct {
+func (fake *FakeStackManager) DetectResourceDrift(arg1 context.Context, arg2 string, arg3 string) (*manager.ResourceDrift, error) {
+	fake.detectResourceDriftMutex.Lock()
+	ret, specificReturn := fake.detectResourceDriftReturnsOnCall[len(fake.detectResourceDriftArgsForCall)]
+	fake.detectResourceDriftArgsForCall = append(fake.detectResourceDriftArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-		arg3 []types.StackStatus
+		arg3 string
 	}{arg1, arg2, arg3})
-	stub := fake.ListStacksMatchingStub
-	fakeReturns := fake.listStacksMatchingReturns
-	fake.recordInvocation("ListStacksMatching", []interface{}{arg1, arg2, arg3})
-	fake.listStacksMatchingMutex.Unlock()
+	stub := fake.DetectResourceDriftStub
+	fakeReturns := fake.detectResourceDriftReturns
+	fake.recordInvocation("DetectResourceDrift", []interface{}{arg1, arg2, arg3})
+	fake.detectResourceDriftMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3...)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -3186,62 +4569,62 @@ func (fake *FakeStackManager) ListStacksMatching(arg1 context.Context, arg2 stri
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) ListStacksMatchingCallCount() int {
-	fake.listStacksMatchingMutex.RLock()
-	defer fake.listStacksMatchingMutex.RUnlock()
-	return len(fake.listStacksMatchingArgsForCall)
+func (fake *FakeStackManager) DetectResourceDriftCallCount() int {
+	fake.detectResourceDriftMutex.RLock()
+	defer fake.detectResourceDriftMutex.RUnlock()
+	return len(fake.detectResourceDriftArgsForCall)
 }
 
-func (fake *FakeStackManager) ListStacksMatchingCalls(stub func(context.Context, string, ...types.StackStatus) ([]*types.Stack, error)) {
-	fake.listStacksMatchingMutex.Lock()
-	defer fake.listStacksMatchingMutex.Unlock()
-	fake.ListStacksMatchingStub = stub
+func (fake *FakeStackManager) DetectResourceDriftCalls(stub func(context.Context, string, string) (*manager.ResourceDrift, error)) {
+	fake.detectResourceDriftMutex.Lock()
+	defer fake.detectResourceDriftMutex.Unlock()
+	fake.DetectResourceDriftStub = stub
 }
 
-func (fake *FakeStackManager) ListStacksMatchingArgsForCall(i int) (context.Context, string, []types.StackStatus) {
-	fake.listStacksMatchingMutex.RLock()
-	defer fake.listStacksMatchingMutex.RUnlock()
-	argsForCall := fake.listStacksMatchingArgsForCall[i]
+func (fake *FakeStackManager) DetectResourceDriftArgsForCall(i int) (context.Context, string, string) {
+	fake.detectResourceDriftMutex.RLock()
+	defer fake.detectResourceDriftMutex.RUnlock()
+	argsForCall := fake.detectResourceDriftArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) ListStacksMatchingReturns(result1 []*types.Stack, result2 error) {
-	fake.listStacksMatchingMutex.Lock()
-	defer fake.listStacksMatchingMutex.Unlock()
-	fake.ListStacksMatchingStub = nil
-	fake.listStacksMatchingReturns = struct {
-		result1 []*types.Stack
+func (fake *FakeStackManager) DetectResourceDriftReturns(result1 *manager.ResourceDrift, result2 error) {
+	fake.detectResourceDriftMutex.Lock()
+	defer fake.detectResourceDriftMutex.Unlock()
+	fake.DetectResourceDriftStub = nil
+	fake.detectResourceDriftReturns = struct {
+		result1 *manager.ResourceDrift
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListStacksMatchingReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
-	fake.listStacksMatchingMutex.Lock()
-	defer fake.listStacksMatchingMutex.Unlock()
-	fake.ListStacksMatchingStub = nil
-	if fake.listStacksMatchingReturnsOnCall == nil {
-		fake.listStacksMatchingReturnsOnCall = make(map[int]struct {
-			result1 []*types.Stack
+func (fake *FakeStackManager) DetectResourceDriftReturnsOnCall(i int, result1 *manager.ResourceDrift, result2 error) {
+	fake.detectResourceDriftMutex.Lock()
+	defer fake.detectResourceDriftMutex.Unlock()
+	fake.DetectResourceDriftStub = nil
+	if fake.detectResourceDriftReturnsOnCall == nil {
+		fake.detectResourceDriftReturnsOnCall = make(map[int]struct {
+			result1 *manager.ResourceDrift
 			result2 error
 		})
 	}
-	fake.listStacksMatchingReturnsOnCall[i] = struct {
-		result1 []*types.Stack
+	fake.detectResourceDriftReturnsOnCall[i] = struct {
+		result1 *manager.ResourceDrift
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) LookupCloudTrailEvents(arg1 context.Context, arg2 *types.Stack) ([]typesb.Event, error) {
-	fake.lookupCloudTrailEventsMutex.Lock()
-	ret, specificReturn := fake.lookupCloudTrailEventsReturnsOnCall[len(fake.lookupCloudTrailEventsArgsForCall)]
-	fake.lookupCloudTrailEventsArgsForCall = append(fake.lookupCloudTrailEventsArgsForCall, struct {
+func (fake *FakeStackManager) DetectStackDrift(arg1 context.Context, arg2 string) (*manager.DriftResult, error) {
+	fake.detectStackDriftMutex.Lock()
+	ret, specificReturn := fake.detectStackDriftReturnsOnCall[len(fake.detectStackDriftArgsForCall)]
+	fake.detectStackDriftArgsForCall = append(fake.detectStackDriftArgsForCall, struct {
 		arg1 context.Context
-		arg2 *types.Stack
+		arg2 string
 	}{arg1, arg2})
-	stub := fake.LookupCloudTrailEventsStub
-	fakeReturns := fake.lookupCloudTrailEventsReturns
-	fake.recordInvocation("LookupCloudTrailEvents", []interface{}{arg1, arg2})
-	fake.lookupCloudTrailEventsMutex.Unlock()
+	stub := fake.DetectStackDriftStub
+	fakeReturns := fake.detectStackDriftReturns
+	fake.recordInvocation("DetectStackDrift", []interface{}{arg1, arg2})
+	fake.detectStackDriftMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -3251,123 +4634,141 @@ func (fake *FakeStackManager) LookupCloudTrailEvents(arg1 context.Context, arg2
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) LookupCloudTrailEventsCallCount() int {
-	fake.lookupCloudTrailEventsMutex.RLock()
-	defer fake.lookupCloudTrailEventsMutex.RUnlock()
-	return len(fake.lookupCloudTrailEventsArgsForCall)
+func (fake *FakeStackManager) DetectStackDriftCallCount() int {
+	fake.detectStackDriftMutex.RLock()
+	defer fake.detectStackDriftMutex.RUnlock()
+	return len(fake.detectStackDriftArgsForCall)
 }
 
-func (fake *FakeStackManager) LookupCloudTrailEventsCalls(stub func(context.Context, *types.Stack) ([]typesb.Event, error)) {
-	fake.lookupCloudTrailEventsMutex.Lock()
-	defer fake.lookupCloudTrailEventsMutex.Unlock()
-	fake.LookupCloudTrailEventsStub = stub
+func (fake *FakeStackManager) DetectStackDriftCalls(stub func(context.Context, string) (*manager.DriftResult, error)) {
+	fake.detectStackDriftMutex.Lock()
+	defer fake.detectStackDriftMutex.Unlock()
+	fake.DetectStackDriftStub = stub
 }
 
-func (fake *FakeStackManager) LookupCloudTrailEventsArgsForCall(i int) (context.Context, *types.Stack) {
-	fake.lookupCloudTrailEventsMutex.RLock()
-	defer fake.lookupCloudTrailEventsMutex.RUnlock()
-	argsForCall := fake.lookupCloudTrailEventsArgsForCall[i]
+func (fake *FakeStackManager) DetectStackDriftArgsForCall(i int) (context.Context, string) {
+	fake.detectStackDriftMutex.RLock()
+	defer fake.detectStackDriftMutex.RUnlock()
+	argsForCall := fake.detectStackDriftArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) LookupCloudTrailEventsReturns(result1 []typesb.Event, result2 error) {
-	fake.lookupCloudTrailEventsMutex.Lock()
-	defer fake.lookupCloudTrailEventsMutex.Unlock()
-	fake.LookupCloudTrailEventsStub = nil
-	fake.lookupCloudTrailEventsReturns = struct {
-		result1 []typesb.Event
+func (fake *FakeStackManager) DetectStackDriftReturns(result1 *manager.DriftResult, result2 error) {
+	fake.detectStackDriftMutex.Lock()
+	defer fake.detectStackDriftMutex.Unlock()
+	fake.DetectStackDriftStub = nil
+	fake.detectStackDriftReturns = struct {
+		result1 *manager.DriftResult
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) LookupCloudTrailEventsReturnsOnCall(i int, result1 []typesb.Event, result2 error) {
-	fake.lookupCloudTrailEventsMutex.Lock()
-	defer fake.lookupCloudTrailEventsMutex.Unlock()
-	fake.LookupCloudTrailEventsStub = nil
-	if fake.lookupCloudTrailEventsReturnsOnCall == nil {
-		fake.lookupCloudTrailEventsReturnsOnCall = make(map[int]struct {
-			result1 []typesb.Event
+func (fake *FakeStackManager) DetectStackDriftReturnsOnCall(i int, result1 *manager.DriftResult, result2 error) {
+	fake.detectStackDriftMutex.Lock()
+	defer fake.detectStackDriftMutex.Unlock()
+	fake.DetectStackDriftStub = nil
+	if fake.detectStackDriftReturnsOnCall == nil {
+		fake.detectStackDriftReturnsOnCall = make(map[int]struct {
+			result1 *manager.DriftResult
 			result2 error
 		})
 	}
-	fake.lookupCloudTrailEventsReturnsOnCall[i] = struct {
-		result1 []typesb.Event
+	fake.detectStackDriftReturnsOnCall[i] = struct {
+		result1 *manager.DriftResult
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) MakeChangeSetName(arg1 string) string {
-	fake.makeChangeSetNameMutex.Lock()
-	ret, specificReturn := fake.makeChangeSetNameReturnsOnCall[len(fake.makeChangeSetNameArgsForCall)]
-	fake.makeChangeSetNameArgsForCall = append(fake.makeChangeSetNameArgsForCall, struct {
-		arg1 string
-	}{arg1})
-	stub := fake.MakeChangeSetNameStub
-	fakeReturns := fake.makeChangeSetNameReturns
-	fake.recordInvocation("MakeChangeSetName", []interface{}{arg1})
-	fake.makeChangeSetNameMutex.Unlock()
+func (fake *FakeStackManager) DiffManagedNodeGroupTags(arg1 context.Context, arg2 string, arg3 map[string]string) (map[string]string, map[string]string, map[string]string, error) {
+	fake.diffManagedNodeGroupTagsMutex.Lock()
+	ret, specificReturn := fake.diffManagedNodeGroupTagsReturnsOnCall[len(fake.diffManagedNodeGroupTagsArgsForCall)]
+	fake.diffManagedNodeGroupTagsArgsForCall = append(fake.diffManagedNodeGroupTagsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]string
+	}{arg1, arg2, arg3})
+	stub := fake.DiffManagedNodeGroupTagsStub
+	fakeReturns := fake.diffManagedNodeGroupTagsReturns
+	fake.recordInvocation("DiffManagedNodeGroupTags", []interface{}{arg1, arg2, arg3})
+	fake.diffManagedNodeGroupTagsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2, ret.result3, ret.result4
 	}
-	return fakeReturns.result1
-}
-
-func (fake *FakeStackManager) MakeChangeSetNameCallCount() int {
-	fake.makeChangeSetNameMutex.RLock()
-	defer fake.makeChangeSetNameMutex.RUnlock()
-	return len(fake.makeChangeSetNameArgsForCall)
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
 }
 
-func (fake *FakeStackManager) MakeChangeSetNameCalls(stub func(string) string) {
-	fake.makeChangeSetNameMutex.Lock()
-	defer fake.makeChangeSetNameMutex.Unlock()
-	fake.MakeChangeSetNameStub = stub
+func (fake *FakeStackManager) DiffManagedNodeGroupTagsCallCount() int {
+	fake.diffManagedNodeGroupTagsMutex.RLock()
+	defer fake.diffManagedNodeGroupTagsMutex.RUnlock()
+	return len(fake.diffManagedNodeGroupTagsArgsForCall)
 }
 
-func (fake *FakeStackManager) MakeChangeSetNameArgsForCall(i int) string {
-	fake.makeChangeSetNameMutex.RLock()
-	defer fake.makeChangeSetNameMutex.RUnlock()
-	argsForCall := fake.makeChangeSetNameArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) DiffManagedNodeGroupTagsCalls(stub func(context.Context, string, map[string]string) (map[string]string, map[string]string, map[string]string, error)) {
+	fake.diffManagedNodeGroupTagsMutex.Lock()
+	defer fake.diffManagedNodeGroupTagsMutex.Unlock()
+	fake.DiffManagedNodeGroupTagsStub = stub
 }
 
-func (fake *FakeStackManager) MakeChangeSetNameReturns(result1 string) {
-	fake.makeChangeSetNameMutex.Lock()
-	defer fake.makeChangeSetNameMutex.Unlock()
-	fake.MakeChangeSetNameStub = nil
-	fake.makeChangeSetNameReturns = struct {
-		result1 string
-	}{result1}
+func (fake *FakeStackManager) DiffManagedNodeGroupTagsArgsForCall(i int) (context.Context, string, map[string]string) {
+	fake.diffManagedNodeGroupTagsMutex.RLock()
+	defer fake.diffManagedNodeGroupTagsMutex.RUnlock()
+	argsForCall := fake.diffManagedNodeGroupTagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) MakeChangeSetNameReturnsOnCall(i int, result1 string) {
-	fake.makeChangeSetNameMutex.Lock()
-	defer fake.makeChangeSetNameMutex.Unlock()
-	fake.MakeChangeSetNameStub = nil
-	if fake.makeChangeSetNameReturnsOnCall == nil {
-		fake.makeChangeSetNameReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeStackManager) DiffManagedNodeGroupTagsReturns(result1 map[string]string, result2 map[string]string, result3 map[string]string, result4 error) {
+	fake.diffManagedNodeGroupTagsMutex.Lock()
+	defer fake.diffManagedNodeGroupTagsMutex.Unlock()
+	fake.DiffManagedNodeGroupTagsStub = nil
+	fake.diffManagedNodeGroupTagsReturns = struct {
+		result1 map[string]string
+		result2 map[string]string
+		result3 map[string]string
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeStackManager) DiffManagedNodeGroupTagsReturnsOnCall(i int, result1 map[string]string, result2 map[string]string, result3 map[string]string, result4 error) {
+	fake.diffManagedNodeGroupTagsMutex.Lock()
+	defer fake.diffManagedNodeGroupTagsMutex.Unlock()
+	fake.DiffManagedNodeGroupTagsStub = nil
+	if fake.diffManagedNodeGroupTagsReturnsOnCall == nil {
+		fake.diffManagedNodeGroupTagsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+			result2 map[string]string
+			result3 map[string]string
+			result4 error
 		})
 	}
-	fake.makeChangeSetNameReturnsOnCall[i] = struct {
-		result1 string
-	}{result1}
+	fake.diffManagedNodeGroupTagsReturnsOnCall[i] = struct {
+		result1 map[string]string
+		result2 map[string]string
+		result3 map[string]string
+		result4 error
+	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeStackManager) MakeClusterStackName() string {
-	fake.makeClusterStackNameMutex.Lock()
-	ret, specificReturn := fake.makeClusterStackNameReturnsOnCall[len(fake.makeClusterStackNameArgsForCall)]
-	fake.makeClusterStackNameArgsForCall = append(fake.makeClusterStackNameArgsForCall, struct {
-	}{})
-	stub := fake.MakeClusterStackNameStub
-	fakeReturns := fake.makeClusterStackNameReturns
-	fake.recordInvocation("MakeClusterStackName", []interface{}{})
-	fake.makeClusterStackNameMutex.Unlock()
+func (fake *FakeStackManager) DoCreateStackRequest(arg1 context.Context, arg2 *types.Stack, arg3 manager.TemplateData, arg4 map[string]string, arg5 map[string]string, arg6 bool, arg7 bool) error {
+	fake.doCreateStackRequestMutex.Lock()
+	ret, specificReturn := fake.doCreateStackRequestReturnsOnCall[len(fake.doCreateStackRequestArgsForCall)]
+	fake.doCreateStackRequestArgsForCall = append(fake.doCreateStackRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 *types.Stack
+		arg3 manager.TemplateData
+		arg4 map[string]string
+		arg5 map[string]string
+		arg6 bool
+		arg7 bool
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	stub := fake.DoCreateStackRequestStub
+	fakeReturns := fake.doCreateStackRequestReturns
+	fake.recordInvocation("DoCreateStackRequest", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.doCreateStackRequestMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	}
 	if specificReturn {
 		return ret.result1
@@ -3375,53 +4776,61 @@ func (fake *FakeStackManager) MakeClusterStackName() string {
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) MakeClusterStackNameCallCount() int {
-	fake.makeClusterStackNameMutex.RLock()
-	defer fake.makeClusterStackNameMutex.RUnlock()
-	return len(fake.makeClusterStackNameArgsForCall)
+func (fake *FakeStackManager) DoCreateStackRequestCallCount() int {
+	fake.doCreateStackRequestMutex.RLock()
+	defer fake.doCreateStackRequestMutex.RUnlock()
+	return len(fake.doCreateStackRequestArgsForCall)
 }
 
-func (fake *FakeStackManager) MakeClusterStackNameCalls(stub func() string) {
-	fake.makeClusterStackNameMutex.Lock()
-	defer fake.makeClusterStackNameMutex.Unlock()
-	fake.MakeClusterStackNameStub = stub
+func (fake *FakeStackManager) DoCreateStackRequestCalls(stub func(context.Context, *types.Stack, manager.TemplateData, map[string]string, map[string]string, bool, bool) error) {
+	fake.doCreateStackRequestMutex.Lock()
+	defer fake.doCreateStackRequestMutex.Unlock()
+	fake.DoCreateStackRequestStub = stub
 }
 
-func (fake *FakeStackManager) MakeClusterStackNameReturns(result1 string) {
-	fake.makeClusterStackNameMutex.Lock()
-	defer fake.makeClusterStackNameMutex.Unlock()
-	fake.MakeClusterStackNameStub = nil
-	fake.makeClusterStackNameReturns = struct {
-		result1 string
+func (fake *FakeStackManager) DoCreateStackRequestArgsForCall(i int) (context.Context, *types.Stack, manager.TemplateData, map[string]string, map[string]string, bool, bool) {
+	fake.doCreateStackRequestMutex.RLock()
+	defer fake.doCreateStackRequestMutex.RUnlock()
+	argsForCall := fake.doCreateStackRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeStackManager) DoCreateStackRequestReturns(result1 error) {
+	fake.doCreateStackRequestMutex.Lock()
+	defer fake.doCreateStackRequestMutex.Unlock()
+	fake.DoCreateStackRequestStub = nil
+	fake.doCreateStackRequestReturns = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) MakeClusterStackNameReturnsOnCall(i int, result1 string) {
-	fake.makeClusterStackNameMutex.Lock()
-	defer fake.makeClusterStackNameMutex.Unlock()
-	fake.MakeClusterStackNameStub = nil
-	if fake.makeClusterStackNameReturnsOnCall == nil {
-		fake.makeClusterStackNameReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeStackManager) DoCreateStackRequestReturnsOnCall(i int, result1 error) {
+	fake.doCreateStackRequestMutex.Lock()
+	defer fake.doCreateStackRequestMutex.Unlock()
+	fake.DoCreateStackRequestStub = nil
+	if fake.doCreateStackRequestReturnsOnCall == nil {
+		fake.doCreateStackRequestReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.makeClusterStackNameReturnsOnCall[i] = struct {
-		result1 string
+	fake.doCreateStackRequestReturnsOnCall[i] = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewClusterCompatTask(arg1 context.Context) tasks.Task {
-	fake.newClusterCompatTaskMutex.Lock()
-	ret, specificReturn := fake.newClusterCompatTaskReturnsOnCall[len(fake.newClusterCompatTaskArgsForCall)]
-	fake.newClusterCompatTaskArgsForCall = append(fake.newClusterCompatTaskArgsForCall, struct {
+func (fake *FakeStackManager) DoWaitUntilStackIsCreated(arg1 context.Context, arg2 *types.Stack) error {
+	fake.doWaitUntilStackIsCreatedMutex.Lock()
+	ret, specificReturn := fake.doWaitUntilStackIsCreatedReturnsOnCall[len(fake.doWaitUntilStackIsCreatedArgsForCall)]
+	fake.doWaitUntilStackIsCreatedArgsForCall = append(fake.doWaitUntilStackIsCreatedArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.NewClusterCompatTaskStub
-	fakeReturns := fake.newClusterCompatTaskReturns
-	fake.recordInvocation("NewClusterCompatTask", []interface{}{arg1})
-	fake.newClusterCompatTaskMutex.Unlock()
+		arg2 *types.Stack
+	}{arg1, arg2})
+	stub := fake.DoWaitUntilStackIsCreatedStub
+	fakeReturns := fake.doWaitUntilStackIsCreatedReturns
+	fake.recordInvocation("DoWaitUntilStackIsCreated", []interface{}{arg1, arg2})
+	fake.doWaitUntilStackIsCreatedMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -3429,68 +4838,84 @@ func (fake *FakeStackManager) NewClusterCompatTask(arg1 context.Context) tasks.T
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) NewClusterCompatTaskCallCount() int {
-	fake.newClusterCompatTaskMutex.RLock()
-	defer fake.newClusterCompatTaskMutex.RUnlock()
-	return len(fake.newClusterCompatTaskArgsForCall)
+func (fake *FakeStackManager) DoWaitUntilStackIsCreatedCallCount() int {
+	fake.doWaitUntilStackIsCreatedMutex.RLock()
+	defer fake.doWaitUntilStackIsCreatedMutex.RUnlock()
+	return len(fake.doWaitUntilStackIsCreatedArgsForCall)
 }
 
-func (fake *FakeStackManager) NewClusterCompatTaskCalls(stub func(context.Context) tasks.Task) {
-	fake.newClusterCompatTaskMutex.Lock()
-	defer fake.newClusterCompatTaskMutex.Unlock()
-	fake.NewClusterCompatTaskStub = stub
+func (fake *FakeStackManager) DoWaitUntilStackIsCreatedCalls(stub func(context.Context, *types.Stack) error) {
+	fake.doWaitUntilStackIsCreatedMutex.Lock()
+	defer fake.doWaitUntilStackIsCreatedMutex.Unlock()
+	fake.DoWaitUntilStackIsCreatedStub = stub
 }
 
-func (fake *FakeStackManager) NewClusterCompatTaskArgsForCall(i int) context.Context {
-	fake.newClusterCompatTaskMutex.RLock()
-	defer fake.newClusterCompatTaskMutex.RUnlock()
-	argsForCall := fake.newClusterCompatTaskArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) DoWaitUntilStackIsCreatedArgsForCall(i int) (context.Context, *types.Stack) {
+	fake.doWaitUntilStackIsCreatedMutex.RLock()
+	defer fake.doWaitUntilStackIsCreatedMutex.RUnlock()
+	argsForCall := fake.doWaitUntilStackIsCreatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) NewClusterCompatTaskReturns(result1 tasks.Task) {
-	fake.newClusterCompatTaskMutex.Lock()
-	defer fake.newClusterCompatTaskMutex.Unlock()
-	fake.NewClusterCompatTaskStub = nil
-	fake.newClusterCompatTaskReturns = struct {
-		result1 tasks.Task
+func (fake *FakeStackManager) DoWaitUntilStackIsCreatedReturns(result1 error) {
+	fake.doWaitUntilStackIsCreatedMutex.Lock()
+	defer fake.doWaitUntilStackIsCreatedMutex.Unlock()
+	fake.DoWaitUntilStackIsCreatedStub = nil
+	fake.doWaitUntilStackIsCreatedReturns = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewClusterCompatTaskReturnsOnCall(i int, result1 tasks.Task) {
-	fake.newClusterCompatTaskMutex.Lock()
-	defer fake.newClusterCompatTaskMutex.Unlock()
-	fake.NewClusterCompatTaskStub = nil
-	if fake.newClusterCompatTaskReturnsOnCall == nil {
-		fake.newClusterCompatTaskReturnsOnCall = make(map[int]struct {
-			result1 tasks.Task
+func (fake *FakeStackManager) DoWaitUntilStackIsCreatedReturnsOnCall(i int, result1 error) {
+	fake.doWaitUntilStackIsCreatedMutex.Lock()
+	defer fake.doWaitUntilStackIsCreatedMutex.Unlock()
+	fake.DoWaitUntilStackIsCreatedStub = nil
+	if fake.doWaitUntilStackIsCreatedReturnsOnCall == nil {
+		fake.doWaitUntilStackIsCreatedReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.newClusterCompatTaskReturnsOnCall[i] = struct {
-		result1 tasks.Task
+	fake.doWaitUntilStackIsCreatedReturnsOnCall[i] = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewManagedNodeGroupTask(arg1 context.Context, arg2 []*v1alpha5.ManagedNodeGroup, arg3 bool, arg4 vpc.Importer) *tasks.TaskTree {
-	var arg2Copy []*v1alpha5.ManagedNodeGroup
-	if arg2 != nil {
-		arg2Copy = make([]*v1alpha5.ManagedNodeGroup, len(arg2))
-		copy(arg2Copy, arg2)
+func (fake *FakeStackManager) EnableDefaultTemplateBucket() {
+	fake.enableDefaultTemplateBucketMutex.Lock()
+	fake.enableDefaultTemplateBucketArgsForCall = append(fake.enableDefaultTemplateBucketArgsForCall, struct {
+	}{})
+	stub := fake.EnableDefaultTemplateBucketStub
+	fake.recordInvocation("EnableDefaultTemplateBucket", []interface{}{})
+	fake.enableDefaultTemplateBucketMutex.Unlock()
+	if stub != nil {
+		fake.EnableDefaultTemplateBucketStub()
 	}
-	fake.newManagedNodeGroupTaskMutex.Lock()
-	ret, specificReturn := fake.newManagedNodeGroupTaskReturnsOnCall[len(fake.newManagedNodeGroupTaskArgsForCall)]
-	fake.newManagedNodeGroupTaskArgsForCall = append(fake.newManagedNodeGroupTaskArgsForCall, struct {
+}
+
+func (fake *FakeStackManager) EnableDefaultTemplateBucketCallCount() int {
+	fake.enableDefaultTemplateBucketMutex.RLock()
+	defer fake.enableDefaultTemplateBucketMutex.RUnlock()
+	return len(fake.enableDefaultTemplateBucketArgsForCall)
+}
+
+func (fake *FakeStackManager) EnableDefaultTemplateBucketCalls(stub func()) {
+	fake.enableDefaultTemplateBucketMutex.Lock()
+	defer fake.enableDefaultTemplateBucketMutex.Unlock()
+	fake.EnableDefaultTemplateBucketStub = stub
+}
+
+func (fake *FakeStackManager) EnsureMapPublicIPOnLaunchEnabled(arg1 context.Context) error {
+	fake.ensureMapPublicIPOnLaunchEnabledMutex.Lock()
+	ret, specificReturn := fake.ensureMapPublicIPOnLaunchEnabledReturnsOnCall[len(fake.ensureMapPublicIPOnLaunchEnabledArgsForCall)]
+	fake.ensureMapPublicIPOnLaunchEnabledArgsForCall = append(fake.ensureMapPublicIPOnLaunchEnabledArgsForCall, struct {
 		arg1 context.Context
-		arg2 []*v1alpha5.ManagedNodeGroup
-		arg3 bool
-		arg4 vpc.Importer
-	}{arg1, arg2Copy, arg3, arg4})
-	stub := fake.NewManagedNodeGroupTaskStub
-	fakeReturns := fake.newManagedNodeGroupTaskReturns
-	fake.recordInvocation("NewManagedNodeGroupTask", []interface{}{arg1, arg2Copy, arg3, arg4})
-	fake.newManagedNodeGroupTaskMutex.Unlock()
+	}{arg1})
+	stub := fake.EnsureMapPublicIPOnLaunchEnabledStub
+	fakeReturns := fake.ensureMapPublicIPOnLaunchEnabledReturns
+	fake.recordInvocation("EnsureMapPublicIPOnLaunchEnabled", []interface{}{arg1})
+	fake.ensureMapPublicIPOnLaunchEnabledMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
@@ -3498,343 +4923,321 @@ func (fake *FakeStackManager) NewManagedNodeGroupTask(arg1 context.Context, arg2
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) NewManagedNodeGroupTaskCallCount() int {
-	fake.newManagedNodeGroupTaskMutex.RLock()
-	defer fake.newManagedNodeGroupTaskMutex.RUnlock()
-	return len(fake.newManagedNodeGroupTaskArgsForCall)
+func (fake *FakeStackManager) EnsureMapPublicIPOnLaunchEnabledCallCount() int {
+	fake.ensureMapPublicIPOnLaunchEnabledMutex.RLock()
+	defer fake.ensureMapPublicIPOnLaunchEnabledMutex.RUnlock()
+	return len(fake.ensureMapPublicIPOnLaunchEnabledArgsForCall)
 }
 
-func (fake *FakeStackManager) NewManagedNodeGroupTaskCalls(stub func(context.Context, []*v1alpha5.ManagedNodeGroup, bool, vpc.Importer) *tasks.TaskTree) {
-	fake.newManagedNodeGroupTaskMutex.Lock()
-	defer fake.newManagedNodeGroupTaskMutex.Unlock()
-	fake.NewManagedNodeGroupTaskStub = stub
+func (fake *FakeStackManager) EnsureMapPublicIPOnLaunchEnabledCalls(stub func(context.Context) error) {
+	fake.ensureMapPublicIPOnLaunchEnabledMutex.Lock()
+	defer fake.ensureMapPublicIPOnLaunchEnabledMutex.Unlock()
+	fake.EnsureMapPublicIPOnLaunchEnabledStub = stub
 }
 
-func (fake *FakeStackManager) NewManagedNodeGroupTaskArgsForCall(i int) (context.Context, []*v1alpha5.ManagedNodeGroup, bool, vpc.Importer) {
-	fake.newManagedNodeGroupTaskMutex.RLock()
-	defer fake.newManagedNodeGroupTaskMutex.RUnlock()
-	argsForCall := fake.newManagedNodeGroupTaskArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeStackManager) EnsureMapPublicIPOnLaunchEnabledArgsForCall(i int) context.Context {
+	fake.ensureMapPublicIPOnLaunchEnabledMutex.RLock()
+	defer fake.ensureMapPublicIPOnLaunchEnabledMutex.RUnlock()
+	argsForCall := fake.ensureMapPublicIPOnLaunchEnabledArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) NewManagedNodeGroupTaskReturns(result1 *tasks.TaskTree) {
-	fake.newManagedNodeGroupTaskMutex.Lock()
-	defer fake.newManagedNodeGroupTaskMutex.Unlock()
-	fake.NewManagedNodeGroupTaskStub = nil
-	fake.newManagedNodeGroupTaskReturns = struct {
-		result1 *tasks.TaskTree
+func (fake *FakeStackManager) EnsureMapPublicIPOnLaunchEnabledReturns(result1 error) {
+	fake.ensureMapPublicIPOnLaunchEnabledMutex.Lock()
+	defer fake.ensureMapPublicIPOnLaunchEnabledMutex.Unlock()
+	fake.EnsureMapPublicIPOnLaunchEnabledStub = nil
+	fake.ensureMapPublicIPOnLaunchEnabledReturns = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewManagedNodeGroupTaskReturnsOnCall(i int, result1 *tasks.TaskTree) {
-	fake.newManagedNodeGroupTaskMutex.Lock()
-	defer fake.newManagedNodeGroupTaskMutex.Unlock()
-	fake.NewManagedNodeGroupTaskStub = nil
-	if fake.newManagedNodeGroupTaskReturnsOnCall == nil {
-		fake.newManagedNodeGroupTaskReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
+func (fake *FakeStackManager) EnsureMapPublicIPOnLaunchEnabledReturnsOnCall(i int, result1 error) {
+	fake.ensureMapPublicIPOnLaunchEnabledMutex.Lock()
+	defer fake.ensureMapPublicIPOnLaunchEnabledMutex.Unlock()
+	fake.EnsureMapPublicIPOnLaunchEnabledStub = nil
+	if fake.ensureMapPublicIPOnLaunchEnabledReturnsOnCall == nil {
+		fake.ensureMapPublicIPOnLaunchEnabledReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.newManagedNodeGroupTaskReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
+	fake.ensureMapPublicIPOnLaunchEnabledReturnsOnCall[i] = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteAddonIAM(arg1 context.Context, arg2 bool) (*tasks.TaskTree, error) {
-	fake.newTaskToDeleteAddonIAMMutex.Lock()
-	ret, specificReturn := fake.newTaskToDeleteAddonIAMReturnsOnCall[len(fake.newTaskToDeleteAddonIAMArgsForCall)]
-	fake.newTaskToDeleteAddonIAMArgsForCall = append(fake.newTaskToDeleteAddonIAMArgsForCall, struct {
+func (fake *FakeStackManager) ExportNodeGroupTemplate(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.exportNodeGroupTemplateMutex.Lock()
+	ret, specificReturn := fake.exportNodeGroupTemplateReturnsOnCall[len(fake.exportNodeGroupTemplateArgsForCall)]
+	fake.exportNodeGroupTemplateArgsForCall = append(fake.exportNodeGroupTemplateArgsForCall, struct {
 		arg1 context.Context
-		arg2 bool
-	}{arg1, arg2})
-	stub := fake.NewTaskToDeleteAddonIAMStub
-	fakeReturns := fake.newTaskToDeleteAddonIAMReturns
-	fake.recordInvocation("NewTaskToDeleteAddonIAM", []interface{}{arg1, arg2})
-	fake.newTaskToDeleteAddonIAMMutex.Unlock()
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ExportNodeGroupTemplateStub
+	fakeReturns := fake.exportNodeGroupTemplateReturns
+	fake.recordInvocation("ExportNodeGroupTemplate", []interface{}{arg1, arg2, arg3})
+	fake.exportNodeGroupTemplateMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteAddonIAMCallCount() int {
-	fake.newTaskToDeleteAddonIAMMutex.RLock()
-	defer fake.newTaskToDeleteAddonIAMMutex.RUnlock()
-	return len(fake.newTaskToDeleteAddonIAMArgsForCall)
+func (fake *FakeStackManager) ExportNodeGroupTemplateCallCount() int {
+	fake.exportNodeGroupTemplateMutex.RLock()
+	defer fake.exportNodeGroupTemplateMutex.RUnlock()
+	return len(fake.exportNodeGroupTemplateArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteAddonIAMCalls(stub func(context.Context, bool) (*tasks.TaskTree, error)) {
-	fake.newTaskToDeleteAddonIAMMutex.Lock()
-	defer fake.newTaskToDeleteAddonIAMMutex.Unlock()
-	fake.NewTaskToDeleteAddonIAMStub = stub
+func (fake *FakeStackManager) ExportNodeGroupTemplateCalls(stub func(context.Context, string, string) error) {
+	fake.exportNodeGroupTemplateMutex.Lock()
+	defer fake.exportNodeGroupTemplateMutex.Unlock()
+	fake.ExportNodeGroupTemplateStub = stub
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteAddonIAMArgsForCall(i int) (context.Context, bool) {
-	fake.newTaskToDeleteAddonIAMMutex.RLock()
-	defer fake.newTaskToDeleteAddonIAMMutex.RUnlock()
-	argsForCall := fake.newTaskToDeleteAddonIAMArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeStackManager) ExportNodeGroupTemplateArgsForCall(i int) (context.Context, string, string) {
+	fake.exportNodeGroupTemplateMutex.RLock()
+	defer fake.exportNodeGroupTemplateMutex.RUnlock()
+	argsForCall := fake.exportNodeGroupTemplateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteAddonIAMReturns(result1 *tasks.TaskTree, result2 error) {
-	fake.newTaskToDeleteAddonIAMMutex.Lock()
-	defer fake.newTaskToDeleteAddonIAMMutex.Unlock()
-	fake.NewTaskToDeleteAddonIAMStub = nil
-	fake.newTaskToDeleteAddonIAMReturns = struct {
-		result1 *tasks.TaskTree
-		result2 error
-	}{result1, result2}
+func (fake *FakeStackManager) ExportNodeGroupTemplateReturns(result1 error) {
+	fake.exportNodeGroupTemplateMutex.Lock()
+	defer fake.exportNodeGroupTemplateMutex.Unlock()
+	fake.ExportNodeGroupTemplateStub = nil
+	fake.exportNodeGroupTemplateReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteAddonIAMReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
-	fake.newTaskToDeleteAddonIAMMutex.Lock()
-	defer fake.newTaskToDeleteAddonIAMMutex.Unlock()
-	fake.NewTaskToDeleteAddonIAMStub = nil
-	if fake.newTaskToDeleteAddonIAMReturnsOnCall == nil {
-		fake.newTaskToDeleteAddonIAMReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
-			result2 error
+func (fake *FakeStackManager) ExportNodeGroupTemplateReturnsOnCall(i int, result1 error) {
+	fake.exportNodeGroupTemplateMutex.Lock()
+	defer fake.exportNodeGroupTemplateMutex.Unlock()
+	fake.ExportNodeGroupTemplateStub = nil
+	if fake.exportNodeGroupTemplateReturnsOnCall == nil {
+		fake.exportNodeGroupTemplateReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.newTaskToDeleteAddonIAMReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
-		result2 error
-	}{result1, result2}
+	fake.exportNodeGroupTemplateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroup(arg1 string, arg2 string, arg3 eksiface.EKSAPI, arg4 *manager.DeleteWaitCondition) tasks.Task {
-	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
-	ret, specificReturn := fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall[len(fake.newTaskToDeleteUnownedNodeGroupArgsForCall)]
-	fake.newTaskToDeleteUnownedNodeGroupArgsForCall = append(fake.newTaskToDeleteUnownedNodeGroupArgsForCall, struct {
-		arg1 string
-		arg2 string
-		arg3 eksiface.EKSAPI
-		arg4 *manager.DeleteWaitCondition
-	}{arg1, arg2, arg3, arg4})
-	stub := fake.NewTaskToDeleteUnownedNodeGroupStub
-	fakeReturns := fake.newTaskToDeleteUnownedNodeGroupReturns
-	fake.recordInvocation("NewTaskToDeleteUnownedNodeGroup", []interface{}{arg1, arg2, arg3, arg4})
-	fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
+func (fake *FakeStackManager) FindClusterStackByTag(arg1 context.Context) (*types.Stack, error) {
+	fake.findClusterStackByTagMutex.Lock()
+	ret, specificReturn := fake.findClusterStackByTagReturnsOnCall[len(fake.findClusterStackByTagArgsForCall)]
+	fake.findClusterStackByTagArgsForCall = append(fake.findClusterStackByTagArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.FindClusterStackByTagStub
+	fakeReturns := fake.findClusterStackByTagReturns
+	fake.recordInvocation("FindClusterStackByTag", []interface{}{arg1})
+	fake.findClusterStackByTagMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupCallCount() int {
-	fake.newTaskToDeleteUnownedNodeGroupMutex.RLock()
-	defer fake.newTaskToDeleteUnownedNodeGroupMutex.RUnlock()
-	return len(fake.newTaskToDeleteUnownedNodeGroupArgsForCall)
+func (fake *FakeStackManager) FindClusterStackByTagCallCount() int {
+	fake.findClusterStackByTagMutex.RLock()
+	defer fake.findClusterStackByTagMutex.RUnlock()
+	return len(fake.findClusterStackByTagArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupCalls(stub func(string, string, eksiface.EKSAPI, *manager.DeleteWaitCondition) tasks.Task) {
-	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
-	defer fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
-	fake.NewTaskToDeleteUnownedNodeGroupStub = stub
+func (fake *FakeStackManager) FindClusterStackByTagCalls(stub func(context.Context) (*types.Stack, error)) {
+	fake.findClusterStackByTagMutex.Lock()
+	defer fake.findClusterStackByTagMutex.Unlock()
+	fake.FindClusterStackByTagStub = stub
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupArgsForCall(i int) (string, string, eksiface.EKSAPI, *manager.DeleteWaitCondition) {
-	fake.newTaskToDeleteUnownedNodeGroupMutex.RLock()
-	defer fake.newTaskToDeleteUnownedNodeGroupMutex.RUnlock()
-	argsForCall := fake.newTaskToDeleteUnownedNodeGroupArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeStackManager) FindClusterStackByTagArgsForCall(i int) context.Context {
+	fake.findClusterStackByTagMutex.RLock()
+	defer fake.findClusterStackByTagMutex.RUnlock()
+	argsForCall := fake.findClusterStackByTagArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupReturns(result1 tasks.Task) {
-	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
-	defer fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
-	fake.NewTaskToDeleteUnownedNodeGroupStub = nil
-	fake.newTaskToDeleteUnownedNodeGroupReturns = struct {
-		result1 tasks.Task
-	}{result1}
+func (fake *FakeStackManager) FindClusterStackByTagReturns(result1 *types.Stack, result2 error) {
+	fake.findClusterStackByTagMutex.Lock()
+	defer fake.findClusterStackByTagMutex.Unlock()
+	fake.FindClusterStackByTagStub = nil
+	fake.findClusterStackByTagReturns = struct {
+		result1 *types.Stack
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupReturnsOnCall(i int, result1 tasks.Task) {
-	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
-	defer fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
-	fake.NewTaskToDeleteUnownedNodeGroupStub = nil
-	if fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall == nil {
-		fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall = make(map[int]struct {
-			result1 tasks.Task
+func (fake *FakeStackManager) FindClusterStackByTagReturnsOnCall(i int, result1 *types.Stack, result2 error) {
+	fake.findClusterStackByTagMutex.Lock()
+	defer fake.findClusterStackByTagMutex.Unlock()
+	fake.FindClusterStackByTagStub = nil
+	if fake.findClusterStackByTagReturnsOnCall == nil {
+		fake.findClusterStackByTagReturnsOnCall = make(map[int]struct {
+			result1 *types.Stack
+			result2 error
 		})
 	}
-	fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall[i] = struct {
-		result1 tasks.Task
-	}{result1}
+	fake.findClusterStackByTagReturnsOnCall[i] = struct {
+		result1 *types.Stack
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroups(arg1 context.Context, arg2 []*v1alpha5.NodeGroup, arg3 []*v1alpha5.ManagedNodeGroup, arg4 ...tasks.Task) *tasks.TaskTree {
-	var arg2Copy []*v1alpha5.NodeGroup
-	if arg2 != nil {
-		arg2Copy = make([]*v1alpha5.NodeGroup, len(arg2))
-		copy(arg2Copy, arg2)
-	}
-	var arg3Copy []*v1alpha5.ManagedNodeGroup
-	if arg3 != nil {
-		arg3Copy = make([]*v1alpha5.ManagedNodeGroup, len(arg3))
-		copy(arg3Copy, arg3)
-	}
-	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
-	ret, specificReturn := fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall[len(fake.newTasksToCreateClusterWithNodeGroupsArgsForCall)]
-	fake.newTasksToCreateClusterWithNodeGroupsArgsForCall = append(fake.newTasksToCreateClusterWithNodeGroupsArgsForCall, struct {
+func (fake *FakeStackManager) FindNodeGroupsMissingAutoscalerTags(arg1 context.Context) ([]string, error) {
+	fake.findNodeGroupsMissingAutoscalerTagsMutex.Lock()
+	ret, specificReturn := fake.findNodeGroupsMissingAutoscalerTagsReturnsOnCall[len(fake.findNodeGroupsMissingAutoscalerTagsArgsForCall)]
+	fake.findNodeGroupsMissingAutoscalerTagsArgsForCall = append(fake.findNodeGroupsMissingAutoscalerTagsArgsForCall, struct {
 		arg1 context.Context
-		arg2 []*v1alpha5.NodeGroup
-		arg3 []*v1alpha5.ManagedNodeGroup
-		arg4 []tasks.Task
-	}{arg1, arg2Copy, arg3Copy, arg4})
-	stub := fake.NewTasksToCreateClusterWithNodeGroupsStub
-	fakeReturns := fake.newTasksToCreateClusterWithNodeGroupsReturns
-	fake.recordInvocation("NewTasksToCreateClusterWithNodeGroups", []interface{}{arg1, arg2Copy, arg3Copy, arg4})
-	fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
+	}{arg1})
+	stub := fake.FindNodeGroupsMissingAutoscalerTagsStub
+	fakeReturns := fake.findNodeGroupsMissingAutoscalerTagsReturns
+	fake.recordInvocation("FindNodeGroupsMissingAutoscalerTags", []interface{}{arg1})
+	fake.findNodeGroupsMissingAutoscalerTagsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4...)
+		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsCallCount() int {
-	fake.newTasksToCreateClusterWithNodeGroupsMutex.RLock()
-	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.RUnlock()
-	return len(fake.newTasksToCreateClusterWithNodeGroupsArgsForCall)
+func (fake *FakeStackManager) FindNodeGroupsMissingAutoscalerTagsCallCount() int {
+	fake.findNodeGroupsMissingAutoscalerTagsMutex.RLock()
+	defer fake.findNodeGroupsMissingAutoscalerTagsMutex.RUnlock()
+	return len(fake.findNodeGroupsMissingAutoscalerTagsArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsCalls(stub func(context.Context, []*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, ...tasks.Task) *tasks.TaskTree) {
-	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
-	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
-	fake.NewTasksToCreateClusterWithNodeGroupsStub = stub
+func (fake *FakeStackManager) FindNodeGroupsMissingAutoscalerTagsCalls(stub func(context.Context) ([]string, error)) {
+	fake.findNodeGroupsMissingAutoscalerTagsMutex.Lock()
+	defer fake.findNodeGroupsMissingAutoscalerTagsMutex.Unlock()
+	fake.FindNodeGroupsMissingAutoscalerTagsStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsArgsForCall(i int) (context.Context, []*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, []tasks.Task) {
-	fake.newTasksToCreateClusterWithNodeGroupsMutex.RLock()
-	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.RUnlock()
-	argsForCall := fake.newTasksToCreateClusterWithNodeGroupsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeStackManager) FindNodeGroupsMissingAutoscalerTagsArgsForCall(i int) context.Context {
+	fake.findNodeGroupsMissingAutoscalerTagsMutex.RLock()
+	defer fake.findNodeGroupsMissingAutoscalerTagsMutex.RUnlock()
+	argsForCall := fake.findNodeGroupsMissingAutoscalerTagsArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsReturns(result1 *tasks.TaskTree) {
-	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
-	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
-	fake.NewTasksToCreateClusterWithNodeGroupsStub = nil
-	fake.newTasksToCreateClusterWithNodeGroupsReturns = struct {
-		result1 *tasks.TaskTree
-	}{result1}
+func (fake *FakeStackManager) FindNodeGroupsMissingAutoscalerTagsReturns(result1 []string, result2 error) {
+	fake.findNodeGroupsMissingAutoscalerTagsMutex.Lock()
+	defer fake.findNodeGroupsMissingAutoscalerTagsMutex.Unlock()
+	fake.FindNodeGroupsMissingAutoscalerTagsStub = nil
+	fake.findNodeGroupsMissingAutoscalerTagsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsReturnsOnCall(i int, result1 *tasks.TaskTree) {
-	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
-	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
-	fake.NewTasksToCreateClusterWithNodeGroupsStub = nil
-	if fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall == nil {
-		fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
+func (fake *FakeStackManager) FindNodeGroupsMissingAutoscalerTagsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.findNodeGroupsMissingAutoscalerTagsMutex.Lock()
+	defer fake.findNodeGroupsMissingAutoscalerTagsMutex.Unlock()
+	fake.FindNodeGroupsMissingAutoscalerTagsStub = nil
+	if fake.findNodeGroupsMissingAutoscalerTagsReturnsOnCall == nil {
+		fake.findNodeGroupsMissingAutoscalerTagsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
 		})
 	}
-	fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
-	}{result1}
+	fake.findNodeGroupsMissingAutoscalerTagsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccounts(arg1 []*v1alpha5.ClusterIAMServiceAccount, arg2 *iamoidc.OpenIDConnectManager, arg3 kubernetes.ClientSetGetter) *tasks.TaskTree {
-	var arg1Copy []*v1alpha5.ClusterIAMServiceAccount
-	if arg1 != nil {
-		arg1Copy = make([]*v1alpha5.ClusterIAMServiceAccount, len(arg1))
-		copy(arg1Copy, arg1)
+func (fake *FakeStackManager) FindNodeGroupsUsingInstanceTypes(arg1 context.Context, arg2 []string) ([]string, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
 	}
-	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
-	ret, specificReturn := fake.newTasksToCreateIAMServiceAccountsReturnsOnCall[len(fake.newTasksToCreateIAMServiceAccountsArgsForCall)]
-	fake.newTasksToCreateIAMServiceAccountsArgsForCall = append(fake.newTasksToCreateIAMServiceAccountsArgsForCall, struct {
-		arg1 []*v1alpha5.ClusterIAMServiceAccount
-		arg2 *iamoidc.OpenIDConnectManager
-		arg3 kubernetes.ClientSetGetter
-	}{arg1Copy, arg2, arg3})
-	stub := fake.NewTasksToCreateIAMServiceAccountsStub
-	fakeReturns := fake.newTasksToCreateIAMServiceAccountsReturns
-	fake.recordInvocation("NewTasksToCreateIAMServiceAccounts", []interface{}{arg1Copy, arg2, arg3})
-	fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
+	fake.findNodeGroupsUsingInstanceTypesMutex.Lock()
+	ret, specificReturn := fake.findNodeGroupsUsingInstanceTypesReturnsOnCall[len(fake.findNodeGroupsUsingInstanceTypesArgsForCall)]
+	fake.findNodeGroupsUsingInstanceTypesArgsForCall = append(fake.findNodeGroupsUsingInstanceTypesArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.FindNodeGroupsUsingInstanceTypesStub
+	fakeReturns := fake.findNodeGroupsUsingInstanceTypesReturns
+	fake.recordInvocation("FindNodeGroupsUsingInstanceTypes", []interface{}{arg1, arg2Copy})
+	fake.findNodeGroupsUsingInstanceTypesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsCallCount() int {
-	fake.newTasksToCreateIAMServiceAccountsMutex.RLock()
-	defer fake.newTasksToCreateIAMServiceAccountsMutex.RUnlock()
-	return len(fake.newTasksToCreateIAMServiceAccountsArgsForCall)
+func (fake *FakeStackManager) FindNodeGroupsUsingInstanceTypesCallCount() int {
+	fake.findNodeGroupsUsingInstanceTypesMutex.RLock()
+	defer fake.findNodeGroupsUsingInstanceTypesMutex.RUnlock()
+	return len(fake.findNodeGroupsUsingInstanceTypesArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsCalls(stub func([]*v1alpha5.ClusterIAMServiceAccount, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) *tasks.TaskTree) {
-	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToCreateIAMServiceAccountsStub = stub
+func (fake *FakeStackManager) FindNodeGroupsUsingInstanceTypesCalls(stub func(context.Context, []string) ([]string, error)) {
+	fake.findNodeGroupsUsingInstanceTypesMutex.Lock()
+	defer fake.findNodeGroupsUsingInstanceTypesMutex.Unlock()
+	fake.FindNodeGroupsUsingInstanceTypesStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsArgsForCall(i int) ([]*v1alpha5.ClusterIAMServiceAccount, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) {
-	fake.newTasksToCreateIAMServiceAccountsMutex.RLock()
-	defer fake.newTasksToCreateIAMServiceAccountsMutex.RUnlock()
-	argsForCall := fake.newTasksToCreateIAMServiceAccountsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeStackManager) FindNodeGroupsUsingInstanceTypesArgsForCall(i int) (context.Context, []string) {
+	fake.findNodeGroupsUsingInstanceTypesMutex.RLock()
+	defer fake.findNodeGroupsUsingInstanceTypesMutex.RUnlock()
+	argsForCall := fake.findNodeGroupsUsingInstanceTypesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsReturns(result1 *tasks.TaskTree) {
-	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToCreateIAMServiceAccountsStub = nil
-	fake.newTasksToCreateIAMServiceAccountsReturns = struct {
-		result1 *tasks.TaskTree
-	}{result1}
+func (fake *FakeStackManager) FindNodeGroupsUsingInstanceTypesReturns(result1 []string, result2 error) {
+	fake.findNodeGroupsUsingInstanceTypesMutex.Lock()
+	defer fake.findNodeGroupsUsingInstanceTypesMutex.Unlock()
+	fake.FindNodeGroupsUsingInstanceTypesStub = nil
+	fake.findNodeGroupsUsingInstanceTypesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsReturnsOnCall(i int, result1 *tasks.TaskTree) {
-	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToCreateIAMServiceAccountsStub = nil
-	if fake.newTasksToCreateIAMServiceAccountsReturnsOnCall == nil {
-		fake.newTasksToCreateIAMServiceAccountsReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
+func (fake *FakeStackManager) FindNodeGroupsUsingInstanceTypesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.findNodeGroupsUsingInstanceTypesMutex.Lock()
+	defer fake.findNodeGroupsUsingInstanceTypesMutex.Unlock()
+	fake.FindNodeGroupsUsingInstanceTypesStub = nil
+	if fake.findNodeGroupsUsingInstanceTypesReturnsOnCall == nil {
+		fake.findNodeGroupsUsingInstanceTypesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
 		})
 	}
-	fake.newTasksToCreateIAMServiceAccountsReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
-	}{result1}
+	fake.findNodeGroupsUsingInstanceTypesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroups(arg1 context.Context, arg2 *types.Stack, arg3 []manager.NodeGroupStack, arg4 bool, arg5 *iamoidc.OpenIDConnectManager, arg6 kubernetes.ClientSetGetter, arg7 bool, arg8 func(chan error, string) error) (*tasks.TaskTree, error) {
-	var arg3Copy []manager.NodeGroupStack
-	if arg3 != nil {
-		arg3Copy = make([]manager.NodeGroupStack, len(arg3))
-		copy(arg3Copy, arg3)
-	}
-	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
-	ret, specificReturn := fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall[len(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall)]
-	fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall = append(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall, struct {
+func (fake *FakeStackManager) FindNodeGroupsWithStaleLaunchTemplate(arg1 context.Context) ([]string, error) {
+	fake.findNodeGroupsWithStaleLaunchTemplateMutex.Lock()
+	ret, specificReturn := fake.findNodeGroupsWithStaleLaunchTemplateReturnsOnCall[len(fake.findNodeGroupsWithStaleLaunchTemplateArgsForCall)]
+	fake.findNodeGroupsWithStaleLaunchTemplateArgsForCall = append(fake.findNodeGroupsWithStaleLaunchTemplateArgsForCall, struct {
 		arg1 context.Context
-		arg2 *types.Stack
-		arg3 []manager.NodeGroupStack
-		arg4 bool
-		arg5 *iamoidc.OpenIDConnectManager
-		arg6 kubernetes.ClientSetGetter
-		arg7 bool
-		arg8 func(chan error, string) error
-	}{arg1, arg2, arg3Copy, arg4, arg5, arg6, arg7, arg8})
-	stub := fake.NewTasksToDeleteClusterWithNodeGroupsStub
-	fakeReturns := fake.newTasksToDeleteClusterWithNodeGroupsReturns
-	fake.recordInvocation("NewTasksToDeleteClusterWithNodeGroups", []interface{}{arg1, arg2, arg3Copy, arg4, arg5, arg6, arg7, arg8})
-	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
+	}{arg1})
+	stub := fake.FindNodeGroupsWithStaleLaunchTemplateStub
+	fakeReturns := fake.findNodeGroupsWithStaleLaunchTemplateReturns
+	fake.recordInvocation("FindNodeGroupsWithStaleLaunchTemplate", []interface{}{arg1})
+	fake.findNodeGroupsWithStaleLaunchTemplateMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -3842,71 +5245,64 @@ func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroups(arg1 context
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsCallCount() int {
-	fake.newTasksToDeleteClusterWithNodeGroupsMutex.RLock()
-	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.RUnlock()
-	return len(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall)
+func (fake *FakeStackManager) FindNodeGroupsWithStaleLaunchTemplateCallCount() int {
+	fake.findNodeGroupsWithStaleLaunchTemplateMutex.RLock()
+	defer fake.findNodeGroupsWithStaleLaunchTemplateMutex.RUnlock()
+	return len(fake.findNodeGroupsWithStaleLaunchTemplateArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsCalls(stub func(context.Context, *types.Stack, []manager.NodeGroupStack, bool, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter, bool, func(chan error, string) error) (*tasks.TaskTree, error)) {
-	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
-	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
-	fake.NewTasksToDeleteClusterWithNodeGroupsStub = stub
+func (fake *FakeStackManager) FindNodeGroupsWithStaleLaunchTemplateCalls(stub func(context.Context) ([]string, error)) {
+	fake.findNodeGroupsWithStaleLaunchTemplateMutex.Lock()
+	defer fake.findNodeGroupsWithStaleLaunchTemplateMutex.Unlock()
+	fake.FindNodeGroupsWithStaleLaunchTemplateStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsArgsForCall(i int) (context.Context, *types.Stack, []manager.NodeGroupStack, bool, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter, bool, func(chan error, string) error) {
-	fake.newTasksToDeleteClusterWithNodeGroupsMutex.RLock()
-	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.RUnlock()
-	argsForCall := fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8
+func (fake *FakeStackManager) FindNodeGroupsWithStaleLaunchTemplateArgsForCall(i int) context.Context {
+	fake.findNodeGroupsWithStaleLaunchTemplateMutex.RLock()
+	defer fake.findNodeGroupsWithStaleLaunchTemplateMutex.RUnlock()
+	argsForCall := fake.findNodeGroupsWithStaleLaunchTemplateArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsReturns(result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
-	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
-	fake.NewTasksToDeleteClusterWithNodeGroupsStub = nil
-	fake.newTasksToDeleteClusterWithNodeGroupsReturns = struct {
-		result1 *tasks.TaskTree
+func (fake *FakeStackManager) FindNodeGroupsWithStaleLaunchTemplateReturns(result1 []string, result2 error) {
+	fake.findNodeGroupsWithStaleLaunchTemplateMutex.Lock()
+	defer fake.findNodeGroupsWithStaleLaunchTemplateMutex.Unlock()
+	fake.FindNodeGroupsWithStaleLaunchTemplateStub = nil
+	fake.findNodeGroupsWithStaleLaunchTemplateReturns = struct {
+		result1 []string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
-	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
-	fake.NewTasksToDeleteClusterWithNodeGroupsStub = nil
-	if fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall == nil {
-		fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
+func (fake *FakeStackManager) FindNodeGroupsWithStaleLaunchTemplateReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.findNodeGroupsWithStaleLaunchTemplateMutex.Lock()
+	defer fake.findNodeGroupsWithStaleLaunchTemplateMutex.Unlock()
+	fake.FindNodeGroupsWithStaleLaunchTemplateStub = nil
+	if fake.findNodeGroupsWithStaleLaunchTemplateReturnsOnCall == nil {
+		fake.findNodeGroupsWithStaleLaunchTemplateReturnsOnCall = make(map[int]struct {
+			result1 []string
 			result2 error
 		})
 	}
-	fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
+	fake.findNodeGroupsWithStaleLaunchTemplateReturnsOnCall[i] = struct {
+		result1 []string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccounts(arg1 context.Context, arg2 []string, arg3 kubernetes.ClientSetGetter, arg4 bool) (*tasks.TaskTree, error) {
-	var arg2Copy []string
-	if arg2 != nil {
-		arg2Copy = make([]string, len(arg2))
-		copy(arg2Copy, arg2)
-	}
-	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
-	ret, specificReturn := fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall[len(fake.newTasksToDeleteIAMServiceAccountsArgsForCall)]
-	fake.newTasksToDeleteIAMServiceAccountsArgsForCall = append(fake.newTasksToDeleteIAMServiceAccountsArgsForCall, struct {
+func (fake *FakeStackManager) FindOrphanedNodeGroupENIs(arg1 context.Context, arg2 string) ([]string, error) {
+	fake.findOrphanedNodeGroupENIsMutex.Lock()
+	ret, specificReturn := fake.findOrphanedNodeGroupENIsReturnsOnCall[len(fake.findOrphanedNodeGroupENIsArgsForCall)]
+	fake.findOrphanedNodeGroupENIsArgsForCall = append(fake.findOrphanedNodeGroupENIsArgsForCall, struct {
 		arg1 context.Context
-		arg2 []string
-		arg3 kubernetes.ClientSetGetter
-		arg4 bool
-	}{arg1, arg2Copy, arg3, arg4})
-	stub := fake.NewTasksToDeleteIAMServiceAccountsStub
-	fakeReturns := fake.newTasksToDeleteIAMServiceAccountsReturns
-	fake.recordInvocation("NewTasksToDeleteIAMServiceAccounts", []interface{}{arg1, arg2Copy, arg3, arg4})
-	fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.FindOrphanedNodeGroupENIsStub
+	fakeReturns := fake.findOrphanedNodeGroupENIsReturns
+	fake.recordInvocation("FindOrphanedNodeGroupENIs", []interface{}{arg1, arg2})
+	fake.findOrphanedNodeGroupENIsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -3914,209 +5310,4415 @@ func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccounts(arg1 context.Co
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsCallCount() int {
-	fake.newTasksToDeleteIAMServiceAccountsMutex.RLock()
-	defer fake.newTasksToDeleteIAMServiceAccountsMutex.RUnlock()
-	return len(fake.newTasksToDeleteIAMServiceAccountsArgsForCall)
+func (fake *FakeStackManager) FindOrphanedNodeGroupENIsCallCount() int {
+	fake.findOrphanedNodeGroupENIsMutex.RLock()
+	defer fake.findOrphanedNodeGroupENIsMutex.RUnlock()
+	return len(fake.findOrphanedNodeGroupENIsArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsCalls(stub func(context.Context, []string, kubernetes.ClientSetGetter, bool) (*tasks.TaskTree, error)) {
-	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToDeleteIAMServiceAccountsStub = stub
+func (fake *FakeStackManager) FindOrphanedNodeGroupENIsCalls(stub func(context.Context, string) ([]string, error)) {
+	fake.findOrphanedNodeGroupENIsMutex.Lock()
+	defer fake.findOrphanedNodeGroupENIsMutex.Unlock()
+	fake.FindOrphanedNodeGroupENIsStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsArgsForCall(i int) (context.Context, []string, kubernetes.ClientSetGetter, bool) {
-	fake.newTasksToDeleteIAMServiceAccountsMutex.RLock()
-	defer fake.newTasksToDeleteIAMServiceAccountsMutex.RUnlock()
-	argsForCall := fake.newTasksToDeleteIAMServiceAccountsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeStackManager) FindOrphanedNodeGroupENIsArgsForCall(i int) (context.Context, string) {
+	fake.findOrphanedNodeGroupENIsMutex.RLock()
+	defer fake.findOrphanedNodeGroupENIsMutex.RUnlock()
+	argsForCall := fake.findOrphanedNodeGroupENIsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsReturns(result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToDeleteIAMServiceAccountsStub = nil
-	fake.newTasksToDeleteIAMServiceAccountsReturns = struct {
-		result1 *tasks.TaskTree
+func (fake *FakeStackManager) FindOrphanedNodeGroupENIsReturns(result1 []string, result2 error) {
+	fake.findOrphanedNodeGroupENIsMutex.Lock()
+	defer fake.findOrphanedNodeGroupENIsMutex.Unlock()
+	fake.FindOrphanedNodeGroupENIsStub = nil
+	fake.findOrphanedNodeGroupENIsReturns = struct {
+		result1 []string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToDeleteIAMServiceAccountsStub = nil
-	if fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall == nil {
-		fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
+func (fake *FakeStackManager) FindOrphanedNodeGroupENIsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.findOrphanedNodeGroupENIsMutex.Lock()
+	defer fake.findOrphanedNodeGroupENIsMutex.Unlock()
+	fake.FindOrphanedNodeGroupENIsStub = nil
+	if fake.findOrphanedNodeGroupENIsReturnsOnCall == nil {
+		fake.findOrphanedNodeGroupENIsReturnsOnCall = make(map[int]struct {
+			result1 []string
 			result2 error
 		})
 	}
-	fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
+	fake.findOrphanedNodeGroupENIsReturnsOnCall[i] = struct {
+		result1 []string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteNodeGroups(arg1 []manager.NodeGroupStack, arg2 func(_ string) bool, arg3 bool, arg4 func(chan error, string) error) (*tasks.TaskTree, error) {
-	var arg1Copy []manager.NodeGroupStack
-	if arg1 != nil {
-		arg1Copy = make([]manager.NodeGroupStack, len(arg1))
-		copy(arg1Copy, arg1)
+func (fake *FakeStackManager) FixClusterCompatibility(arg1 context.Context) error {
+	fake.fixClusterCompatibilityMutex.Lock()
+	ret, specificReturn := fake.fixClusterCompatibilityReturnsOnCall[len(fake.fixClusterCompatibilityArgsForCall)]
+	fake.fixClusterCompatibilityArgsForCall = append(fake.fixClusterCompatibilityArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.FixClusterCompatibilityStub
+	fakeReturns := fake.fixClusterCompatibilityReturns
+	fake.recordInvocation("FixClusterCompatibility", []interface{}{arg1})
+	fake.fixClusterCompatibilityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
 	}
-	fake.newTasksToDeleteNodeGroupsMutex.Lock()
-	ret, specificReturn := fake.newTasksToDeleteNodeGroupsReturnsOnCall[len(fake.newTasksToDeleteNodeGroupsArgsForCall)]
-	fake.newTasksToDeleteNodeGroupsArgsForCall = append(fake.newTasksToDeleteNodeGroupsArgsForCall, struct {
-		arg1 []manager.NodeGroupStack
-		arg2 func(_ string) bool
-		arg3 bool
-		arg4 func(chan error, string) error
-	}{arg1Copy, arg2, arg3, arg4})
-	stub := fake.NewTasksToDeleteNodeGroupsStub
-	fakeReturns := fake.newTasksToDeleteNodeGroupsReturns
-	fake.recordInvocation("NewTasksToDeleteNodeGroups", []interface{}{arg1Copy, arg2, arg3, arg4})
-	fake.newTasksToDeleteNodeGroupsMutex.Unlock()
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) FixClusterCompatibilityCallCount() int {
+	fake.fixClusterCompatibilityMutex.RLock()
+	defer fake.fixClusterCompatibilityMutex.RUnlock()
+	return len(fake.fixClusterCompatibilityArgsForCall)
+}
+
+func (fake *FakeStackManager) FixClusterCompatibilityCalls(stub func(context.Context) error) {
+	fake.fixClusterCompatibilityMutex.Lock()
+	defer fake.fixClusterCompatibilityMutex.Unlock()
+	fake.FixClusterCompatibilityStub = stub
+}
+
+func (fake *FakeStackManager) FixClusterCompatibilityArgsForCall(i int) context.Context {
+	fake.fixClusterCompatibilityMutex.RLock()
+	defer fake.fixClusterCompatibilityMutex.RUnlock()
+	argsForCall := fake.fixClusterCompatibilityArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) FixClusterCompatibilityReturns(result1 error) {
+	fake.fixClusterCompatibilityMutex.Lock()
+	defer fake.fixClusterCompatibilityMutex.Unlock()
+	fake.FixClusterCompatibilityStub = nil
+	fake.fixClusterCompatibilityReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) FixClusterCompatibilityReturnsOnCall(i int, result1 error) {
+	fake.fixClusterCompatibilityMutex.Lock()
+	defer fake.fixClusterCompatibilityMutex.Unlock()
+	fake.FixClusterCompatibilityStub = nil
+	if fake.fixClusterCompatibilityReturnsOnCall == nil {
+		fake.fixClusterCompatibilityReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.fixClusterCompatibilityReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) ForceDeleteNodeGroupStack(arg1 context.Context, arg2 string, arg3 []string) error {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.forceDeleteNodeGroupStackMutex.Lock()
+	ret, specificReturn := fake.forceDeleteNodeGroupStackReturnsOnCall[len(fake.forceDeleteNodeGroupStackArgsForCall)]
+	fake.forceDeleteNodeGroupStackArgsForCall = append(fake.forceDeleteNodeGroupStackArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.ForceDeleteNodeGroupStackStub
+	fakeReturns := fake.forceDeleteNodeGroupStackReturns
+	fake.recordInvocation("ForceDeleteNodeGroupStack", []interface{}{arg1, arg2, arg3Copy})
+	fake.forceDeleteNodeGroupStackMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) ForceDeleteNodeGroupStackCallCount() int {
+	fake.forceDeleteNodeGroupStackMutex.RLock()
+	defer fake.forceDeleteNodeGroupStackMutex.RUnlock()
+	return len(fake.forceDeleteNodeGroupStackArgsForCall)
+}
+
+func (fake *FakeStackManager) ForceDeleteNodeGroupStackCalls(stub func(context.Context, string, []string) error) {
+	fake.forceDeleteNodeGroupStackMutex.Lock()
+	defer fake.forceDeleteNodeGroupStackMutex.Unlock()
+	fake.ForceDeleteNodeGroupStackStub = stub
+}
+
+func (fake *FakeStackManager) ForceDeleteNodeGroupStackArgsForCall(i int) (context.Context, string, []string) {
+	fake.forceDeleteNodeGroupStackMutex.RLock()
+	defer fake.forceDeleteNodeGroupStackMutex.RUnlock()
+	argsForCall := fake.forceDeleteNodeGroupStackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) ForceDeleteNodeGroupStackReturns(result1 error) {
+	fake.forceDeleteNodeGroupStackMutex.Lock()
+	defer fake.forceDeleteNodeGroupStackMutex.Unlock()
+	fake.ForceDeleteNodeGroupStackStub = nil
+	fake.forceDeleteNodeGroupStackReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) ForceDeleteNodeGroupStackReturnsOnCall(i int, result1 error) {
+	fake.forceDeleteNodeGroupStackMutex.Lock()
+	defer fake.forceDeleteNodeGroupStackMutex.Unlock()
+	fake.ForceDeleteNodeGroupStackStub = nil
+	if fake.forceDeleteNodeGroupStackReturnsOnCall == nil {
+		fake.forceDeleteNodeGroupStackReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.forceDeleteNodeGroupStackReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupCapacity(arg1 context.Context, arg2 string) (manager.ScalingConfig, error) {
+	fake.getAutoScalingGroupCapacityMutex.Lock()
+	ret, specificReturn := fake.getAutoScalingGroupCapacityReturnsOnCall[len(fake.getAutoScalingGroupCapacityArgsForCall)]
+	fake.getAutoScalingGroupCapacityArgsForCall = append(fake.getAutoScalingGroupCapacityArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAutoScalingGroupCapacityStub
+	fakeReturns := fake.getAutoScalingGroupCapacityReturns
+	fake.recordInvocation("GetAutoScalingGroupCapacity", []interface{}{arg1, arg2})
+	fake.getAutoScalingGroupCapacityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupCapacityCallCount() int {
+	fake.getAutoScalingGroupCapacityMutex.RLock()
+	defer fake.getAutoScalingGroupCapacityMutex.RUnlock()
+	return len(fake.getAutoScalingGroupCapacityArgsForCall)
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupCapacityCalls(stub func(context.Context, string) (manager.ScalingConfig, error)) {
+	fake.getAutoScalingGroupCapacityMutex.Lock()
+	defer fake.getAutoScalingGroupCapacityMutex.Unlock()
+	fake.GetAutoScalingGroupCapacityStub = stub
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupCapacityArgsForCall(i int) (context.Context, string) {
+	fake.getAutoScalingGroupCapacityMutex.RLock()
+	defer fake.getAutoScalingGroupCapacityMutex.RUnlock()
+	argsForCall := fake.getAutoScalingGroupCapacityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupCapacityReturns(result1 manager.ScalingConfig, result2 error) {
+	fake.getAutoScalingGroupCapacityMutex.Lock()
+	defer fake.getAutoScalingGroupCapacityMutex.Unlock()
+	fake.GetAutoScalingGroupCapacityStub = nil
+	fake.getAutoScalingGroupCapacityReturns = struct {
+		result1 manager.ScalingConfig
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupCapacityReturnsOnCall(i int, result1 manager.ScalingConfig, result2 error) {
+	fake.getAutoScalingGroupCapacityMutex.Lock()
+	defer fake.getAutoScalingGroupCapacityMutex.Unlock()
+	fake.GetAutoScalingGroupCapacityStub = nil
+	if fake.getAutoScalingGroupCapacityReturnsOnCall == nil {
+		fake.getAutoScalingGroupCapacityReturnsOnCall = make(map[int]struct {
+			result1 manager.ScalingConfig
+			result2 error
+		})
+	}
+	fake.getAutoScalingGroupCapacityReturnsOnCall[i] = struct {
+		result1 manager.ScalingConfig
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacity(arg1 context.Context, arg2 string) (typesa.AutoScalingGroup, error) {
+	fake.getAutoScalingGroupDesiredCapacityMutex.Lock()
+	ret, specificReturn := fake.getAutoScalingGroupDesiredCapacityReturnsOnCall[len(fake.getAutoScalingGroupDesiredCapacityArgsForCall)]
+	fake.getAutoScalingGroupDesiredCapacityArgsForCall = append(fake.getAutoScalingGroupDesiredCapacityArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAutoScalingGroupDesiredCapacityStub
+	fakeReturns := fake.getAutoScalingGroupDesiredCapacityReturns
+	fake.recordInvocation("GetAutoScalingGroupDesiredCapacity", []interface{}{arg1, arg2})
+	fake.getAutoScalingGroupDesiredCapacityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacityCallCount() int {
+	fake.getAutoScalingGroupDesiredCapacityMutex.RLock()
+	defer fake.getAutoScalingGroupDesiredCapacityMutex.RUnlock()
+	return len(fake.getAutoScalingGroupDesiredCapacityArgsForCall)
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacityCalls(stub func(context.Context, string) (typesa.AutoScalingGroup, error)) {
+	fake.getAutoScalingGroupDesiredCapacityMutex.Lock()
+	defer fake.getAutoScalingGroupDesiredCapacityMutex.Unlock()
+	fake.GetAutoScalingGroupDesiredCapacityStub = stub
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacityArgsForCall(i int) (context.Context, string) {
+	fake.getAutoScalingGroupDesiredCapacityMutex.RLock()
+	defer fake.getAutoScalingGroupDesiredCapacityMutex.RUnlock()
+	argsForCall := fake.getAutoScalingGroupDesiredCapacityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacityReturns(result1 typesa.AutoScalingGroup, result2 error) {
+	fake.getAutoScalingGroupDesiredCapacityMutex.Lock()
+	defer fake.getAutoScalingGroupDesiredCapacityMutex.Unlock()
+	fake.GetAutoScalingGroupDesiredCapacityStub = nil
+	fake.getAutoScalingGroupDesiredCapacityReturns = struct {
+		result1 typesa.AutoScalingGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacityReturnsOnCall(i int, result1 typesa.AutoScalingGroup, result2 error) {
+	fake.getAutoScalingGroupDesiredCapacityMutex.Lock()
+	defer fake.getAutoScalingGroupDesiredCapacityMutex.Unlock()
+	fake.GetAutoScalingGroupDesiredCapacityStub = nil
+	if fake.getAutoScalingGroupDesiredCapacityReturnsOnCall == nil {
+		fake.getAutoScalingGroupDesiredCapacityReturnsOnCall = make(map[int]struct {
+			result1 typesa.AutoScalingGroup
+			result2 error
+		})
+	}
+	fake.getAutoScalingGroupDesiredCapacityReturnsOnCall[i] = struct {
+		result1 typesa.AutoScalingGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupName(arg1 context.Context, arg2 *types.Stack) (string, error) {
+	fake.getAutoScalingGroupNameMutex.Lock()
+	ret, specificReturn := fake.getAutoScalingGroupNameReturnsOnCall[len(fake.getAutoScalingGroupNameArgsForCall)]
+	fake.getAutoScalingGroupNameArgsForCall = append(fake.getAutoScalingGroupNameArgsForCall, struct {
+		arg1 context.Context
+		arg2 *types.Stack
+	}{arg1, arg2})
+	stub := fake.GetAutoScalingGroupNameStub
+	fakeReturns := fake.getAutoScalingGroupNameReturns
+	fake.recordInvocation("GetAutoScalingGroupName", []interface{}{arg1, arg2})
+	fake.getAutoScalingGroupNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupNameCallCount() int {
+	fake.getAutoScalingGroupNameMutex.RLock()
+	defer fake.getAutoScalingGroupNameMutex.RUnlock()
+	return len(fake.getAutoScalingGroupNameArgsForCall)
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupNameCalls(stub func(context.Context, *types.Stack) (string, error)) {
+	fake.getAutoScalingGroupNameMutex.Lock()
+	defer fake.getAutoScalingGroupNameMutex.Unlock()
+	fake.GetAutoScalingGroupNameStub = stub
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupNameArgsForCall(i int) (context.Context, *types.Stack) {
+	fake.getAutoScalingGroupNameMutex.RLock()
+	defer fake.getAutoScalingGroupNameMutex.RUnlock()
+	argsForCall := fake.getAutoScalingGroupNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupNameReturns(result1 string, result2 error) {
+	fake.getAutoScalingGroupNameMutex.Lock()
+	defer fake.getAutoScalingGroupNameMutex.Unlock()
+	fake.GetAutoScalingGroupNameStub = nil
+	fake.getAutoScalingGroupNameReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetAutoScalingGroupNameReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getAutoScalingGroupNameMutex.Lock()
+	defer fake.getAutoScalingGroupNameMutex.Unlock()
+	fake.GetAutoScalingGroupNameStub = nil
+	if fake.getAutoScalingGroupNameReturnsOnCall == nil {
+		fake.getAutoScalingGroupNameReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getAutoScalingGroupNameReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetClusterStackIfExists(arg1 context.Context) (*types.Stack, error) {
+	fake.getClusterStackIfExistsMutex.Lock()
+	ret, specificReturn := fake.getClusterStackIfExistsReturnsOnCall[len(fake.getClusterStackIfExistsArgsForCall)]
+	fake.getClusterStackIfExistsArgsForCall = append(fake.getClusterStackIfExistsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetClusterStackIfExistsStub
+	fakeReturns := fake.getClusterStackIfExistsReturns
+	fake.recordInvocation("GetClusterStackIfExists", []interface{}{arg1})
+	fake.getClusterStackIfExistsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetClusterStackIfExistsCallCount() int {
+	fake.getClusterStackIfExistsMutex.RLock()
+	defer fake.getClusterStackIfExistsMutex.RUnlock()
+	return len(fake.getClusterStackIfExistsArgsForCall)
+}
+
+func (fake *FakeStackManager) GetClusterStackIfExistsCalls(stub func(context.Context) (*types.Stack, error)) {
+	fake.getClusterStackIfExistsMutex.Lock()
+	defer fake.getClusterStackIfExistsMutex.Unlock()
+	fake.GetClusterStackIfExistsStub = stub
+}
+
+func (fake *FakeStackManager) GetClusterStackIfExistsArgsForCall(i int) context.Context {
+	fake.getClusterStackIfExistsMutex.RLock()
+	defer fake.getClusterStackIfExistsMutex.RUnlock()
+	argsForCall := fake.getClusterStackIfExistsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GetClusterStackIfExistsReturns(result1 *types.Stack, result2 error) {
+	fake.getClusterStackIfExistsMutex.Lock()
+	defer fake.getClusterStackIfExistsMutex.Unlock()
+	fake.GetClusterStackIfExistsStub = nil
+	fake.getClusterStackIfExistsReturns = struct {
+		result1 *types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetClusterStackIfExistsReturnsOnCall(i int, result1 *types.Stack, result2 error) {
+	fake.getClusterStackIfExistsMutex.Lock()
+	defer fake.getClusterStackIfExistsMutex.Unlock()
+	fake.GetClusterStackIfExistsStub = nil
+	if fake.getClusterStackIfExistsReturnsOnCall == nil {
+		fake.getClusterStackIfExistsReturnsOnCall = make(map[int]struct {
+			result1 *types.Stack
+			result2 error
+		})
+	}
+	fake.getClusterStackIfExistsReturnsOnCall[i] = struct {
+		result1 *types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetClusterVPCConfig(arg1 context.Context) (*manager.ClusterVPCInfo, error) {
+	fake.getClusterVPCConfigMutex.Lock()
+	ret, specificReturn := fake.getClusterVPCConfigReturnsOnCall[len(fake.getClusterVPCConfigArgsForCall)]
+	fake.getClusterVPCConfigArgsForCall = append(fake.getClusterVPCConfigArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetClusterVPCConfigStub
+	fakeReturns := fake.getClusterVPCConfigReturns
+	fake.recordInvocation("GetClusterVPCConfig", []interface{}{arg1})
+	fake.getClusterVPCConfigMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetClusterVPCConfigCallCount() int {
+	fake.getClusterVPCConfigMutex.RLock()
+	defer fake.getClusterVPCConfigMutex.RUnlock()
+	return len(fake.getClusterVPCConfigArgsForCall)
+}
+
+func (fake *FakeStackManager) GetClusterVPCConfigCalls(stub func(context.Context) (*manager.ClusterVPCInfo, error)) {
+	fake.getClusterVPCConfigMutex.Lock()
+	defer fake.getClusterVPCConfigMutex.Unlock()
+	fake.GetClusterVPCConfigStub = stub
+}
+
+func (fake *FakeStackManager) GetClusterVPCConfigArgsForCall(i int) context.Context {
+	fake.getClusterVPCConfigMutex.RLock()
+	defer fake.getClusterVPCConfigMutex.RUnlock()
+	argsForCall := fake.getClusterVPCConfigArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GetClusterVPCConfigReturns(result1 *manager.ClusterVPCInfo, result2 error) {
+	fake.getClusterVPCConfigMutex.Lock()
+	defer fake.getClusterVPCConfigMutex.Unlock()
+	fake.GetClusterVPCConfigStub = nil
+	fake.getClusterVPCConfigReturns = struct {
+		result1 *manager.ClusterVPCInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetClusterVPCConfigReturnsOnCall(i int, result1 *manager.ClusterVPCInfo, result2 error) {
+	fake.getClusterVPCConfigMutex.Lock()
+	defer fake.getClusterVPCConfigMutex.Unlock()
+	fake.GetClusterVPCConfigStub = nil
+	if fake.getClusterVPCConfigReturnsOnCall == nil {
+		fake.getClusterVPCConfigReturnsOnCall = make(map[int]struct {
+			result1 *manager.ClusterVPCInfo
+			result2 error
+		})
+	}
+	fake.getClusterVPCConfigReturnsOnCall[i] = struct {
+		result1 *manager.ClusterVPCInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetFargateStack(arg1 context.Context) (*types.Stack, error) {
+	fake.getFargateStackMutex.Lock()
+	ret, specificReturn := fake.getFargateStackReturnsOnCall[len(fake.getFargateStackArgsForCall)]
+	fake.getFargateStackArgsForCall = append(fake.getFargateStackArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetFargateStackStub
+	fakeReturns := fake.getFargateStackReturns
+	fake.recordInvocation("GetFargateStack", []interface{}{arg1})
+	fake.getFargateStackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetFargateStackCallCount() int {
+	fake.getFargateStackMutex.RLock()
+	defer fake.getFargateStackMutex.RUnlock()
+	return len(fake.getFargateStackArgsForCall)
+}
+
+func (fake *FakeStackManager) GetFargateStackCalls(stub func(context.Context) (*types.Stack, error)) {
+	fake.getFargateStackMutex.Lock()
+	defer fake.getFargateStackMutex.Unlock()
+	fake.GetFargateStackStub = stub
+}
+
+func (fake *FakeStackManager) GetFargateStackArgsForCall(i int) context.Context {
+	fake.getFargateStackMutex.RLock()
+	defer fake.getFargateStackMutex.RUnlock()
+	argsForCall := fake.getFargateStackArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GetFargateStackReturns(result1 *types.Stack, result2 error) {
+	fake.getFargateStackMutex.Lock()
+	defer fake.getFargateStackMutex.Unlock()
+	fake.GetFargateStackStub = nil
+	fake.getFargateStackReturns = struct {
+		result1 *types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetFargateStackReturnsOnCall(i int, result1 *types.Stack, result2 error) {
+	fake.getFargateStackMutex.Lock()
+	defer fake.getFargateStackMutex.Unlock()
+	fake.GetFargateStackStub = nil
+	if fake.getFargateStackReturnsOnCall == nil {
+		fake.getFargateStackReturnsOnCall = make(map[int]struct {
+			result1 *types.Stack
+			result2 error
+		})
+	}
+	fake.getFargateStackReturnsOnCall[i] = struct {
+		result1 *types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetIAMAddonName(arg1 *types.Stack) string {
+	fake.getIAMAddonNameMutex.Lock()
+	ret, specificReturn := fake.getIAMAddonNameReturnsOnCall[len(fake.getIAMAddonNameArgsForCall)]
+	fake.getIAMAddonNameArgsForCall = append(fake.getIAMAddonNameArgsForCall, struct {
+		arg1 *types.Stack
+	}{arg1})
+	stub := fake.GetIAMAddonNameStub
+	fakeReturns := fake.getIAMAddonNameReturns
+	fake.recordInvocation("GetIAMAddonName", []interface{}{arg1})
+	fake.getIAMAddonNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) GetIAMAddonNameCallCount() int {
+	fake.getIAMAddonNameMutex.RLock()
+	defer fake.getIAMAddonNameMutex.RUnlock()
+	return len(fake.getIAMAddonNameArgsForCall)
+}
+
+func (fake *FakeStackManager) GetIAMAddonNameCalls(stub func(*types.Stack) string) {
+	fake.getIAMAddonNameMutex.Lock()
+	defer fake.getIAMAddonNameMutex.Unlock()
+	fake.GetIAMAddonNameStub = stub
+}
+
+func (fake *FakeStackManager) GetIAMAddonNameArgsForCall(i int) *types.Stack {
+	fake.getIAMAddonNameMutex.RLock()
+	defer fake.getIAMAddonNameMutex.RUnlock()
+	argsForCall := fake.getIAMAddonNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GetIAMAddonNameReturns(result1 string) {
+	fake.getIAMAddonNameMutex.Lock()
+	defer fake.getIAMAddonNameMutex.Unlock()
+	fake.GetIAMAddonNameStub = nil
+	fake.getIAMAddonNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeStackManager) GetIAMAddonNameReturnsOnCall(i int, result1 string) {
+	fake.getIAMAddonNameMutex.Lock()
+	defer fake.getIAMAddonNameMutex.Unlock()
+	fake.GetIAMAddonNameStub = nil
+	if fake.getIAMAddonNameReturnsOnCall == nil {
+		fake.getIAMAddonNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getIAMAddonNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeStackManager) GetIAMAddonsStacks(arg1 context.Context) ([]*types.Stack, error) {
+	fake.getIAMAddonsStacksMutex.Lock()
+	ret, specificReturn := fake.getIAMAddonsStacksReturnsOnCall[len(fake.getIAMAddonsStacksArgsForCall)]
+	fake.getIAMAddonsStacksArgsForCall = append(fake.getIAMAddonsStacksArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetIAMAddonsStacksStub
+	fakeReturns := fake.getIAMAddonsStacksReturns
+	fake.recordInvocation("GetIAMAddonsStacks", []interface{}{arg1})
+	fake.getIAMAddonsStacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetIAMAddonsStacksCallCount() int {
+	fake.getIAMAddonsStacksMutex.RLock()
+	defer fake.getIAMAddonsStacksMutex.RUnlock()
+	return len(fake.getIAMAddonsStacksArgsForCall)
+}
+
+func (fake *FakeStackManager) GetIAMAddonsStacksCalls(stub func(context.Context) ([]*types.Stack, error)) {
+	fake.getIAMAddonsStacksMutex.Lock()
+	defer fake.getIAMAddonsStacksMutex.Unlock()
+	fake.GetIAMAddonsStacksStub = stub
+}
+
+func (fake *FakeStackManager) GetIAMAddonsStacksArgsForCall(i int) context.Context {
+	fake.getIAMAddonsStacksMutex.RLock()
+	defer fake.getIAMAddonsStacksMutex.RUnlock()
+	argsForCall := fake.getIAMAddonsStacksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GetIAMAddonsStacksReturns(result1 []*types.Stack, result2 error) {
+	fake.getIAMAddonsStacksMutex.Lock()
+	defer fake.getIAMAddonsStacksMutex.Unlock()
+	fake.GetIAMAddonsStacksStub = nil
+	fake.getIAMAddonsStacksReturns = struct {
+		result1 []*types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetIAMAddonsStacksReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
+	fake.getIAMAddonsStacksMutex.Lock()
+	defer fake.getIAMAddonsStacksMutex.Unlock()
+	fake.GetIAMAddonsStacksStub = nil
+	if fake.getIAMAddonsStacksReturnsOnCall == nil {
+		fake.getIAMAddonsStacksReturnsOnCall = make(map[int]struct {
+			result1 []*types.Stack
+			result2 error
+		})
+	}
+	fake.getIAMAddonsStacksReturnsOnCall[i] = struct {
+		result1 []*types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetIAMServiceAccounts(arg1 context.Context) ([]*v1alpha5.ClusterIAMServiceAccount, error) {
+	fake.getIAMServiceAccountsMutex.Lock()
+	ret, specificReturn := fake.getIAMServiceAccountsReturnsOnCall[len(fake.getIAMServiceAccountsArgsForCall)]
+	fake.getIAMServiceAccountsArgsForCall = append(fake.getIAMServiceAccountsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetIAMServiceAccountsStub
+	fakeReturns := fake.getIAMServiceAccountsReturns
+	fake.recordInvocation("GetIAMServiceAccounts", []interface{}{arg1})
+	fake.getIAMServiceAccountsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetIAMServiceAccountsCallCount() int {
+	fake.getIAMServiceAccountsMutex.RLock()
+	defer fake.getIAMServiceAccountsMutex.RUnlock()
+	return len(fake.getIAMServiceAccountsArgsForCall)
+}
+
+func (fake *FakeStackManager) GetIAMServiceAccountsCalls(stub func(context.Context) ([]*v1alpha5.ClusterIAMServiceAccount, error)) {
+	fake.getIAMServiceAccountsMutex.Lock()
+	defer fake.getIAMServiceAccountsMutex.Unlock()
+	fake.GetIAMServiceAccountsStub = stub
+}
+
+func (fake *FakeStackManager) GetIAMServiceAccountsArgsForCall(i int) context.Context {
+	fake.getIAMServiceAccountsMutex.RLock()
+	defer fake.getIAMServiceAccountsMutex.RUnlock()
+	argsForCall := fake.getIAMServiceAccountsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GetIAMServiceAccountsReturns(result1 []*v1alpha5.ClusterIAMServiceAccount, result2 error) {
+	fake.getIAMServiceAccountsMutex.Lock()
+	defer fake.getIAMServiceAccountsMutex.Unlock()
+	fake.GetIAMServiceAccountsStub = nil
+	fake.getIAMServiceAccountsReturns = struct {
+		result1 []*v1alpha5.ClusterIAMServiceAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetIAMServiceAccountsReturnsOnCall(i int, result1 []*v1alpha5.ClusterIAMServiceAccount, result2 error) {
+	fake.getIAMServiceAccountsMutex.Lock()
+	defer fake.getIAMServiceAccountsMutex.Unlock()
+	fake.GetIAMServiceAccountsStub = nil
+	if fake.getIAMServiceAccountsReturnsOnCall == nil {
+		fake.getIAMServiceAccountsReturnsOnCall = make(map[int]struct {
+			result1 []*v1alpha5.ClusterIAMServiceAccount
+			result2 error
+		})
+	}
+	fake.getIAMServiceAccountsReturnsOnCall[i] = struct {
+		result1 []*v1alpha5.ClusterIAMServiceAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetKarpenterStack(arg1 context.Context) (*types.Stack, error) {
+	fake.getKarpenterStackMutex.Lock()
+	ret, specificReturn := fake.getKarpenterStackReturnsOnCall[len(fake.getKarpenterStackArgsForCall)]
+	fake.getKarpenterStackArgsForCall = append(fake.getKarpenterStackArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetKarpenterStackStub
+	fakeReturns := fake.getKarpenterStackReturns
+	fake.recordInvocation("GetKarpenterStack", []interface{}{arg1})
+	fake.getKarpenterStackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetKarpenterStackCallCount() int {
+	fake.getKarpenterStackMutex.RLock()
+	defer fake.getKarpenterStackMutex.RUnlock()
+	return len(fake.getKarpenterStackArgsForCall)
+}
+
+func (fake *FakeStackManager) GetKarpenterStackCalls(stub func(context.Context) (*types.Stack, error)) {
+	fake.getKarpenterStackMutex.Lock()
+	defer fake.getKarpenterStackMutex.Unlock()
+	fake.GetKarpenterStackStub = stub
+}
+
+func (fake *FakeStackManager) GetKarpenterStackArgsForCall(i int) context.Context {
+	fake.getKarpenterStackMutex.RLock()
+	defer fake.getKarpenterStackMutex.RUnlock()
+	argsForCall := fake.getKarpenterStackArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GetKarpenterStackReturns(result1 *types.Stack, result2 error) {
+	fake.getKarpenterStackMutex.Lock()
+	defer fake.getKarpenterStackMutex.Unlock()
+	fake.GetKarpenterStackStub = nil
+	fake.getKarpenterStackReturns = struct {
+		result1 *types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetKarpenterStackReturnsOnCall(i int, result1 *types.Stack, result2 error) {
+	fake.getKarpenterStackMutex.Lock()
+	defer fake.getKarpenterStackMutex.Unlock()
+	fake.GetKarpenterStackStub = nil
+	if fake.getKarpenterStackReturnsOnCall == nil {
+		fake.getKarpenterStackReturnsOnCall = make(map[int]struct {
+			result1 *types.Stack
+			result2 error
+		})
+	}
+	fake.getKarpenterStackReturnsOnCall[i] = struct {
+		result1 *types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupLaunchTemplate(arg1 context.Context, arg2 *types.Stack) (string, string, error) {
+	fake.getManagedNodeGroupLaunchTemplateMutex.Lock()
+	ret, specificReturn := fake.getManagedNodeGroupLaunchTemplateReturnsOnCall[len(fake.getManagedNodeGroupLaunchTemplateArgsForCall)]
+	fake.getManagedNodeGroupLaunchTemplateArgsForCall = append(fake.getManagedNodeGroupLaunchTemplateArgsForCall, struct {
+		arg1 context.Context
+		arg2 *types.Stack
+	}{arg1, arg2})
+	stub := fake.GetManagedNodeGroupLaunchTemplateStub
+	fakeReturns := fake.getManagedNodeGroupLaunchTemplateReturns
+	fake.recordInvocation("GetManagedNodeGroupLaunchTemplate", []interface{}{arg1, arg2})
+	fake.getManagedNodeGroupLaunchTemplateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupLaunchTemplateCallCount() int {
+	fake.getManagedNodeGroupLaunchTemplateMutex.RLock()
+	defer fake.getManagedNodeGroupLaunchTemplateMutex.RUnlock()
+	return len(fake.getManagedNodeGroupLaunchTemplateArgsForCall)
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupLaunchTemplateCalls(stub func(context.Context, *types.Stack) (string, string, error)) {
+	fake.getManagedNodeGroupLaunchTemplateMutex.Lock()
+	defer fake.getManagedNodeGroupLaunchTemplateMutex.Unlock()
+	fake.GetManagedNodeGroupLaunchTemplateStub = stub
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupLaunchTemplateArgsForCall(i int) (context.Context, *types.Stack) {
+	fake.getManagedNodeGroupLaunchTemplateMutex.RLock()
+	defer fake.getManagedNodeGroupLaunchTemplateMutex.RUnlock()
+	argsForCall := fake.getManagedNodeGroupLaunchTemplateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupLaunchTemplateReturns(result1 string, result2 string, result3 error) {
+	fake.getManagedNodeGroupLaunchTemplateMutex.Lock()
+	defer fake.getManagedNodeGroupLaunchTemplateMutex.Unlock()
+	fake.GetManagedNodeGroupLaunchTemplateStub = nil
+	fake.getManagedNodeGroupLaunchTemplateReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupLaunchTemplateReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.getManagedNodeGroupLaunchTemplateMutex.Lock()
+	defer fake.getManagedNodeGroupLaunchTemplateMutex.Unlock()
+	fake.GetManagedNodeGroupLaunchTemplateStub = nil
+	if fake.getManagedNodeGroupLaunchTemplateReturnsOnCall == nil {
+		fake.getManagedNodeGroupLaunchTemplateReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.getManagedNodeGroupLaunchTemplateReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupTemplate(arg1 context.Context, arg2 manager.GetNodegroupOption) (string, error) {
+	fake.getManagedNodeGroupTemplateMutex.Lock()
+	ret, specificReturn := fake.getManagedNodeGroupTemplateReturnsOnCall[len(fake.getManagedNodeGroupTemplateArgsForCall)]
+	fake.getManagedNodeGroupTemplateArgsForCall = append(fake.getManagedNodeGroupTemplateArgsForCall, struct {
+		arg1 context.Context
+		arg2 manager.GetNodegroupOption
+	}{arg1, arg2})
+	stub := fake.GetManagedNodeGroupTemplateStub
+	fakeReturns := fake.getManagedNodeGroupTemplateReturns
+	fake.recordInvocation("GetManagedNodeGroupTemplate", []interface{}{arg1, arg2})
+	fake.getManagedNodeGroupTemplateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupTemplateCallCount() int {
+	fake.getManagedNodeGroupTemplateMutex.RLock()
+	defer fake.getManagedNodeGroupTemplateMutex.RUnlock()
+	return len(fake.getManagedNodeGroupTemplateArgsForCall)
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupTemplateCalls(stub func(context.Context, manager.GetNodegroupOption) (string, error)) {
+	fake.getManagedNodeGroupTemplateMutex.Lock()
+	defer fake.getManagedNodeGroupTemplateMutex.Unlock()
+	fake.GetManagedNodeGroupTemplateStub = stub
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupTemplateArgsForCall(i int) (context.Context, manager.GetNodegroupOption) {
+	fake.getManagedNodeGroupTemplateMutex.RLock()
+	defer fake.getManagedNodeGroupTemplateMutex.RUnlock()
+	argsForCall := fake.getManagedNodeGroupTemplateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupTemplateReturns(result1 string, result2 error) {
+	fake.getManagedNodeGroupTemplateMutex.Lock()
+	defer fake.getManagedNodeGroupTemplateMutex.Unlock()
+	fake.GetManagedNodeGroupTemplateStub = nil
+	fake.getManagedNodeGroupTemplateReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupTemplateReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getManagedNodeGroupTemplateMutex.Lock()
+	defer fake.getManagedNodeGroupTemplateMutex.Unlock()
+	fake.GetManagedNodeGroupTemplateStub = nil
+	if fake.getManagedNodeGroupTemplateReturnsOnCall == nil {
+		fake.getManagedNodeGroupTemplateReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getManagedNodeGroupTemplateReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetNodeGroupAMI(arg1 context.Context, arg2 string) (string, error) {
+	fake.getNodeGroupAMIMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupAMIReturnsOnCall[len(fake.getNodeGroupAMIArgsForCall)]
+	fake.getNodeGroupAMIArgsForCall = append(fake.getNodeGroupAMIArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetNodeGroupAMIStub
+	fakeReturns := fake.getNodeGroupAMIReturns
+	fake.recordInvocation("GetNodeGroupAMI", []interface{}{arg1, arg2})
+	fake.getNodeGroupAMIMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetNodeGroupAMICallCount() int {
+	fake.getNodeGroupAMIMutex.RLock()
+	defer fake.getNodeGroupAMIMutex.RUnlock()
+	return len(fake.getNodeGroupAMIArgsForCall)
+}
+
+func (fake *FakeStackManager) GetNodeGroupAMICalls(stub func(context.Context, string) (string, error)) {
+	fake.getNodeGroupAMIMutex.Lock()
+	defer fake.getNodeGroupAMIMutex.Unlock()
+	fake.GetNodeGroupAMIStub = stub
+}
+
+func (fake *FakeStackManager) GetNodeGroupAMIArgsForCall(i int) (context.Context, string) {
+	fake.getNodeGroupAMIMutex.RLock()
+	defer fake.getNodeGroupAMIMutex.RUnlock()
+	argsForCall := fake.getNodeGroupAMIArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetNodeGroupAMIReturns(result1 string, result2 error) {
+	fake.getNodeGroupAMIMutex.Lock()
+	defer fake.getNodeGroupAMIMutex.Unlock()
+	fake.GetNodeGroupAMIStub = nil
+	fake.getNodeGroupAMIReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetNodeGroupAMIReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getNodeGroupAMIMutex.Lock()
+	defer fake.getNodeGroupAMIMutex.Unlock()
+	fake.GetNodeGroupAMIStub = nil
+	if fake.getNodeGroupAMIReturnsOnCall == nil {
+		fake.getNodeGroupAMIReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getNodeGroupAMIReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetNodeGroupInstanceRoleARN(arg1 context.Context, arg2 string) (string, error) {
+	fake.getNodeGroupInstanceRoleARNMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupInstanceRoleARNReturnsOnCall[len(fake.getNodeGroupInstanceRoleARNArgsForCall)]
+	fake.getNodeGroupInstanceRoleARNArgsForCall = append(fake.getNodeGroupInstanceRoleARNArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetNodeGroupInstanceRoleARNStub
+	fakeReturns := fake.getNodeGroupInstanceRoleARNReturns
+	fake.recordInvocation("GetNodeGroupInstanceRoleARN", []interface{}{arg1, arg2})
+	fake.getNodeGroupInstanceRoleARNMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetNodeGroupInstanceRoleARNCallCount() int {
+	fake.getNodeGroupInstanceRoleARNMutex.RLock()
+	defer fake.getNodeGroupInstanceRoleARNMutex.RUnlock()
+	return len(fake.getNodeGroupInstanceRoleARNArgsForCall)
+}
+
+func (fake *FakeStackManager) GetNodeGroupInstanceRoleARNCalls(stub func(context.Context, string) (string, error)) {
+	fake.getNodeGroupInstanceRoleARNMutex.Lock()
+	defer fake.getNodeGroupInstanceRoleARNMutex.Unlock()
+	fake.GetNodeGroupInstanceRoleARNStub = stub
+}
+
+func (fake *FakeStackManager) GetNodeGroupInstanceRoleARNArgsForCall(i int) (context.Context, string) {
+	fake.getNodeGroupInstanceRoleARNMutex.RLock()
+	defer fake.getNodeGroupInstanceRoleARNMutex.RUnlock()
+	argsForCall := fake.getNodeGroupInstanceRoleARNArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetNodeGroupInstanceRoleARNReturns(result1 string, result2 error) {
+	fake.getNodeGroupInstanceRoleARNMutex.Lock()
+	defer fake.getNodeGroupInstanceRoleARNMutex.Unlock()
+	fake.GetNodeGroupInstanceRoleARNStub = nil
+	fake.getNodeGroupInstanceRoleARNReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetNodeGroupInstanceRoleARNReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getNodeGroupInstanceRoleARNMutex.Lock()
+	defer fake.getNodeGroupInstanceRoleARNMutex.Unlock()
+	fake.GetNodeGroupInstanceRoleARNStub = nil
+	if fake.getNodeGroupInstanceRoleARNReturnsOnCall == nil {
+		fake.getNodeGroupInstanceRoleARNReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getNodeGroupInstanceRoleARNReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetNodeGroupKubernetesVersion(arg1 context.Context, arg2 string) (string, error) {
+	fake.getNodeGroupKubernetesVersionMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupKubernetesVersionReturnsOnCall[len(fake.getNodeGroupKubernetesVersionArgsForCall)]
+	fake.getNodeGroupKubernetesVersionArgsForCall = append(fake.getNodeGroupKubernetesVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetNodeGroupKubernetesVersionStub
+	fakeReturns := fake.getNodeGroupKubernetesVersionReturns
+	fake.recordInvocation("GetNodeGroupKubernetesVersion", []interface{}{arg1, arg2})
+	fake.getNodeGroupKubernetesVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetNodeGroupKubernetesVersionCallCount() int {
+	fake.getNodeGroupKubernetesVersionMutex.RLock()
+	defer fake.getNodeGroupKubernetesVersionMutex.RUnlock()
+	return len(fake.getNodeGroupKubernetesVersionArgsForCall)
+}
+
+func (fake *FakeStackManager) GetNodeGroupKubernetesVersionCalls(stub func(context.Context, string) (string, error)) {
+	fake.getNodeGroupKubernetesVersionMutex.Lock()
+	defer fake.getNodeGroupKubernetesVersionMutex.Unlock()
+	fake.GetNodeGroupKubernetesVersionStub = stub
+}
+
+func (fake *FakeStackManager) GetNodeGroupKubernetesVersionArgsForCall(i int) (context.Context, string) {
+	fake.getNodeGroupKubernetesVersionMutex.RLock()
+	defer fake.getNodeGroupKubernetesVersionMutex.RUnlock()
+	argsForCall := fake.getNodeGroupKubernetesVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetNodeGroupKubernetesVersionReturns(result1 string, result2 error) {
+	fake.getNodeGroupKubernetesVersionMutex.Lock()
+	defer fake.getNodeGroupKubernetesVersionMutex.Unlock()
+	fake.GetNodeGroupKubernetesVersionStub = nil
+	fake.getNodeGroupKubernetesVersionReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetNodeGroupKubernetesVersionReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getNodeGroupKubernetesVersionMutex.Lock()
+	defer fake.getNodeGroupKubernetesVersionMutex.Unlock()
+	fake.GetNodeGroupKubernetesVersionStub = nil
+	if fake.getNodeGroupKubernetesVersionReturnsOnCall == nil {
+		fake.getNodeGroupKubernetesVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getNodeGroupKubernetesVersionReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetNodeGroupName(arg1 *types.Stack) string {
+	fake.getNodeGroupNameMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupNameReturnsOnCall[len(fake.getNodeGroupNameArgsForCall)]
+	fake.getNodeGroupNameArgsForCall = append(fake.getNodeGroupNameArgsForCall, struct {
+		arg1 *types.Stack
+	}{arg1})
+	stub := fake.GetNodeGroupNameStub
+	fakeReturns := fake.getNodeGroupNameReturns
+	fake.recordInvocation("GetNodeGroupName", []interface{}{arg1})
+	fake.getNodeGroupNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) GetNodeGroupNameCallCount() int {
+	fake.getNodeGroupNameMutex.RLock()
+	defer fake.getNodeGroupNameMutex.RUnlock()
+	return len(fake.getNodeGroupNameArgsForCall)
+}
+
+func (fake *FakeStackManager) GetNodeGroupNameCalls(stub func(*types.Stack) string) {
+	fake.getNodeGroupNameMutex.Lock()
+	defer fake.getNodeGroupNameMutex.Unlock()
+	fake.GetNodeGroupNameStub = stub
+}
+
+func (fake *FakeStackManager) GetNodeGroupNameArgsForCall(i int) *types.Stack {
+	fake.getNodeGroupNameMutex.RLock()
+	defer fake.getNodeGroupNameMutex.RUnlock()
+	argsForCall := fake.getNodeGroupNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GetNodeGroupNameReturns(result1 string) {
+	fake.getNodeGroupNameMutex.Lock()
+	defer fake.getNodeGroupNameMutex.Unlock()
+	fake.GetNodeGroupNameStub = nil
+	fake.getNodeGroupNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeStackManager) GetNodeGroupNameReturnsOnCall(i int, result1 string) {
+	fake.getNodeGroupNameMutex.Lock()
+	defer fake.getNodeGroupNameMutex.Unlock()
+	fake.GetNodeGroupNameStub = nil
+	if fake.getNodeGroupNameReturnsOnCall == nil {
+		fake.getNodeGroupNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getNodeGroupNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeStackManager) GetNodeGroupSecurityGroupIDs(arg1 context.Context, arg2 string) ([]string, error) {
+	fake.getNodeGroupSecurityGroupIDsMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupSecurityGroupIDsReturnsOnCall[len(fake.getNodeGroupSecurityGroupIDsArgsForCall)]
+	fake.getNodeGroupSecurityGroupIDsArgsForCall = append(fake.getNodeGroupSecurityGroupIDsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetNodeGroupSecurityGroupIDsStub
+	fakeReturns := fake.getNodeGroupSecurityGroupIDsReturns
+	fake.recordInvocation("GetNodeGroupSecurityGroupIDs", []interface{}{arg1, arg2})
+	fake.getNodeGroupSecurityGroupIDsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetNodeGroupSecurityGroupIDsCallCount() int {
+	fake.getNodeGroupSecurityGroupIDsMutex.RLock()
+	defer fake.getNodeGroupSecurityGroupIDsMutex.RUnlock()
+	return len(fake.getNodeGroupSecurityGroupIDsArgsForCall)
+}
+
+func (fake *FakeStackManager) GetNodeGroupSecurityGroupIDsCalls(stub func(context.Context, string) ([]string, error)) {
+	fake.getNodeGroupSecurityGroupIDsMutex.Lock()
+	defer fake.getNodeGroupSecurityGroupIDsMutex.Unlock()
+	fake.GetNodeGroupSecurityGroupIDsStub = stub
+}
+
+func (fake *FakeStackManager) GetNodeGroupSecurityGroupIDsArgsForCall(i int) (context.Context, string) {
+	fake.getNodeGroupSecurityGroupIDsMutex.RLock()
+	defer fake.getNodeGroupSecurityGroupIDsMutex.RUnlock()
+	argsForCall := fake.getNodeGroupSecurityGroupIDsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetNodeGroupSecurityGroupIDsReturns(result1 []string, result2 error) {
+	fake.getNodeGroupSecurityGroupIDsMutex.Lock()
+	defer fake.getNodeGroupSecurityGroupIDsMutex.Unlock()
+	fake.GetNodeGroupSecurityGroupIDsStub = nil
+	fake.getNodeGroupSecurityGroupIDsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetNodeGroupSecurityGroupIDsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.getNodeGroupSecurityGroupIDsMutex.Lock()
+	defer fake.getNodeGroupSecurityGroupIDsMutex.Unlock()
+	fake.GetNodeGroupSecurityGroupIDsStub = nil
+	if fake.getNodeGroupSecurityGroupIDsReturnsOnCall == nil {
+		fake.getNodeGroupSecurityGroupIDsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.getNodeGroupSecurityGroupIDsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetNodeGroupStackTemplate(arg1 context.Context, arg2 string) (string, error) {
+	fake.getNodeGroupStackTemplateMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupStackTemplateReturnsOnCall[len(fake.getNodeGroupStackTemplateArgsForCall)]
+	fake.getNodeGroupStackTemplateArgsForCall = append(fake.getNodeGroupStackTemplateArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetNodeGroupStackTemplateStub
+	fakeReturns := fake.getNodeGroupStackTemplateReturns
+	fake.recordInvocation("GetNodeGroupStackTemplate", []interface{}{arg1, arg2})
+	fake.getNodeGroupStackTemplateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetNodeGroupStackTemplateCallCount() int {
+	fake.getNodeGroupStackTemplateMutex.RLock()
+	defer fake.getNodeGroupStackTemplateMutex.RUnlock()
+	return len(fake.getNodeGroupStackTemplateArgsForCall)
+}
+
+func (fake *FakeStackManager) GetNodeGroupStackTemplateCalls(stub func(context.Context, string) (string, error)) {
+	fake.getNodeGroupStackTemplateMutex.Lock()
+	defer fake.getNodeGroupStackTemplateMutex.Unlock()
+	fake.GetNodeGroupStackTemplateStub = stub
+}
+
+func (fake *FakeStackManager) GetNodeGroupStackTemplateArgsForCall(i int) (context.Context, string) {
+	fake.getNodeGroupStackTemplateMutex.RLock()
+	defer fake.getNodeGroupStackTemplateMutex.RUnlock()
+	argsForCall := fake.getNodeGroupStackTemplateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetNodeGroupStackTemplateReturns(result1 string, result2 error) {
+	fake.getNodeGroupStackTemplateMutex.Lock()
+	defer fake.getNodeGroupStackTemplateMutex.Unlock()
+	fake.GetNodeGroupStackTemplateStub = nil
+	fake.getNodeGroupStackTemplateReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetNodeGroupStackTemplateReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getNodeGroupStackTemplateMutex.Lock()
+	defer fake.getNodeGroupStackTemplateMutex.Unlock()
+	fake.GetNodeGroupStackTemplateStub = nil
+	if fake.getNodeGroupStackTemplateReturnsOnCall == nil {
+		fake.getNodeGroupStackTemplateReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getNodeGroupStackTemplateReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetNodeGroupStackType(arg1 context.Context, arg2 manager.GetNodegroupOption) (v1alpha5.NodeGroupType, error) {
+	fake.getNodeGroupStackTypeMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupStackTypeReturnsOnCall[len(fake.getNodeGroupStackTypeArgsForCall)]
+	fake.getNodeGroupStackTypeArgsForCall = append(fake.getNodeGroupStackTypeArgsForCall, struct {
+		arg1 context.Context
+		arg2 manager.GetNodegroupOption
+	}{arg1, arg2})
+	stub := fake.GetNodeGroupStackTypeStub
+	fakeReturns := fake.getNodeGroupStackTypeReturns
+	fake.recordInvocation("GetNodeGroupStackType", []interface{}{arg1, arg2})
+	fake.getNodeGroupStackTypeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetNodeGroupStackTypeCallCount() int {
+	fake.getNodeGroupStackTypeMutex.RLock()
+	defer fake.getNodeGroupStackTypeMutex.RUnlock()
+	return len(fake.getNodeGroupStackTypeArgsForCall)
+}
+
+func (fake *FakeStackManager) GetNodeGroupStackTypeCalls(stub func(context.Context, manager.GetNodegroupOption) (v1alpha5.NodeGroupType, error)) {
+	fake.getNodeGroupStackTypeMutex.Lock()
+	defer fake.getNodeGroupStackTypeMutex.Unlock()
+	fake.GetNodeGroupStackTypeStub = stub
+}
+
+func (fake *FakeStackManager) GetNodeGroupStackTypeArgsForCall(i int) (context.Context, manager.GetNodegroupOption) {
+	fake.getNodeGroupStackTypeMutex.RLock()
+	defer fake.getNodeGroupStackTypeMutex.RUnlock()
+	argsForCall := fake.getNodeGroupStackTypeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetNodeGroupStackTypeReturns(result1 v1alpha5.NodeGroupType, result2 error) {
+	fake.getNodeGroupStackTypeMutex.Lock()
+	defer fake.getNodeGroupStackTypeMutex.Unlock()
+	fake.GetNodeGroupStackTypeStub = nil
+	fake.getNodeGroupStackTypeReturns = struct {
+		result1 v1alpha5.NodeGroupType
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetNodeGroupStackTypeReturnsOnCall(i int, result1 v1alpha5.NodeGroupType, result2 error) {
+	fake.getNodeGroupStackTypeMutex.Lock()
+	defer fake.getNodeGroupStackTypeMutex.Unlock()
+	fake.GetNodeGroupStackTypeStub = nil
+	if fake.getNodeGroupStackTypeReturnsOnCall == nil {
+		fake.getNodeGroupStackTypeReturnsOnCall = make(map[int]struct {
+			result1 v1alpha5.NodeGroupType
+			result2 error
+		})
+	}
+	fake.getNodeGroupStackTypeReturnsOnCall[i] = struct {
+		result1 v1alpha5.NodeGroupType
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetStackParameters(arg1 context.Context, arg2 string) (map[string]string, error) {
+	fake.getStackParametersMutex.Lock()
+	ret, specificReturn := fake.getStackParametersReturnsOnCall[len(fake.getStackParametersArgsForCall)]
+	fake.getStackParametersArgsForCall = append(fake.getStackParametersArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetStackParametersStub
+	fakeReturns := fake.getStackParametersReturns
+	fake.recordInvocation("GetStackParameters", []interface{}{arg1, arg2})
+	fake.getStackParametersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetStackParametersCallCount() int {
+	fake.getStackParametersMutex.RLock()
+	defer fake.getStackParametersMutex.RUnlock()
+	return len(fake.getStackParametersArgsForCall)
+}
+
+func (fake *FakeStackManager) GetStackParametersCalls(stub func(context.Context, string) (map[string]string, error)) {
+	fake.getStackParametersMutex.Lock()
+	defer fake.getStackParametersMutex.Unlock()
+	fake.GetStackParametersStub = stub
+}
+
+func (fake *FakeStackManager) GetStackParametersArgsForCall(i int) (context.Context, string) {
+	fake.getStackParametersMutex.RLock()
+	defer fake.getStackParametersMutex.RUnlock()
+	argsForCall := fake.getStackParametersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetStackParametersReturns(result1 map[string]string, result2 error) {
+	fake.getStackParametersMutex.Lock()
+	defer fake.getStackParametersMutex.Unlock()
+	fake.GetStackParametersStub = nil
+	fake.getStackParametersReturns = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetStackParametersReturnsOnCall(i int, result1 map[string]string, result2 error) {
+	fake.getStackParametersMutex.Lock()
+	defer fake.getStackParametersMutex.Unlock()
+	fake.GetStackParametersStub = nil
+	if fake.getStackParametersReturnsOnCall == nil {
+		fake.getStackParametersReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+			result2 error
+		})
+	}
+	fake.getStackParametersReturnsOnCall[i] = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetStackPhysicalResourceID(arg1 context.Context, arg2 string, arg3 string) (string, error) {
+	fake.getStackPhysicalResourceIDMutex.Lock()
+	ret, specificReturn := fake.getStackPhysicalResourceIDReturnsOnCall[len(fake.getStackPhysicalResourceIDArgsForCall)]
+	fake.getStackPhysicalResourceIDArgsForCall = append(fake.getStackPhysicalResourceIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetStackPhysicalResourceIDStub
+	fakeReturns := fake.getStackPhysicalResourceIDReturns
+	fake.recordInvocation("GetStackPhysicalResourceID", []interface{}{arg1, arg2, arg3})
+	fake.getStackPhysicalResourceIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetStackPhysicalResourceIDCallCount() int {
+	fake.getStackPhysicalResourceIDMutex.RLock()
+	defer fake.getStackPhysicalResourceIDMutex.RUnlock()
+	return len(fake.getStackPhysicalResourceIDArgsForCall)
+}
+
+func (fake *FakeStackManager) GetStackPhysicalResourceIDCalls(stub func(context.Context, string, string) (string, error)) {
+	fake.getStackPhysicalResourceIDMutex.Lock()
+	defer fake.getStackPhysicalResourceIDMutex.Unlock()
+	fake.GetStackPhysicalResourceIDStub = stub
+}
+
+func (fake *FakeStackManager) GetStackPhysicalResourceIDArgsForCall(i int) (context.Context, string, string) {
+	fake.getStackPhysicalResourceIDMutex.RLock()
+	defer fake.getStackPhysicalResourceIDMutex.RUnlock()
+	argsForCall := fake.getStackPhysicalResourceIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) GetStackPhysicalResourceIDReturns(result1 string, result2 error) {
+	fake.getStackPhysicalResourceIDMutex.Lock()
+	defer fake.getStackPhysicalResourceIDMutex.Unlock()
+	fake.GetStackPhysicalResourceIDStub = nil
+	fake.getStackPhysicalResourceIDReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetStackPhysicalResourceIDReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getStackPhysicalResourceIDMutex.Lock()
+	defer fake.getStackPhysicalResourceIDMutex.Unlock()
+	fake.GetStackPhysicalResourceIDStub = nil
+	if fake.getStackPhysicalResourceIDReturnsOnCall == nil {
+		fake.getStackPhysicalResourceIDReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getStackPhysicalResourceIDReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetStackPolicy(arg1 context.Context, arg2 string) (string, error) {
+	fake.getStackPolicyMutex.Lock()
+	ret, specificReturn := fake.getStackPolicyReturnsOnCall[len(fake.getStackPolicyArgsForCall)]
+	fake.getStackPolicyArgsForCall = append(fake.getStackPolicyArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetStackPolicyStub
+	fakeReturns := fake.getStackPolicyReturns
+	fake.recordInvocation("GetStackPolicy", []interface{}{arg1, arg2})
+	fake.getStackPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetStackPolicyCallCount() int {
+	fake.getStackPolicyMutex.RLock()
+	defer fake.getStackPolicyMutex.RUnlock()
+	return len(fake.getStackPolicyArgsForCall)
+}
+
+func (fake *FakeStackManager) GetStackPolicyCalls(stub func(context.Context, string) (string, error)) {
+	fake.getStackPolicyMutex.Lock()
+	defer fake.getStackPolicyMutex.Unlock()
+	fake.GetStackPolicyStub = stub
+}
+
+func (fake *FakeStackManager) GetStackPolicyArgsForCall(i int) (context.Context, string) {
+	fake.getStackPolicyMutex.RLock()
+	defer fake.getStackPolicyMutex.RUnlock()
+	argsForCall := fake.getStackPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetStackPolicyReturns(result1 string, result2 error) {
+	fake.getStackPolicyMutex.Lock()
+	defer fake.getStackPolicyMutex.Unlock()
+	fake.GetStackPolicyStub = nil
+	fake.getStackPolicyReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetStackPolicyReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getStackPolicyMutex.Lock()
+	defer fake.getStackPolicyMutex.Unlock()
+	fake.GetStackPolicyStub = nil
+	if fake.getStackPolicyReturnsOnCall == nil {
+		fake.getStackPolicyReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getStackPolicyReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetStackRollbackConfiguration(arg1 context.Context, arg2 string) (*manager.RollbackConfiguration, error) {
+	fake.getStackRollbackConfigurationMutex.Lock()
+	ret, specificReturn := fake.getStackRollbackConfigurationReturnsOnCall[len(fake.getStackRollbackConfigurationArgsForCall)]
+	fake.getStackRollbackConfigurationArgsForCall = append(fake.getStackRollbackConfigurationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetStackRollbackConfigurationStub
+	fakeReturns := fake.getStackRollbackConfigurationReturns
+	fake.recordInvocation("GetStackRollbackConfiguration", []interface{}{arg1, arg2})
+	fake.getStackRollbackConfigurationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetStackRollbackConfigurationCallCount() int {
+	fake.getStackRollbackConfigurationMutex.RLock()
+	defer fake.getStackRollbackConfigurationMutex.RUnlock()
+	return len(fake.getStackRollbackConfigurationArgsForCall)
+}
+
+func (fake *FakeStackManager) GetStackRollbackConfigurationCalls(stub func(context.Context, string) (*manager.RollbackConfiguration, error)) {
+	fake.getStackRollbackConfigurationMutex.Lock()
+	defer fake.getStackRollbackConfigurationMutex.Unlock()
+	fake.GetStackRollbackConfigurationStub = stub
+}
+
+func (fake *FakeStackManager) GetStackRollbackConfigurationArgsForCall(i int) (context.Context, string) {
+	fake.getStackRollbackConfigurationMutex.RLock()
+	defer fake.getStackRollbackConfigurationMutex.RUnlock()
+	argsForCall := fake.getStackRollbackConfigurationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetStackRollbackConfigurationReturns(result1 *manager.RollbackConfiguration, result2 error) {
+	fake.getStackRollbackConfigurationMutex.Lock()
+	defer fake.getStackRollbackConfigurationMutex.Unlock()
+	fake.GetStackRollbackConfigurationStub = nil
+	fake.getStackRollbackConfigurationReturns = struct {
+		result1 *manager.RollbackConfiguration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetStackRollbackConfigurationReturnsOnCall(i int, result1 *manager.RollbackConfiguration, result2 error) {
+	fake.getStackRollbackConfigurationMutex.Lock()
+	defer fake.getStackRollbackConfigurationMutex.Unlock()
+	fake.GetStackRollbackConfigurationStub = nil
+	if fake.getStackRollbackConfigurationReturnsOnCall == nil {
+		fake.getStackRollbackConfigurationReturnsOnCall = make(map[int]struct {
+			result1 *manager.RollbackConfiguration
+			result2 error
+		})
+	}
+	fake.getStackRollbackConfigurationReturnsOnCall[i] = struct {
+		result1 *manager.RollbackConfiguration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetStackTemplate(arg1 context.Context, arg2 string) (string, error) {
+	fake.getStackTemplateMutex.Lock()
+	ret, specificReturn := fake.getStackTemplateReturnsOnCall[len(fake.getStackTemplateArgsForCall)]
+	fake.getStackTemplateArgsForCall = append(fake.getStackTemplateArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetStackTemplateStub
+	fakeReturns := fake.getStackTemplateReturns
+	fake.recordInvocation("GetStackTemplate", []interface{}{arg1, arg2})
+	fake.getStackTemplateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetStackTemplateCallCount() int {
+	fake.getStackTemplateMutex.RLock()
+	defer fake.getStackTemplateMutex.RUnlock()
+	return len(fake.getStackTemplateArgsForCall)
+}
+
+func (fake *FakeStackManager) GetStackTemplateCalls(stub func(context.Context, string) (string, error)) {
+	fake.getStackTemplateMutex.Lock()
+	defer fake.getStackTemplateMutex.Unlock()
+	fake.GetStackTemplateStub = stub
+}
+
+func (fake *FakeStackManager) GetStackTemplateArgsForCall(i int) (context.Context, string) {
+	fake.getStackTemplateMutex.RLock()
+	defer fake.getStackTemplateMutex.RUnlock()
+	argsForCall := fake.getStackTemplateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetStackTemplateReturns(result1 string, result2 error) {
+	fake.getStackTemplateMutex.Lock()
+	defer fake.getStackTemplateMutex.Unlock()
+	fake.GetStackTemplateStub = nil
+	fake.getStackTemplateReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetStackTemplateReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getStackTemplateMutex.Lock()
+	defer fake.getStackTemplateMutex.Unlock()
+	fake.GetStackTemplateStub = nil
+	if fake.getStackTemplateReturnsOnCall == nil {
+		fake.getStackTemplateReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getStackTemplateReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetUnmanagedNodeGroupAutoScalingGroupName(arg1 context.Context, arg2 *types.Stack) (string, error) {
+	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Lock()
+	ret, specificReturn := fake.getUnmanagedNodeGroupAutoScalingGroupNameReturnsOnCall[len(fake.getUnmanagedNodeGroupAutoScalingGroupNameArgsForCall)]
+	fake.getUnmanagedNodeGroupAutoScalingGroupNameArgsForCall = append(fake.getUnmanagedNodeGroupAutoScalingGroupNameArgsForCall, struct {
+		arg1 context.Context
+		arg2 *types.Stack
+	}{arg1, arg2})
+	stub := fake.GetUnmanagedNodeGroupAutoScalingGroupNameStub
+	fakeReturns := fake.getUnmanagedNodeGroupAutoScalingGroupNameReturns
+	fake.recordInvocation("GetUnmanagedNodeGroupAutoScalingGroupName", []interface{}{arg1, arg2})
+	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetUnmanagedNodeGroupAutoScalingGroupNameCallCount() int {
+	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.RLock()
+	defer fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.RUnlock()
+	return len(fake.getUnmanagedNodeGroupAutoScalingGroupNameArgsForCall)
+}
+
+func (fake *FakeStackManager) GetUnmanagedNodeGroupAutoScalingGroupNameCalls(stub func(context.Context, *types.Stack) (string, error)) {
+	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Lock()
+	defer fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Unlock()
+	fake.GetUnmanagedNodeGroupAutoScalingGroupNameStub = stub
+}
+
+func (fake *FakeStackManager) GetUnmanagedNodeGroupAutoScalingGroupNameArgsForCall(i int) (context.Context, *types.Stack) {
+	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.RLock()
+	defer fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.RUnlock()
+	argsForCall := fake.getUnmanagedNodeGroupAutoScalingGroupNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetUnmanagedNodeGroupAutoScalingGroupNameReturns(result1 string, result2 error) {
+	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Lock()
+	defer fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Unlock()
+	fake.GetUnmanagedNodeGroupAutoScalingGroupNameStub = nil
+	fake.getUnmanagedNodeGroupAutoScalingGroupNameReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetUnmanagedNodeGroupAutoScalingGroupNameReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Lock()
+	defer fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.Unlock()
+	fake.GetUnmanagedNodeGroupAutoScalingGroupNameStub = nil
+	if fake.getUnmanagedNodeGroupAutoScalingGroupNameReturnsOnCall == nil {
+		fake.getUnmanagedNodeGroupAutoScalingGroupNameReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getUnmanagedNodeGroupAutoScalingGroupNameReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GroupNodeGroupsByInstanceRole(arg1 context.Context) (map[string][]string, error) {
+	fake.groupNodeGroupsByInstanceRoleMutex.Lock()
+	ret, specificReturn := fake.groupNodeGroupsByInstanceRoleReturnsOnCall[len(fake.groupNodeGroupsByInstanceRoleArgsForCall)]
+	fake.groupNodeGroupsByInstanceRoleArgsForCall = append(fake.groupNodeGroupsByInstanceRoleArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GroupNodeGroupsByInstanceRoleStub
+	fakeReturns := fake.groupNodeGroupsByInstanceRoleReturns
+	fake.recordInvocation("GroupNodeGroupsByInstanceRole", []interface{}{arg1})
+	fake.groupNodeGroupsByInstanceRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GroupNodeGroupsByInstanceRoleCallCount() int {
+	fake.groupNodeGroupsByInstanceRoleMutex.RLock()
+	defer fake.groupNodeGroupsByInstanceRoleMutex.RUnlock()
+	return len(fake.groupNodeGroupsByInstanceRoleArgsForCall)
+}
+
+func (fake *FakeStackManager) GroupNodeGroupsByInstanceRoleCalls(stub func(context.Context) (map[string][]string, error)) {
+	fake.groupNodeGroupsByInstanceRoleMutex.Lock()
+	defer fake.groupNodeGroupsByInstanceRoleMutex.Unlock()
+	fake.GroupNodeGroupsByInstanceRoleStub = stub
+}
+
+func (fake *FakeStackManager) GroupNodeGroupsByInstanceRoleArgsForCall(i int) context.Context {
+	fake.groupNodeGroupsByInstanceRoleMutex.RLock()
+	defer fake.groupNodeGroupsByInstanceRoleMutex.RUnlock()
+	argsForCall := fake.groupNodeGroupsByInstanceRoleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GroupNodeGroupsByInstanceRoleReturns(result1 map[string][]string, result2 error) {
+	fake.groupNodeGroupsByInstanceRoleMutex.Lock()
+	defer fake.groupNodeGroupsByInstanceRoleMutex.Unlock()
+	fake.GroupNodeGroupsByInstanceRoleStub = nil
+	fake.groupNodeGroupsByInstanceRoleReturns = struct {
+		result1 map[string][]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GroupNodeGroupsByInstanceRoleReturnsOnCall(i int, result1 map[string][]string, result2 error) {
+	fake.groupNodeGroupsByInstanceRoleMutex.Lock()
+	defer fake.groupNodeGroupsByInstanceRoleMutex.Unlock()
+	fake.GroupNodeGroupsByInstanceRoleStub = nil
+	if fake.groupNodeGroupsByInstanceRoleReturnsOnCall == nil {
+		fake.groupNodeGroupsByInstanceRoleReturnsOnCall = make(map[int]struct {
+			result1 map[string][]string
+			result2 error
+		})
+	}
+	fake.groupNodeGroupsByInstanceRoleReturnsOnCall[i] = struct {
+		result1 map[string][]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GroupNodeGroupsBySecurityGroup(arg1 context.Context) (map[string][]string, error) {
+	fake.groupNodeGroupsBySecurityGroupMutex.Lock()
+	ret, specificReturn := fake.groupNodeGroupsBySecurityGroupReturnsOnCall[len(fake.groupNodeGroupsBySecurityGroupArgsForCall)]
+	fake.groupNodeGroupsBySecurityGroupArgsForCall = append(fake.groupNodeGroupsBySecurityGroupArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GroupNodeGroupsBySecurityGroupStub
+	fakeReturns := fake.groupNodeGroupsBySecurityGroupReturns
+	fake.recordInvocation("GroupNodeGroupsBySecurityGroup", []interface{}{arg1})
+	fake.groupNodeGroupsBySecurityGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GroupNodeGroupsBySecurityGroupCallCount() int {
+	fake.groupNodeGroupsBySecurityGroupMutex.RLock()
+	defer fake.groupNodeGroupsBySecurityGroupMutex.RUnlock()
+	return len(fake.groupNodeGroupsBySecurityGroupArgsForCall)
+}
+
+func (fake *FakeStackManager) GroupNodeGroupsBySecurityGroupCalls(stub func(context.Context) (map[string][]string, error)) {
+	fake.groupNodeGroupsBySecurityGroupMutex.Lock()
+	defer fake.groupNodeGroupsBySecurityGroupMutex.Unlock()
+	fake.GroupNodeGroupsBySecurityGroupStub = stub
+}
+
+func (fake *FakeStackManager) GroupNodeGroupsBySecurityGroupArgsForCall(i int) context.Context {
+	fake.groupNodeGroupsBySecurityGroupMutex.RLock()
+	defer fake.groupNodeGroupsBySecurityGroupMutex.RUnlock()
+	argsForCall := fake.groupNodeGroupsBySecurityGroupArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GroupNodeGroupsBySecurityGroupReturns(result1 map[string][]string, result2 error) {
+	fake.groupNodeGroupsBySecurityGroupMutex.Lock()
+	defer fake.groupNodeGroupsBySecurityGroupMutex.Unlock()
+	fake.GroupNodeGroupsBySecurityGroupStub = nil
+	fake.groupNodeGroupsBySecurityGroupReturns = struct {
+		result1 map[string][]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GroupNodeGroupsBySecurityGroupReturnsOnCall(i int, result1 map[string][]string, result2 error) {
+	fake.groupNodeGroupsBySecurityGroupMutex.Lock()
+	defer fake.groupNodeGroupsBySecurityGroupMutex.Unlock()
+	fake.GroupNodeGroupsBySecurityGroupStub = nil
+	if fake.groupNodeGroupsBySecurityGroupReturnsOnCall == nil {
+		fake.groupNodeGroupsBySecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 map[string][]string
+			result2 error
+		})
+	}
+	fake.groupNodeGroupsBySecurityGroupReturnsOnCall[i] = struct {
+		result1 map[string][]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) HasCNIPolicy(arg1 context.Context, arg2 *types.Stack) (bool, error) {
+	fake.hasCNIPolicyMutex.Lock()
+	ret, specificReturn := fake.hasCNIPolicyReturnsOnCall[len(fake.hasCNIPolicyArgsForCall)]
+	fake.hasCNIPolicyArgsForCall = append(fake.hasCNIPolicyArgsForCall, struct {
+		arg1 context.Context
+		arg2 *types.Stack
+	}{arg1, arg2})
+	stub := fake.HasCNIPolicyStub
+	fakeReturns := fake.hasCNIPolicyReturns
+	fake.recordInvocation("HasCNIPolicy", []interface{}{arg1, arg2})
+	fake.hasCNIPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) HasCNIPolicyCallCount() int {
+	fake.hasCNIPolicyMutex.RLock()
+	defer fake.hasCNIPolicyMutex.RUnlock()
+	return len(fake.hasCNIPolicyArgsForCall)
+}
+
+func (fake *FakeStackManager) HasCNIPolicyCalls(stub func(context.Context, *types.Stack) (bool, error)) {
+	fake.hasCNIPolicyMutex.Lock()
+	defer fake.hasCNIPolicyMutex.Unlock()
+	fake.HasCNIPolicyStub = stub
+}
+
+func (fake *FakeStackManager) HasCNIPolicyArgsForCall(i int) (context.Context, *types.Stack) {
+	fake.hasCNIPolicyMutex.RLock()
+	defer fake.hasCNIPolicyMutex.RUnlock()
+	argsForCall := fake.hasCNIPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) HasCNIPolicyReturns(result1 bool, result2 error) {
+	fake.hasCNIPolicyMutex.Lock()
+	defer fake.hasCNIPolicyMutex.Unlock()
+	fake.HasCNIPolicyStub = nil
+	fake.hasCNIPolicyReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) HasCNIPolicyReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.hasCNIPolicyMutex.Lock()
+	defer fake.hasCNIPolicyMutex.Unlock()
+	fake.HasCNIPolicyStub = nil
+	if fake.hasCNIPolicyReturnsOnCall == nil {
+		fake.hasCNIPolicyReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasCNIPolicyReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) HasClusterStackFromList(arg1 context.Context, arg2 []string, arg3 string) (bool, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.hasClusterStackFromListMutex.Lock()
+	ret, specificReturn := fake.hasClusterStackFromListReturnsOnCall[len(fake.hasClusterStackFromListArgsForCall)]
+	fake.hasClusterStackFromListArgsForCall = append(fake.hasClusterStackFromListArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 string
+	}{arg1, arg2Copy, arg3})
+	stub := fake.HasClusterStackFromListStub
+	fakeReturns := fake.hasClusterStackFromListReturns
+	fake.recordInvocation("HasClusterStackFromList", []interface{}{arg1, arg2Copy, arg3})
+	fake.hasClusterStackFromListMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) HasClusterStackFromListCallCount() int {
+	fake.hasClusterStackFromListMutex.RLock()
+	defer fake.hasClusterStackFromListMutex.RUnlock()
+	return len(fake.hasClusterStackFromListArgsForCall)
+}
+
+func (fake *FakeStackManager) HasClusterStackFromListCalls(stub func(context.Context, []string, string) (bool, error)) {
+	fake.hasClusterStackFromListMutex.Lock()
+	defer fake.hasClusterStackFromListMutex.Unlock()
+	fake.HasClusterStackFromListStub = stub
+}
+
+func (fake *FakeStackManager) HasClusterStackFromListArgsForCall(i int) (context.Context, []string, string) {
+	fake.hasClusterStackFromListMutex.RLock()
+	defer fake.hasClusterStackFromListMutex.RUnlock()
+	argsForCall := fake.hasClusterStackFromListArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) HasClusterStackFromListReturns(result1 bool, result2 error) {
+	fake.hasClusterStackFromListMutex.Lock()
+	defer fake.hasClusterStackFromListMutex.Unlock()
+	fake.HasClusterStackFromListStub = nil
+	fake.hasClusterStackFromListReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) HasClusterStackFromListReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.hasClusterStackFromListMutex.Lock()
+	defer fake.hasClusterStackFromListMutex.Unlock()
+	fake.HasClusterStackFromListStub = nil
+	if fake.hasClusterStackFromListReturnsOnCall == nil {
+		fake.hasClusterStackFromListReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasClusterStackFromListReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) HasProtectedResources(arg1 context.Context, arg2 string) (bool, error) {
+	fake.hasProtectedResourcesMutex.Lock()
+	ret, specificReturn := fake.hasProtectedResourcesReturnsOnCall[len(fake.hasProtectedResourcesArgsForCall)]
+	fake.hasProtectedResourcesArgsForCall = append(fake.hasProtectedResourcesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.HasProtectedResourcesStub
+	fakeReturns := fake.hasProtectedResourcesReturns
+	fake.recordInvocation("HasProtectedResources", []interface{}{arg1, arg2})
+	fake.hasProtectedResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) HasProtectedResourcesCallCount() int {
+	fake.hasProtectedResourcesMutex.RLock()
+	defer fake.hasProtectedResourcesMutex.RUnlock()
+	return len(fake.hasProtectedResourcesArgsForCall)
+}
+
+func (fake *FakeStackManager) HasProtectedResourcesCalls(stub func(context.Context, string) (bool, error)) {
+	fake.hasProtectedResourcesMutex.Lock()
+	defer fake.hasProtectedResourcesMutex.Unlock()
+	fake.HasProtectedResourcesStub = stub
+}
+
+func (fake *FakeStackManager) HasProtectedResourcesArgsForCall(i int) (context.Context, string) {
+	fake.hasProtectedResourcesMutex.RLock()
+	defer fake.hasProtectedResourcesMutex.RUnlock()
+	argsForCall := fake.hasProtectedResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) HasProtectedResourcesReturns(result1 bool, result2 error) {
+	fake.hasProtectedResourcesMutex.Lock()
+	defer fake.hasProtectedResourcesMutex.Unlock()
+	fake.HasProtectedResourcesStub = nil
+	fake.hasProtectedResourcesReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) HasProtectedResourcesReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.hasProtectedResourcesMutex.Lock()
+	defer fake.hasProtectedResourcesMutex.Unlock()
+	fake.HasProtectedResourcesStub = nil
+	if fake.hasProtectedResourcesReturnsOnCall == nil {
+		fake.hasProtectedResourcesReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasProtectedResourcesReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ImportUnmanagedNodeGroup(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.importUnmanagedNodeGroupMutex.Lock()
+	ret, specificReturn := fake.importUnmanagedNodeGroupReturnsOnCall[len(fake.importUnmanagedNodeGroupArgsForCall)]
+	fake.importUnmanagedNodeGroupArgsForCall = append(fake.importUnmanagedNodeGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ImportUnmanagedNodeGroupStub
+	fakeReturns := fake.importUnmanagedNodeGroupReturns
+	fake.recordInvocation("ImportUnmanagedNodeGroup", []interface{}{arg1, arg2, arg3})
+	fake.importUnmanagedNodeGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) ImportUnmanagedNodeGroupCallCount() int {
+	fake.importUnmanagedNodeGroupMutex.RLock()
+	defer fake.importUnmanagedNodeGroupMutex.RUnlock()
+	return len(fake.importUnmanagedNodeGroupArgsForCall)
+}
+
+func (fake *FakeStackManager) ImportUnmanagedNodeGroupCalls(stub func(context.Context, string, string) error) {
+	fake.importUnmanagedNodeGroupMutex.Lock()
+	defer fake.importUnmanagedNodeGroupMutex.Unlock()
+	fake.ImportUnmanagedNodeGroupStub = stub
+}
+
+func (fake *FakeStackManager) ImportUnmanagedNodeGroupArgsForCall(i int) (context.Context, string, string) {
+	fake.importUnmanagedNodeGroupMutex.RLock()
+	defer fake.importUnmanagedNodeGroupMutex.RUnlock()
+	argsForCall := fake.importUnmanagedNodeGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) ImportUnmanagedNodeGroupReturns(result1 error) {
+	fake.importUnmanagedNodeGroupMutex.Lock()
+	defer fake.importUnmanagedNodeGroupMutex.Unlock()
+	fake.ImportUnmanagedNodeGroupStub = nil
+	fake.importUnmanagedNodeGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) ImportUnmanagedNodeGroupReturnsOnCall(i int, result1 error) {
+	fake.importUnmanagedNodeGroupMutex.Lock()
+	defer fake.importUnmanagedNodeGroupMutex.Unlock()
+	fake.ImportUnmanagedNodeGroupStub = nil
+	if fake.importUnmanagedNodeGroupReturnsOnCall == nil {
+		fake.importUnmanagedNodeGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.importUnmanagedNodeGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) IsSpotNodeGroup(arg1 context.Context, arg2 *types.Stack) (bool, error) {
+	fake.isSpotNodeGroupMutex.Lock()
+	ret, specificReturn := fake.isSpotNodeGroupReturnsOnCall[len(fake.isSpotNodeGroupArgsForCall)]
+	fake.isSpotNodeGroupArgsForCall = append(fake.isSpotNodeGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 *types.Stack
+	}{arg1, arg2})
+	stub := fake.IsSpotNodeGroupStub
+	fakeReturns := fake.isSpotNodeGroupReturns
+	fake.recordInvocation("IsSpotNodeGroup", []interface{}{arg1, arg2})
+	fake.isSpotNodeGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) IsSpotNodeGroupCallCount() int {
+	fake.isSpotNodeGroupMutex.RLock()
+	defer fake.isSpotNodeGroupMutex.RUnlock()
+	return len(fake.isSpotNodeGroupArgsForCall)
+}
+
+func (fake *FakeStackManager) IsSpotNodeGroupCalls(stub func(context.Context, *types.Stack) (bool, error)) {
+	fake.isSpotNodeGroupMutex.Lock()
+	defer fake.isSpotNodeGroupMutex.Unlock()
+	fake.IsSpotNodeGroupStub = stub
+}
+
+func (fake *FakeStackManager) IsSpotNodeGroupArgsForCall(i int) (context.Context, *types.Stack) {
+	fake.isSpotNodeGroupMutex.RLock()
+	defer fake.isSpotNodeGroupMutex.RUnlock()
+	argsForCall := fake.isSpotNodeGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) IsSpotNodeGroupReturns(result1 bool, result2 error) {
+	fake.isSpotNodeGroupMutex.Lock()
+	defer fake.isSpotNodeGroupMutex.Unlock()
+	fake.IsSpotNodeGroupStub = nil
+	fake.isSpotNodeGroupReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) IsSpotNodeGroupReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isSpotNodeGroupMutex.Lock()
+	defer fake.isSpotNodeGroupMutex.Unlock()
+	fake.IsSpotNodeGroupStub = nil
+	if fake.isSpotNodeGroupReturnsOnCall == nil {
+		fake.isSpotNodeGroupReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isSpotNodeGroupReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) IsTerminationProtected(arg1 context.Context, arg2 string) (bool, error) {
+	fake.isTerminationProtectedMutex.Lock()
+	ret, specificReturn := fake.isTerminationProtectedReturnsOnCall[len(fake.isTerminationProtectedArgsForCall)]
+	fake.isTerminationProtectedArgsForCall = append(fake.isTerminationProtectedArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.IsTerminationProtectedStub
+	fakeReturns := fake.isTerminationProtectedReturns
+	fake.recordInvocation("IsTerminationProtected", []interface{}{arg1, arg2})
+	fake.isTerminationProtectedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) IsTerminationProtectedCallCount() int {
+	fake.isTerminationProtectedMutex.RLock()
+	defer fake.isTerminationProtectedMutex.RUnlock()
+	return len(fake.isTerminationProtectedArgsForCall)
+}
+
+func (fake *FakeStackManager) IsTerminationProtectedCalls(stub func(context.Context, string) (bool, error)) {
+	fake.isTerminationProtectedMutex.Lock()
+	defer fake.isTerminationProtectedMutex.Unlock()
+	fake.IsTerminationProtectedStub = stub
+}
+
+func (fake *FakeStackManager) IsTerminationProtectedArgsForCall(i int) (context.Context, string) {
+	fake.isTerminationProtectedMutex.RLock()
+	defer fake.isTerminationProtectedMutex.RUnlock()
+	argsForCall := fake.isTerminationProtectedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) IsTerminationProtectedReturns(result1 bool, result2 error) {
+	fake.isTerminationProtectedMutex.Lock()
+	defer fake.isTerminationProtectedMutex.Unlock()
+	fake.IsTerminationProtectedStub = nil
+	fake.isTerminationProtectedReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) IsTerminationProtectedReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isTerminationProtectedMutex.Lock()
+	defer fake.isTerminationProtectedMutex.Unlock()
+	fake.IsTerminationProtectedStub = nil
+	if fake.isTerminationProtectedReturnsOnCall == nil {
+		fake.isTerminationProtectedReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isTerminationProtectedReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListClusterStackNames(arg1 context.Context) ([]string, error) {
+	fake.listClusterStackNamesMutex.Lock()
+	ret, specificReturn := fake.listClusterStackNamesReturnsOnCall[len(fake.listClusterStackNamesArgsForCall)]
+	fake.listClusterStackNamesArgsForCall = append(fake.listClusterStackNamesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListClusterStackNamesStub
+	fakeReturns := fake.listClusterStackNamesReturns
+	fake.recordInvocation("ListClusterStackNames", []interface{}{arg1})
+	fake.listClusterStackNamesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListClusterStackNamesCallCount() int {
+	fake.listClusterStackNamesMutex.RLock()
+	defer fake.listClusterStackNamesMutex.RUnlock()
+	return len(fake.listClusterStackNamesArgsForCall)
+}
+
+func (fake *FakeStackManager) ListClusterStackNamesCalls(stub func(context.Context) ([]string, error)) {
+	fake.listClusterStackNamesMutex.Lock()
+	defer fake.listClusterStackNamesMutex.Unlock()
+	fake.ListClusterStackNamesStub = stub
+}
+
+func (fake *FakeStackManager) ListClusterStackNamesArgsForCall(i int) context.Context {
+	fake.listClusterStackNamesMutex.RLock()
+	defer fake.listClusterStackNamesMutex.RUnlock()
+	argsForCall := fake.listClusterStackNamesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) ListClusterStackNamesReturns(result1 []string, result2 error) {
+	fake.listClusterStackNamesMutex.Lock()
+	defer fake.listClusterStackNamesMutex.Unlock()
+	fake.ListClusterStackNamesStub = nil
+	fake.listClusterStackNamesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListClusterStackNamesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.listClusterStackNamesMutex.Lock()
+	defer fake.listClusterStackNamesMutex.Unlock()
+	fake.ListClusterStackNamesStub = nil
+	if fake.listClusterStackNamesReturnsOnCall == nil {
+		fake.listClusterStackNamesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.listClusterStackNamesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListIAMServiceAccountStacks(arg1 context.Context) ([]string, error) {
+	fake.listIAMServiceAccountStacksMutex.Lock()
+	ret, specificReturn := fake.listIAMServiceAccountStacksReturnsOnCall[len(fake.listIAMServiceAccountStacksArgsForCall)]
+	fake.listIAMServiceAccountStacksArgsForCall = append(fake.listIAMServiceAccountStacksArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListIAMServiceAccountStacksStub
+	fakeReturns := fake.listIAMServiceAccountStacksReturns
+	fake.recordInvocation("ListIAMServiceAccountStacks", []interface{}{arg1})
+	fake.listIAMServiceAccountStacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListIAMServiceAccountStacksCallCount() int {
+	fake.listIAMServiceAccountStacksMutex.RLock()
+	defer fake.listIAMServiceAccountStacksMutex.RUnlock()
+	return len(fake.listIAMServiceAccountStacksArgsForCall)
+}
+
+func (fake *FakeStackManager) ListIAMServiceAccountStacksCalls(stub func(context.Context) ([]string, error)) {
+	fake.listIAMServiceAccountStacksMutex.Lock()
+	defer fake.listIAMServiceAccountStacksMutex.Unlock()
+	fake.ListIAMServiceAccountStacksStub = stub
+}
+
+func (fake *FakeStackManager) ListIAMServiceAccountStacksArgsForCall(i int) context.Context {
+	fake.listIAMServiceAccountStacksMutex.RLock()
+	defer fake.listIAMServiceAccountStacksMutex.RUnlock()
+	argsForCall := fake.listIAMServiceAccountStacksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) ListIAMServiceAccountStacksReturns(result1 []string, result2 error) {
+	fake.listIAMServiceAccountStacksMutex.Lock()
+	defer fake.listIAMServiceAccountStacksMutex.Unlock()
+	fake.ListIAMServiceAccountStacksStub = nil
+	fake.listIAMServiceAccountStacksReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListIAMServiceAccountStacksReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.listIAMServiceAccountStacksMutex.Lock()
+	defer fake.listIAMServiceAccountStacksMutex.Unlock()
+	fake.ListIAMServiceAccountStacksStub = nil
+	if fake.listIAMServiceAccountStacksReturnsOnCall == nil {
+		fake.listIAMServiceAccountStacksReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.listIAMServiceAccountStacksReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListInProgressStacks(arg1 context.Context) ([]*types.Stack, error) {
+	fake.listInProgressStacksMutex.Lock()
+	ret, specificReturn := fake.listInProgressStacksReturnsOnCall[len(fake.listInProgressStacksArgsForCall)]
+	fake.listInProgressStacksArgsForCall = append(fake.listInProgressStacksArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListInProgressStacksStub
+	fakeReturns := fake.listInProgressStacksReturns
+	fake.recordInvocation("ListInProgressStacks", []interface{}{arg1})
+	fake.listInProgressStacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListInProgressStacksCallCount() int {
+	fake.listInProgressStacksMutex.RLock()
+	defer fake.listInProgressStacksMutex.RUnlock()
+	return len(fake.listInProgressStacksArgsForCall)
+}
+
+func (fake *FakeStackManager) ListInProgressStacksCalls(stub func(context.Context) ([]*types.Stack, error)) {
+	fake.listInProgressStacksMutex.Lock()
+	defer fake.listInProgressStacksMutex.Unlock()
+	fake.ListInProgressStacksStub = stub
+}
+
+func (fake *FakeStackManager) ListInProgressStacksArgsForCall(i int) context.Context {
+	fake.listInProgressStacksMutex.RLock()
+	defer fake.listInProgressStacksMutex.RUnlock()
+	argsForCall := fake.listInProgressStacksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) ListInProgressStacksReturns(result1 []*types.Stack, result2 error) {
+	fake.listInProgressStacksMutex.Lock()
+	defer fake.listInProgressStacksMutex.Unlock()
+	fake.ListInProgressStacksStub = nil
+	fake.listInProgressStacksReturns = struct {
+		result1 []*types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListInProgressStacksReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
+	fake.listInProgressStacksMutex.Lock()
+	defer fake.listInProgressStacksMutex.Unlock()
+	fake.ListInProgressStacksStub = nil
+	if fake.listInProgressStacksReturnsOnCall == nil {
+		fake.listInProgressStacksReturnsOnCall = make(map[int]struct {
+			result1 []*types.Stack
+			result2 error
+		})
+	}
+	fake.listInProgressStacksReturnsOnCall[i] = struct {
+		result1 []*types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacks(arg1 context.Context) ([]manager.NodeGroupStack, error) {
+	fake.listNodeGroupStacksMutex.Lock()
+	ret, specificReturn := fake.listNodeGroupStacksReturnsOnCall[len(fake.listNodeGroupStacksArgsForCall)]
+	fake.listNodeGroupStacksArgsForCall = append(fake.listNodeGroupStacksArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListNodeGroupStacksStub
+	fakeReturns := fake.listNodeGroupStacksReturns
+	fake.recordInvocation("ListNodeGroupStacks", []interface{}{arg1})
+	fake.listNodeGroupStacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksCallCount() int {
+	fake.listNodeGroupStacksMutex.RLock()
+	defer fake.listNodeGroupStacksMutex.RUnlock()
+	return len(fake.listNodeGroupStacksArgsForCall)
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksCalls(stub func(context.Context) ([]manager.NodeGroupStack, error)) {
+	fake.listNodeGroupStacksMutex.Lock()
+	defer fake.listNodeGroupStacksMutex.Unlock()
+	fake.ListNodeGroupStacksStub = stub
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksArgsForCall(i int) context.Context {
+	fake.listNodeGroupStacksMutex.RLock()
+	defer fake.listNodeGroupStacksMutex.RUnlock()
+	argsForCall := fake.listNodeGroupStacksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksReturns(result1 []manager.NodeGroupStack, result2 error) {
+	fake.listNodeGroupStacksMutex.Lock()
+	defer fake.listNodeGroupStacksMutex.Unlock()
+	fake.ListNodeGroupStacksStub = nil
+	fake.listNodeGroupStacksReturns = struct {
+		result1 []manager.NodeGroupStack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksReturnsOnCall(i int, result1 []manager.NodeGroupStack, result2 error) {
+	fake.listNodeGroupStacksMutex.Lock()
+	defer fake.listNodeGroupStacksMutex.Unlock()
+	fake.ListNodeGroupStacksStub = nil
+	if fake.listNodeGroupStacksReturnsOnCall == nil {
+		fake.listNodeGroupStacksReturnsOnCall = make(map[int]struct {
+			result1 []manager.NodeGroupStack
+			result2 error
+		})
+	}
+	fake.listNodeGroupStacksReturnsOnCall[i] = struct {
+		result1 []manager.NodeGroupStack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksByType(arg1 context.Context, arg2 v1alpha5.NodeGroupType) ([]manager.NodeGroupStack, error) {
+	fake.listNodeGroupStacksByTypeMutex.Lock()
+	ret, specificReturn := fake.listNodeGroupStacksByTypeReturnsOnCall[len(fake.listNodeGroupStacksByTypeArgsForCall)]
+	fake.listNodeGroupStacksByTypeArgsForCall = append(fake.listNodeGroupStacksByTypeArgsForCall, struct {
+		arg1 context.Context
+		arg2 v1alpha5.NodeGroupType
+	}{arg1, arg2})
+	stub := fake.ListNodeGroupStacksByTypeStub
+	fakeReturns := fake.listNodeGroupStacksByTypeReturns
+	fake.recordInvocation("ListNodeGroupStacksByType", []interface{}{arg1, arg2})
+	fake.listNodeGroupStacksByTypeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksByTypeCallCount() int {
+	fake.listNodeGroupStacksByTypeMutex.RLock()
+	defer fake.listNodeGroupStacksByTypeMutex.RUnlock()
+	return len(fake.listNodeGroupStacksByTypeArgsForCall)
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksByTypeCalls(stub func(context.Context, v1alpha5.NodeGroupType) ([]manager.NodeGroupStack, error)) {
+	fake.listNodeGroupStacksByTypeMutex.Lock()
+	defer fake.listNodeGroupStacksByTypeMutex.Unlock()
+	fake.ListNodeGroupStacksByTypeStub = stub
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksByTypeArgsForCall(i int) (context.Context, v1alpha5.NodeGroupType) {
+	fake.listNodeGroupStacksByTypeMutex.RLock()
+	defer fake.listNodeGroupStacksByTypeMutex.RUnlock()
+	argsForCall := fake.listNodeGroupStacksByTypeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksByTypeReturns(result1 []manager.NodeGroupStack, result2 error) {
+	fake.listNodeGroupStacksByTypeMutex.Lock()
+	defer fake.listNodeGroupStacksByTypeMutex.Unlock()
+	fake.ListNodeGroupStacksByTypeStub = nil
+	fake.listNodeGroupStacksByTypeReturns = struct {
+		result1 []manager.NodeGroupStack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksByTypeReturnsOnCall(i int, result1 []manager.NodeGroupStack, result2 error) {
+	fake.listNodeGroupStacksByTypeMutex.Lock()
+	defer fake.listNodeGroupStacksByTypeMutex.Unlock()
+	fake.ListNodeGroupStacksByTypeStub = nil
+	if fake.listNodeGroupStacksByTypeReturnsOnCall == nil {
+		fake.listNodeGroupStacksByTypeReturnsOnCall = make(map[int]struct {
+			result1 []manager.NodeGroupStack
+			result2 error
+		})
+	}
+	fake.listNodeGroupStacksByTypeReturnsOnCall[i] = struct {
+		result1 []manager.NodeGroupStack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksPaged(arg1 context.Context, arg2 int, arg3 string) ([]manager.NodeGroupStack, string, error) {
+	fake.listNodeGroupStacksPagedMutex.Lock()
+	ret, specificReturn := fake.listNodeGroupStacksPagedReturnsOnCall[len(fake.listNodeGroupStacksPagedArgsForCall)]
+	fake.listNodeGroupStacksPagedArgsForCall = append(fake.listNodeGroupStacksPagedArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ListNodeGroupStacksPagedStub
+	fakeReturns := fake.listNodeGroupStacksPagedReturns
+	fake.recordInvocation("ListNodeGroupStacksPaged", []interface{}{arg1, arg2, arg3})
+	fake.listNodeGroupStacksPagedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksPagedCallCount() int {
+	fake.listNodeGroupStacksPagedMutex.RLock()
+	defer fake.listNodeGroupStacksPagedMutex.RUnlock()
+	return len(fake.listNodeGroupStacksPagedArgsForCall)
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksPagedCalls(stub func(context.Context, int, string) ([]manager.NodeGroupStack, string, error)) {
+	fake.listNodeGroupStacksPagedMutex.Lock()
+	defer fake.listNodeGroupStacksPagedMutex.Unlock()
+	fake.ListNodeGroupStacksPagedStub = stub
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksPagedArgsForCall(i int) (context.Context, int, string) {
+	fake.listNodeGroupStacksPagedMutex.RLock()
+	defer fake.listNodeGroupStacksPagedMutex.RUnlock()
+	argsForCall := fake.listNodeGroupStacksPagedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksPagedReturns(result1 []manager.NodeGroupStack, result2 string, result3 error) {
+	fake.listNodeGroupStacksPagedMutex.Lock()
+	defer fake.listNodeGroupStacksPagedMutex.Unlock()
+	fake.ListNodeGroupStacksPagedStub = nil
+	fake.listNodeGroupStacksPagedReturns = struct {
+		result1 []manager.NodeGroupStack
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksPagedReturnsOnCall(i int, result1 []manager.NodeGroupStack, result2 string, result3 error) {
+	fake.listNodeGroupStacksPagedMutex.Lock()
+	defer fake.listNodeGroupStacksPagedMutex.Unlock()
+	fake.ListNodeGroupStacksPagedStub = nil
+	if fake.listNodeGroupStacksPagedReturnsOnCall == nil {
+		fake.listNodeGroupStacksPagedReturnsOnCall = make(map[int]struct {
+			result1 []manager.NodeGroupStack
+			result2 string
+			result3 error
+		})
+	}
+	fake.listNodeGroupStacksPagedReturnsOnCall[i] = struct {
+		result1 []manager.NodeGroupStack
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStackManager) ListStackChangeSets(arg1 context.Context, arg2 string) ([]manager.ChangeSetSummary, error) {
+	fake.listStackChangeSetsMutex.Lock()
+	ret, specificReturn := fake.listStackChangeSetsReturnsOnCall[len(fake.listStackChangeSetsArgsForCall)]
+	fake.listStackChangeSetsArgsForCall = append(fake.listStackChangeSetsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ListStackChangeSetsStub
+	fakeReturns := fake.listStackChangeSetsReturns
+	fake.recordInvocation("ListStackChangeSets", []interface{}{arg1, arg2})
+	fake.listStackChangeSetsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListStackChangeSetsCallCount() int {
+	fake.listStackChangeSetsMutex.RLock()
+	defer fake.listStackChangeSetsMutex.RUnlock()
+	return len(fake.listStackChangeSetsArgsForCall)
+}
+
+func (fake *FakeStackManager) ListStackChangeSetsCalls(stub func(context.Context, string) ([]manager.ChangeSetSummary, error)) {
+	fake.listStackChangeSetsMutex.Lock()
+	defer fake.listStackChangeSetsMutex.Unlock()
+	fake.ListStackChangeSetsStub = stub
+}
+
+func (fake *FakeStackManager) ListStackChangeSetsArgsForCall(i int) (context.Context, string) {
+	fake.listStackChangeSetsMutex.RLock()
+	defer fake.listStackChangeSetsMutex.RUnlock()
+	argsForCall := fake.listStackChangeSetsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) ListStackChangeSetsReturns(result1 []manager.ChangeSetSummary, result2 error) {
+	fake.listStackChangeSetsMutex.Lock()
+	defer fake.listStackChangeSetsMutex.Unlock()
+	fake.ListStackChangeSetsStub = nil
+	fake.listStackChangeSetsReturns = struct {
+		result1 []manager.ChangeSetSummary
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListStackChangeSetsReturnsOnCall(i int, result1 []manager.ChangeSetSummary, result2 error) {
+	fake.listStackChangeSetsMutex.Lock()
+	defer fake.listStackChangeSetsMutex.Unlock()
+	fake.ListStackChangeSetsStub = nil
+	if fake.listStackChangeSetsReturnsOnCall == nil {
+		fake.listStackChangeSetsReturnsOnCall = make(map[int]struct {
+			result1 []manager.ChangeSetSummary
+			result2 error
+		})
+	}
+	fake.listStackChangeSetsReturnsOnCall[i] = struct {
+		result1 []manager.ChangeSetSummary
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListStacks(arg1 context.Context, arg2 ...types.StackStatus) ([]*types.Stack, error) {
+	fake.listStacksMutex.Lock()
+	ret, specificReturn := fake.listStacksReturnsOnCall[len(fake.listStacksArgsForCall)]
+	fake.listStacksArgsForCall = append(fake.listStacksArgsForCall, struct {
+		arg1 context.Context
+		arg2 []types.StackStatus
+	}{arg1, arg2})
+	stub := fake.ListStacksStub
+	fakeReturns := fake.listStacksReturns
+	fake.recordInvocation("ListStacks", []interface{}{arg1, arg2})
+	fake.listStacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListStacksCallCount() int {
+	fake.listStacksMutex.RLock()
+	defer fake.listStacksMutex.RUnlock()
+	return len(fake.listStacksArgsForCall)
+}
+
+func (fake *FakeStackManager) ListStacksCalls(stub func(context.Context, ...types.StackStatus) ([]*types.Stack, error)) {
+	fake.listStacksMutex.Lock()
+	defer fake.listStacksMutex.Unlock()
+	fake.ListStacksStub = stub
+}
+
+func (fake *FakeStackManager) ListStacksArgsForCall(i int) (context.Context, []types.StackStatus) {
+	fake.listStacksMutex.RLock()
+	defer fake.listStacksMutex.RUnlock()
+	argsForCall := fake.listStacksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) ListStacksReturns(result1 []*types.Stack, result2 error) {
+	fake.listStacksMutex.Lock()
+	defer fake.listStacksMutex.Unlock()
+	fake.ListStacksStub = nil
+	fake.listStacksReturns = struct {
+		result1 []*types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListStacksReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
+	fake.listStacksMutex.Lock()
+	defer fake.listStacksMutex.Unlock()
+	fake.ListStacksStub = nil
+	if fake.listStacksReturnsOnCall == nil {
+		fake.listStacksReturnsOnCall = make(map[int]struct {
+			result1 []*types.Stack
+			result2 error
+		})
+	}
+	fake.listStacksReturnsOnCall[i] = struct {
+		result1 []*types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListStacksMatching(arg1 context.Context, arg2 string, arg3 ...types.StackStatus) ([]*types.Stack, error) {
+	fake.listStacksMatchingMutex.Lock()
+	ret, specificReturn := fake.listStacksMatchingReturnsOnCall[len(fake.listStacksMatchingArgsForCall)]
+	fake.listStacksMatchingArgsForCall = append(fake.listStacksMatchingArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []types.StackStatus
+	}{arg1, arg2, arg3})
+	stub := fake.ListStacksMatchingStub
+	fakeReturns := fake.listStacksMatchingReturns
+	fake.recordInvocation("ListStacksMatching", []interface{}{arg1, arg2, arg3})
+	fake.listStacksMatchingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListStacksMatchingCallCount() int {
+	fake.listStacksMatchingMutex.RLock()
+	defer fake.listStacksMatchingMutex.RUnlock()
+	return len(fake.listStacksMatchingArgsForCall)
+}
+
+func (fake *FakeStackManager) ListStacksMatchingCalls(stub func(context.Context, string, ...types.StackStatus) ([]*types.Stack, error)) {
+	fake.listStacksMatchingMutex.Lock()
+	defer fake.listStacksMatchingMutex.Unlock()
+	fake.ListStacksMatchingStub = stub
+}
+
+func (fake *FakeStackManager) ListStacksMatchingArgsForCall(i int) (context.Context, string, []types.StackStatus) {
+	fake.listStacksMatchingMutex.RLock()
+	defer fake.listStacksMatchingMutex.RUnlock()
+	argsForCall := fake.listStacksMatchingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) ListStacksMatchingReturns(result1 []*types.Stack, result2 error) {
+	fake.listStacksMatchingMutex.Lock()
+	defer fake.listStacksMatchingMutex.Unlock()
+	fake.ListStacksMatchingStub = nil
+	fake.listStacksMatchingReturns = struct {
+		result1 []*types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListStacksMatchingReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
+	fake.listStacksMatchingMutex.Lock()
+	defer fake.listStacksMatchingMutex.Unlock()
+	fake.ListStacksMatchingStub = nil
+	if fake.listStacksMatchingReturnsOnCall == nil {
+		fake.listStacksMatchingReturnsOnCall = make(map[int]struct {
+			result1 []*types.Stack
+			result2 error
+		})
+	}
+	fake.listStacksMatchingReturnsOnCall[i] = struct {
+		result1 []*types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) LookupCloudTrailEvents(arg1 context.Context, arg2 *types.Stack) ([]typesb.Event, error) {
+	fake.lookupCloudTrailEventsMutex.Lock()
+	ret, specificReturn := fake.lookupCloudTrailEventsReturnsOnCall[len(fake.lookupCloudTrailEventsArgsForCall)]
+	fake.lookupCloudTrailEventsArgsForCall = append(fake.lookupCloudTrailEventsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *types.Stack
+	}{arg1, arg2})
+	stub := fake.LookupCloudTrailEventsStub
+	fakeReturns := fake.lookupCloudTrailEventsReturns
+	fake.recordInvocation("LookupCloudTrailEvents", []interface{}{arg1, arg2})
+	fake.lookupCloudTrailEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) LookupCloudTrailEventsCallCount() int {
+	fake.lookupCloudTrailEventsMutex.RLock()
+	defer fake.lookupCloudTrailEventsMutex.RUnlock()
+	return len(fake.lookupCloudTrailEventsArgsForCall)
+}
+
+func (fake *FakeStackManager) LookupCloudTrailEventsCalls(stub func(context.Context, *types.Stack) ([]typesb.Event, error)) {
+	fake.lookupCloudTrailEventsMutex.Lock()
+	defer fake.lookupCloudTrailEventsMutex.Unlock()
+	fake.LookupCloudTrailEventsStub = stub
+}
+
+func (fake *FakeStackManager) LookupCloudTrailEventsArgsForCall(i int) (context.Context, *types.Stack) {
+	fake.lookupCloudTrailEventsMutex.RLock()
+	defer fake.lookupCloudTrailEventsMutex.RUnlock()
+	argsForCall := fake.lookupCloudTrailEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) LookupCloudTrailEventsReturns(result1 []typesb.Event, result2 error) {
+	fake.lookupCloudTrailEventsMutex.Lock()
+	defer fake.lookupCloudTrailEventsMutex.Unlock()
+	fake.LookupCloudTrailEventsStub = nil
+	fake.lookupCloudTrailEventsReturns = struct {
+		result1 []typesb.Event
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) LookupCloudTrailEventsReturnsOnCall(i int, result1 []typesb.Event, result2 error) {
+	fake.lookupCloudTrailEventsMutex.Lock()
+	defer fake.lookupCloudTrailEventsMutex.Unlock()
+	fake.LookupCloudTrailEventsStub = nil
+	if fake.lookupCloudTrailEventsReturnsOnCall == nil {
+		fake.lookupCloudTrailEventsReturnsOnCall = make(map[int]struct {
+			result1 []typesb.Event
+			result2 error
+		})
+	}
+	fake.lookupCloudTrailEventsReturnsOnCall[i] = struct {
+		result1 []typesb.Event
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) MakeChangeSetName(arg1 string) string {
+	fake.makeChangeSetNameMutex.Lock()
+	ret, specificReturn := fake.makeChangeSetNameReturnsOnCall[len(fake.makeChangeSetNameArgsForCall)]
+	fake.makeChangeSetNameArgsForCall = append(fake.makeChangeSetNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.MakeChangeSetNameStub
+	fakeReturns := fake.makeChangeSetNameReturns
+	fake.recordInvocation("MakeChangeSetName", []interface{}{arg1})
+	fake.makeChangeSetNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) MakeChangeSetNameCallCount() int {
+	fake.makeChangeSetNameMutex.RLock()
+	defer fake.makeChangeSetNameMutex.RUnlock()
+	return len(fake.makeChangeSetNameArgsForCall)
+}
+
+func (fake *FakeStackManager) MakeChangeSetNameCalls(stub func(string) string) {
+	fake.makeChangeSetNameMutex.Lock()
+	defer fake.makeChangeSetNameMutex.Unlock()
+	fake.MakeChangeSetNameStub = stub
+}
+
+func (fake *FakeStackManager) MakeChangeSetNameArgsForCall(i int) string {
+	fake.makeChangeSetNameMutex.RLock()
+	defer fake.makeChangeSetNameMutex.RUnlock()
+	argsForCall := fake.makeChangeSetNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) MakeChangeSetNameReturns(result1 string) {
+	fake.makeChangeSetNameMutex.Lock()
+	defer fake.makeChangeSetNameMutex.Unlock()
+	fake.MakeChangeSetNameStub = nil
+	fake.makeChangeSetNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeStackManager) MakeChangeSetNameReturnsOnCall(i int, result1 string) {
+	fake.makeChangeSetNameMutex.Lock()
+	defer fake.makeChangeSetNameMutex.Unlock()
+	fake.MakeChangeSetNameStub = nil
+	if fake.makeChangeSetNameReturnsOnCall == nil {
+		fake.makeChangeSetNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.makeChangeSetNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeStackManager) MakeClusterStackName() string {
+	fake.makeClusterStackNameMutex.Lock()
+	ret, specificReturn := fake.makeClusterStackNameReturnsOnCall[len(fake.makeClusterStackNameArgsForCall)]
+	fake.makeClusterStackNameArgsForCall = append(fake.makeClusterStackNameArgsForCall, struct {
+	}{})
+	stub := fake.MakeClusterStackNameStub
+	fakeReturns := fake.makeClusterStackNameReturns
+	fake.recordInvocation("MakeClusterStackName", []interface{}{})
+	fake.makeClusterStackNameMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) MakeClusterStackNameCallCount() int {
+	fake.makeClusterStackNameMutex.RLock()
+	defer fake.makeClusterStackNameMutex.RUnlock()
+	return len(fake.makeClusterStackNameArgsForCall)
+}
+
+func (fake *FakeStackManager) MakeClusterStackNameCalls(stub func() string) {
+	fake.makeClusterStackNameMutex.Lock()
+	defer fake.makeClusterStackNameMutex.Unlock()
+	fake.MakeClusterStackNameStub = stub
+}
+
+func (fake *FakeStackManager) MakeClusterStackNameReturns(result1 string) {
+	fake.makeClusterStackNameMutex.Lock()
+	defer fake.makeClusterStackNameMutex.Unlock()
+	fake.MakeClusterStackNameStub = nil
+	fake.makeClusterStackNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeStackManager) MakeClusterStackNameReturnsOnCall(i int, result1 string) {
+	fake.makeClusterStackNameMutex.Lock()
+	defer fake.makeClusterStackNameMutex.Unlock()
+	fake.MakeClusterStackNameStub = nil
+	if fake.makeClusterStackNameReturnsOnCall == nil {
+		fake.makeClusterStackNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.makeClusterStackNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewClusterCompatTask(arg1 context.Context) tasks.Task {
+	fake.newClusterCompatTaskMutex.Lock()
+	ret, specificReturn := fake.newClusterCompatTaskReturnsOnCall[len(fake.newClusterCompatTaskArgsForCall)]
+	fake.newClusterCompatTaskArgsForCall = append(fake.newClusterCompatTaskArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.NewClusterCompatTaskStub
+	fakeReturns := fake.newClusterCompatTaskReturns
+	fake.recordInvocation("NewClusterCompatTask", []interface{}{arg1})
+	fake.newClusterCompatTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) NewClusterCompatTaskCallCount() int {
+	fake.newClusterCompatTaskMutex.RLock()
+	defer fake.newClusterCompatTaskMutex.RUnlock()
+	return len(fake.newClusterCompatTaskArgsForCall)
+}
+
+func (fake *FakeStackManager) NewClusterCompatTaskCalls(stub func(context.Context) tasks.Task) {
+	fake.newClusterCompatTaskMutex.Lock()
+	defer fake.newClusterCompatTaskMutex.Unlock()
+	fake.NewClusterCompatTaskStub = stub
+}
+
+func (fake *FakeStackManager) NewClusterCompatTaskArgsForCall(i int) context.Context {
+	fake.newClusterCompatTaskMutex.RLock()
+	defer fake.newClusterCompatTaskMutex.RUnlock()
+	argsForCall := fake.newClusterCompatTaskArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) NewClusterCompatTaskReturns(result1 tasks.Task) {
+	fake.newClusterCompatTaskMutex.Lock()
+	defer fake.newClusterCompatTaskMutex.Unlock()
+	fake.NewClusterCompatTaskStub = nil
+	fake.newClusterCompatTaskReturns = struct {
+		result1 tasks.Task
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewClusterCompatTaskReturnsOnCall(i int, result1 tasks.Task) {
+	fake.newClusterCompatTaskMutex.Lock()
+	defer fake.newClusterCompatTaskMutex.Unlock()
+	fake.NewClusterCompatTaskStub = nil
+	if fake.newClusterCompatTaskReturnsOnCall == nil {
+		fake.newClusterCompatTaskReturnsOnCall = make(map[int]struct {
+			result1 tasks.Task
+		})
+	}
+	fake.newClusterCompatTaskReturnsOnCall[i] = struct {
+		result1 tasks.Task
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewManagedNodeGroupTask(arg1 context.Context, arg2 []*v1alpha5.ManagedNodeGroup, arg3 bool, arg4 vpc.Importer) *tasks.TaskTree {
+	var arg2Copy []*v1alpha5.ManagedNodeGroup
+	if arg2 != nil {
+		arg2Copy = make([]*v1alpha5.ManagedNodeGroup, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.newManagedNodeGroupTaskMutex.Lock()
+	ret, specificReturn := fake.newManagedNodeGroupTaskReturnsOnCall[len(fake.newManagedNodeGroupTaskArgsForCall)]
+	fake.newManagedNodeGroupTaskArgsForCall = append(fake.newManagedNodeGroupTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 []*v1alpha5.ManagedNodeGroup
+		arg3 bool
+		arg4 vpc.Importer
+	}{arg1, arg2Copy, arg3, arg4})
+	stub := fake.NewManagedNodeGroupTaskStub
+	fakeReturns := fake.newManagedNodeGroupTaskReturns
+	fake.recordInvocation("NewManagedNodeGroupTask", []interface{}{arg1, arg2Copy, arg3, arg4})
+	fake.newManagedNodeGroupTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) NewManagedNodeGroupTaskCallCount() int {
+	fake.newManagedNodeGroupTaskMutex.RLock()
+	defer fake.newManagedNodeGroupTaskMutex.RUnlock()
+	return len(fake.newManagedNodeGroupTaskArgsForCall)
+}
+
+func (fake *FakeStackManager) NewManagedNodeGroupTaskCalls(stub func(context.Context, []*v1alpha5.ManagedNodeGroup, bool, vpc.Importer) *tasks.TaskTree) {
+	fake.newManagedNodeGroupTaskMutex.Lock()
+	defer fake.newManagedNodeGroupTaskMutex.Unlock()
+	fake.NewManagedNodeGroupTaskStub = stub
+}
+
+func (fake *FakeStackManager) NewManagedNodeGroupTaskArgsForCall(i int) (context.Context, []*v1alpha5.ManagedNodeGroup, bool, vpc.Importer) {
+	fake.newManagedNodeGroupTaskMutex.RLock()
+	defer fake.newManagedNodeGroupTaskMutex.RUnlock()
+	argsForCall := fake.newManagedNodeGroupTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStackManager) NewManagedNodeGroupTaskReturns(result1 *tasks.TaskTree) {
+	fake.newManagedNodeGroupTaskMutex.Lock()
+	defer fake.newManagedNodeGroupTaskMutex.Unlock()
+	fake.NewManagedNodeGroupTaskStub = nil
+	fake.newManagedNodeGroupTaskReturns = struct {
+		result1 *tasks.TaskTree
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewManagedNodeGroupTaskReturnsOnCall(i int, result1 *tasks.TaskTree) {
+	fake.newManagedNodeGroupTaskMutex.Lock()
+	defer fake.newManagedNodeGroupTaskMutex.Unlock()
+	fake.NewManagedNodeGroupTaskStub = nil
+	if fake.newManagedNodeGroupTaskReturnsOnCall == nil {
+		fake.newManagedNodeGroupTaskReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+		})
+	}
+	fake.newManagedNodeGroupTaskReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteAddonIAM(arg1 context.Context, arg2 bool) (*tasks.TaskTree, error) {
+	fake.newTaskToDeleteAddonIAMMutex.Lock()
+	ret, specificReturn := fake.newTaskToDeleteAddonIAMReturnsOnCall[len(fake.newTaskToDeleteAddonIAMArgsForCall)]
+	fake.newTaskToDeleteAddonIAMArgsForCall = append(fake.newTaskToDeleteAddonIAMArgsForCall, struct {
+		arg1 context.Context
+		arg2 bool
+	}{arg1, arg2})
+	stub := fake.NewTaskToDeleteAddonIAMStub
+	fakeReturns := fake.newTaskToDeleteAddonIAMReturns
+	fake.recordInvocation("NewTaskToDeleteAddonIAM", []interface{}{arg1, arg2})
+	fake.newTaskToDeleteAddonIAMMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteAddonIAMCallCount() int {
+	fake.newTaskToDeleteAddonIAMMutex.RLock()
+	defer fake.newTaskToDeleteAddonIAMMutex.RUnlock()
+	return len(fake.newTaskToDeleteAddonIAMArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteAddonIAMCalls(stub func(context.Context, bool) (*tasks.TaskTree, error)) {
+	fake.newTaskToDeleteAddonIAMMutex.Lock()
+	defer fake.newTaskToDeleteAddonIAMMutex.Unlock()
+	fake.NewTaskToDeleteAddonIAMStub = stub
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteAddonIAMArgsForCall(i int) (context.Context, bool) {
+	fake.newTaskToDeleteAddonIAMMutex.RLock()
+	defer fake.newTaskToDeleteAddonIAMMutex.RUnlock()
+	argsForCall := fake.newTaskToDeleteAddonIAMArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteAddonIAMReturns(result1 *tasks.TaskTree, result2 error) {
+	fake.newTaskToDeleteAddonIAMMutex.Lock()
+	defer fake.newTaskToDeleteAddonIAMMutex.Unlock()
+	fake.NewTaskToDeleteAddonIAMStub = nil
+	fake.newTaskToDeleteAddonIAMReturns = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteAddonIAMReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
+	fake.newTaskToDeleteAddonIAMMutex.Lock()
+	defer fake.newTaskToDeleteAddonIAMMutex.Unlock()
+	fake.NewTaskToDeleteAddonIAMStub = nil
+	if fake.newTaskToDeleteAddonIAMReturnsOnCall == nil {
+		fake.newTaskToDeleteAddonIAMReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+			result2 error
+		})
+	}
+	fake.newTaskToDeleteAddonIAMReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroup(arg1 string, arg2 string, arg3 eksiface.EKSAPI, arg4 *manager.DeleteWaitCondition) tasks.Task {
+	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
+	ret, specificReturn := fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall[len(fake.newTaskToDeleteUnownedNodeGroupArgsForCall)]
+	fake.newTaskToDeleteUnownedNodeGroupArgsForCall = append(fake.newTaskToDeleteUnownedNodeGroupArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 eksiface.EKSAPI
+		arg4 *manager.DeleteWaitCondition
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.NewTaskToDeleteUnownedNodeGroupStub
+	fakeReturns := fake.newTaskToDeleteUnownedNodeGroupReturns
+	fake.recordInvocation("NewTaskToDeleteUnownedNodeGroup", []interface{}{arg1, arg2, arg3, arg4})
+	fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupCallCount() int {
+	fake.newTaskToDeleteUnownedNodeGroupMutex.RLock()
+	defer fake.newTaskToDeleteUnownedNodeGroupMutex.RUnlock()
+	return len(fake.newTaskToDeleteUnownedNodeGroupArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupCalls(stub func(string, string, eksiface.EKSAPI, *manager.DeleteWaitCondition) tasks.Task) {
+	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
+	defer fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
+	fake.NewTaskToDeleteUnownedNodeGroupStub = stub
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupArgsForCall(i int) (string, string, eksiface.EKSAPI, *manager.DeleteWaitCondition) {
+	fake.newTaskToDeleteUnownedNodeGroupMutex.RLock()
+	defer fake.newTaskToDeleteUnownedNodeGroupMutex.RUnlock()
+	argsForCall := fake.newTaskToDeleteUnownedNodeGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupReturns(result1 tasks.Task) {
+	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
+	defer fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
+	fake.NewTaskToDeleteUnownedNodeGroupStub = nil
+	fake.newTaskToDeleteUnownedNodeGroupReturns = struct {
+		result1 tasks.Task
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupReturnsOnCall(i int, result1 tasks.Task) {
+	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
+	defer fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
+	fake.NewTaskToDeleteUnownedNodeGroupStub = nil
+	if fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall == nil {
+		fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall = make(map[int]struct {
+			result1 tasks.Task
+		})
+	}
+	fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall[i] = struct {
+		result1 tasks.Task
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroups(arg1 context.Context, arg2 []*v1alpha5.NodeGroup, arg3 []*v1alpha5.ManagedNodeGroup, arg4 ...tasks.Task) *tasks.TaskTree {
+	var arg2Copy []*v1alpha5.NodeGroup
+	if arg2 != nil {
+		arg2Copy = make([]*v1alpha5.NodeGroup, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	var arg3Copy []*v1alpha5.ManagedNodeGroup
+	if arg3 != nil {
+		arg3Copy = make([]*v1alpha5.ManagedNodeGroup, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
+	ret, specificReturn := fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall[len(fake.newTasksToCreateClusterWithNodeGroupsArgsForCall)]
+	fake.newTasksToCreateClusterWithNodeGroupsArgsForCall = append(fake.newTasksToCreateClusterWithNodeGroupsArgsForCall, struct {
+		arg1 context.Context
+		arg2 []*v1alpha5.NodeGroup
+		arg3 []*v1alpha5.ManagedNodeGroup
+		arg4 []tasks.Task
+	}{arg1, arg2Copy, arg3Copy, arg4})
+	stub := fake.NewTasksToCreateClusterWithNodeGroupsStub
+	fakeReturns := fake.newTasksToCreateClusterWithNodeGroupsReturns
+	fake.recordInvocation("NewTasksToCreateClusterWithNodeGroups", []interface{}{arg1, arg2Copy, arg3Copy, arg4})
+	fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsCallCount() int {
+	fake.newTasksToCreateClusterWithNodeGroupsMutex.RLock()
+	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.RUnlock()
+	return len(fake.newTasksToCreateClusterWithNodeGroupsArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsCalls(stub func(context.Context, []*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, ...tasks.Task) *tasks.TaskTree) {
+	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
+	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
+	fake.NewTasksToCreateClusterWithNodeGroupsStub = stub
+}
+
+func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsArgsForCall(i int) (context.Context, []*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, []tasks.Task) {
+	fake.newTasksToCreateClusterWithNodeGroupsMutex.RLock()
+	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.RUnlock()
+	argsForCall := fake.newTasksToCreateClusterWithNodeGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsReturns(result1 *tasks.TaskTree) {
+	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
+	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
+	fake.NewTasksToCreateClusterWithNodeGroupsStub = nil
+	fake.newTasksToCreateClusterWithNodeGroupsReturns = struct {
+		result1 *tasks.TaskTree
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsReturnsOnCall(i int, result1 *tasks.TaskTree) {
+	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
+	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
+	fake.NewTasksToCreateClusterWithNodeGroupsStub = nil
+	if fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall == nil {
+		fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+		})
+	}
+	fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccounts(arg1 []*v1alpha5.ClusterIAMServiceAccount, arg2 *iamoidc.OpenIDConnectManager, arg3 kubernetes.ClientSetGetter) *tasks.TaskTree {
+	var arg1Copy []*v1alpha5.ClusterIAMServiceAccount
+	if arg1 != nil {
+		arg1Copy = make([]*v1alpha5.ClusterIAMServiceAccount, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
+	ret, specificReturn := fake.newTasksToCreateIAMServiceAccountsReturnsOnCall[len(fake.newTasksToCreateIAMServiceAccountsArgsForCall)]
+	fake.newTasksToCreateIAMServiceAccountsArgsForCall = append(fake.newTasksToCreateIAMServiceAccountsArgsForCall, struct {
+		arg1 []*v1alpha5.ClusterIAMServiceAccount
+		arg2 *iamoidc.OpenIDConnectManager
+		arg3 kubernetes.ClientSetGetter
+	}{arg1Copy, arg2, arg3})
+	stub := fake.NewTasksToCreateIAMServiceAccountsStub
+	fakeReturns := fake.newTasksToCreateIAMServiceAccountsReturns
+	fake.recordInvocation("NewTasksToCreateIAMServiceAccounts", []interface{}{arg1Copy, arg2, arg3})
+	fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsCallCount() int {
+	fake.newTasksToCreateIAMServiceAccountsMutex.RLock()
+	defer fake.newTasksToCreateIAMServiceAccountsMutex.RUnlock()
+	return len(fake.newTasksToCreateIAMServiceAccountsArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsCalls(stub func([]*v1alpha5.ClusterIAMServiceAccount, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) *tasks.TaskTree) {
+	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToCreateIAMServiceAccountsStub = stub
+}
+
+func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsArgsForCall(i int) ([]*v1alpha5.ClusterIAMServiceAccount, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) {
+	fake.newTasksToCreateIAMServiceAccountsMutex.RLock()
+	defer fake.newTasksToCreateIAMServiceAccountsMutex.RUnlock()
+	argsForCall := fake.newTasksToCreateIAMServiceAccountsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsReturns(result1 *tasks.TaskTree) {
+	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToCreateIAMServiceAccountsStub = nil
+	fake.newTasksToCreateIAMServiceAccountsReturns = struct {
+		result1 *tasks.TaskTree
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsReturnsOnCall(i int, result1 *tasks.TaskTree) {
+	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToCreateIAMServiceAccountsStub = nil
+	if fake.newTasksToCreateIAMServiceAccountsReturnsOnCall == nil {
+		fake.newTasksToCreateIAMServiceAccountsReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+		})
+	}
+	fake.newTasksToCreateIAMServiceAccountsReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroups(arg1 context.Context, arg2 *types.Stack, arg3 []manager.NodeGroupStack, arg4 bool, arg5 *iamoidc.OpenIDConnectManager, arg6 kubernetes.ClientSetGetter, arg7 bool, arg8 func(chan error, string) error) (*tasks.TaskTree, error) {
+	var arg3Copy []manager.NodeGroupStack
+	if arg3 != nil {
+		arg3Copy = make([]manager.NodeGroupStack, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
+	ret, specificReturn := fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall[len(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall)]
+	fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall = append(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *types.Stack
+		arg3 []manager.NodeGroupStack
+		arg4 bool
+		arg5 *iamoidc.OpenIDConnectManager
+		arg6 kubernetes.ClientSetGetter
+		arg7 bool
+		arg8 func(chan error, string) error
+	}{arg1, arg2, arg3Copy, arg4, arg5, arg6, arg7, arg8})
+	stub := fake.NewTasksToDeleteClusterWithNodeGroupsStub
+	fakeReturns := fake.newTasksToDeleteClusterWithNodeGroupsReturns
+	fake.recordInvocation("NewTasksToDeleteClusterWithNodeGroups", []interface{}{arg1, arg2, arg3Copy, arg4, arg5, arg6, arg7, arg8})
+	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsCallCount() int {
+	fake.newTasksToDeleteClusterWithNodeGroupsMutex.RLock()
+	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.RUnlock()
+	return len(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsCalls(stub func(context.Context, *types.Stack, []manager.NodeGroupStack, bool, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter, bool, func(chan error, string) error) (*tasks.TaskTree, error)) {
+	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
+	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
+	fake.NewTasksToDeleteClusterWithNodeGroupsStub = stub
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsArgsForCall(i int) (context.Context, *types.Stack, []manager.NodeGroupStack, bool, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter, bool, func(chan error, string) error) {
+	fake.newTasksToDeleteClusterWithNodeGroupsMutex.RLock()
+	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.RUnlock()
+	argsForCall := fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsReturns(result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
+	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
+	fake.NewTasksToDeleteClusterWithNodeGroupsStub = nil
+	fake.newTasksToDeleteClusterWithNodeGroupsReturns = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
+	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
+	fake.NewTasksToDeleteClusterWithNodeGroupsStub = nil
+	if fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall == nil {
+		fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+			result2 error
+		})
+	}
+	fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccounts(arg1 context.Context, arg2 []string, arg3 kubernetes.ClientSetGetter, arg4 bool) (*tasks.TaskTree, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
+	ret, specificReturn := fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall[len(fake.newTasksToDeleteIAMServiceAccountsArgsForCall)]
+	fake.newTasksToDeleteIAMServiceAccountsArgsForCall = append(fake.newTasksToDeleteIAMServiceAccountsArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 kubernetes.ClientSetGetter
+		arg4 bool
+	}{arg1, arg2Copy, arg3, arg4})
+	stub := fake.NewTasksToDeleteIAMServiceAccountsStub
+	fakeReturns := fake.newTasksToDeleteIAMServiceAccountsReturns
+	fake.recordInvocation("NewTasksToDeleteIAMServiceAccounts", []interface{}{arg1, arg2Copy, arg3, arg4})
+	fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsCallCount() int {
+	fake.newTasksToDeleteIAMServiceAccountsMutex.RLock()
+	defer fake.newTasksToDeleteIAMServiceAccountsMutex.RUnlock()
+	return len(fake.newTasksToDeleteIAMServiceAccountsArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsCalls(stub func(context.Context, []string, kubernetes.ClientSetGetter, bool) (*tasks.TaskTree, error)) {
+	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToDeleteIAMServiceAccountsStub = stub
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsArgsForCall(i int) (context.Context, []string, kubernetes.ClientSetGetter, bool) {
+	fake.newTasksToDeleteIAMServiceAccountsMutex.RLock()
+	defer fake.newTasksToDeleteIAMServiceAccountsMutex.RUnlock()
+	argsForCall := fake.newTasksToDeleteIAMServiceAccountsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsReturns(result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToDeleteIAMServiceAccountsStub = nil
+	fake.newTasksToDeleteIAMServiceAccountsReturns = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToDeleteIAMServiceAccountsStub = nil
+	if fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall == nil {
+		fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+			result2 error
+		})
+	}
+	fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteNodeGroups(arg1 context.Context, arg2 []manager.NodeGroupStack, arg3 func(_ string) bool, arg4 bool, arg5 func(chan error, string) error) (*tasks.TaskTree, error) {
+	var arg2Copy []manager.NodeGroupStack
+	if arg2 != nil {
+		arg2Copy = make([]manager.NodeGroupStack, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.newTasksToDeleteNodeGroupsMutex.Lock()
+	ret, specificReturn := fake.newTasksToDeleteNodeGroupsReturnsOnCall[len(fake.newTasksToDeleteNodeGroupsArgsForCall)]
+	fake.newTasksToDeleteNodeGroupsArgsForCall = append(fake.newTasksToDeleteNodeGroupsArgsForCall, struct {
+		arg1 context.Context
+		arg2 []manager.NodeGroupStack
+		arg3 func(_ string) bool
+		arg4 bool
+		arg5 func(chan error, string) error
+	}{arg1, arg2Copy, arg3, arg4, arg5})
+	stub := fake.NewTasksToDeleteNodeGroupsStub
+	fakeReturns := fake.newTasksToDeleteNodeGroupsReturns
+	fake.recordInvocation("NewTasksToDeleteNodeGroups", []interface{}{arg1, arg2Copy, arg3, arg4, arg5})
+	fake.newTasksToDeleteNodeGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsCallCount() int {
+	fake.newTasksToDeleteNodeGroupsMutex.RLock()
+	defer fake.newTasksToDeleteNodeGroupsMutex.RUnlock()
+	return len(fake.newTasksToDeleteNodeGroupsArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsCalls(stub func(context.Context, []manager.NodeGroupStack, func(_ string) bool, bool, func(chan error, string) error) (*tasks.TaskTree, error)) {
+	fake.newTasksToDeleteNodeGroupsMutex.Lock()
+	defer fake.newTasksToDeleteNodeGroupsMutex.Unlock()
+	fake.NewTasksToDeleteNodeGroupsStub = stub
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsArgsForCall(i int) (context.Context, []manager.NodeGroupStack, func(_ string) bool, bool, func(chan error, string) error) {
+	fake.newTasksToDeleteNodeGroupsMutex.RLock()
+	defer fake.newTasksToDeleteNodeGroupsMutex.RUnlock()
+	argsForCall := fake.newTasksToDeleteNodeGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsReturns(result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteNodeGroupsMutex.Lock()
+	defer fake.newTasksToDeleteNodeGroupsMutex.Unlock()
+	fake.NewTasksToDeleteNodeGroupsStub = nil
+	fake.newTasksToDeleteNodeGroupsReturns = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteNodeGroupsMutex.Lock()
+	defer fake.newTasksToDeleteNodeGroupsMutex.Unlock()
+	fake.NewTasksToDeleteNodeGroupsStub = nil
+	if fake.newTasksToDeleteNodeGroupsReturnsOnCall == nil {
+		fake.newTasksToDeleteNodeGroupsReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+			result2 error
+		})
+	}
+	fake.newTasksToDeleteNodeGroupsReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(arg1 context.Context, arg2 *iamoidc.OpenIDConnectManager, arg3 kubernetes.ClientSetGetter) (*tasks.TaskTree, error) {
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
+	ret, specificReturn := fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall[len(fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall)]
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall = append(fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *iamoidc.OpenIDConnectManager
+		arg3 kubernetes.ClientSetGetter
+	}{arg1, arg2, arg3})
+	stub := fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub
+	fakeReturns := fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturns
+	fake.recordInvocation("NewTasksToDeleteOIDCProviderWithIAMServiceAccounts", []interface{}{arg1, arg2, arg3})
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsCallCount() int {
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RLock()
+	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RUnlock()
+	return len(fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsCalls(stub func(context.Context, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) (*tasks.TaskTree, error)) {
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub = stub
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall(i int) (context.Context, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) {
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RLock()
+	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RUnlock()
+	argsForCall := fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsReturns(result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub = nil
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturns = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub = nil
+	if fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall == nil {
+		fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+			result2 error
+		})
+	}
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewUnmanagedNodeGroupTask(arg1 context.Context, arg2 []*v1alpha5.NodeGroup, arg3 bool, arg4 vpc.Importer) *tasks.TaskTree {
+	var arg2Copy []*v1alpha5.NodeGroup
+	if arg2 != nil {
+		arg2Copy = make([]*v1alpha5.NodeGroup, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.newUnmanagedNodeGroupTaskMutex.Lock()
+	ret, specificReturn := fake.newUnmanagedNodeGroupTaskReturnsOnCall[len(fake.newUnmanagedNodeGroupTaskArgsForCall)]
+	fake.newUnmanagedNodeGroupTaskArgsForCall = append(fake.newUnmanagedNodeGroupTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 []*v1alpha5.NodeGroup
+		arg3 bool
+		arg4 vpc.Importer
+	}{arg1, arg2Copy, arg3, arg4})
+	stub := fake.NewUnmanagedNodeGroupTaskStub
+	fakeReturns := fake.newUnmanagedNodeGroupTaskReturns
+	fake.recordInvocation("NewUnmanagedNodeGroupTask", []interface{}{arg1, arg2Copy, arg3, arg4})
+	fake.newUnmanagedNodeGroupTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskCallCount() int {
+	fake.newUnmanagedNodeGroupTaskMutex.RLock()
+	defer fake.newUnmanagedNodeGroupTaskMutex.RUnlock()
+	return len(fake.newUnmanagedNodeGroupTaskArgsForCall)
+}
+
+func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskCalls(stub func(context.Context, []*v1alpha5.NodeGroup, bool, vpc.Importer) *tasks.TaskTree) {
+	fake.newUnmanagedNodeGroupTaskMutex.Lock()
+	defer fake.newUnmanagedNodeGroupTaskMutex.Unlock()
+	fake.NewUnmanagedNodeGroupTaskStub = stub
+}
+
+func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskArgsForCall(i int) (context.Context, []*v1alpha5.NodeGroup, bool, vpc.Importer) {
+	fake.newUnmanagedNodeGroupTaskMutex.RLock()
+	defer fake.newUnmanagedNodeGroupTaskMutex.RUnlock()
+	argsForCall := fake.newUnmanagedNodeGroupTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskReturns(result1 *tasks.TaskTree) {
+	fake.newUnmanagedNodeGroupTaskMutex.Lock()
+	defer fake.newUnmanagedNodeGroupTaskMutex.Unlock()
+	fake.NewUnmanagedNodeGroupTaskStub = nil
+	fake.newUnmanagedNodeGroupTaskReturns = struct {
+		result1 *tasks.TaskTree
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskReturnsOnCall(i int, result1 *tasks.TaskTree) {
+	fake.newUnmanagedNodeGroupTaskMutex.Lock()
+	defer fake.newUnmanagedNodeGroupTaskMutex.Unlock()
+	fake.NewUnmanagedNodeGroupTaskStub = nil
+	if fake.newUnmanagedNodeGroupTaskReturnsOnCall == nil {
+		fake.newUnmanagedNodeGroupTaskReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+		})
+	}
+	fake.newUnmanagedNodeGroupTaskReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+	}{result1}
+}
+
+func (fake *FakeStackManager) NodeGroupStacksJSON(arg1 context.Context) ([]byte, error) {
+	fake.nodeGroupStacksJSONMutex.Lock()
+	ret, specificReturn := fake.nodeGroupStacksJSONReturnsOnCall[len(fake.nodeGroupStacksJSONArgsForCall)]
+	fake.nodeGroupStacksJSONArgsForCall = append(fake.nodeGroupStacksJSONArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.NodeGroupStacksJSONStub
+	fakeReturns := fake.nodeGroupStacksJSONReturns
+	fake.recordInvocation("NodeGroupStacksJSON", []interface{}{arg1})
+	fake.nodeGroupStacksJSONMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) NodeGroupStacksJSONCallCount() int {
+	fake.nodeGroupStacksJSONMutex.RLock()
+	defer fake.nodeGroupStacksJSONMutex.RUnlock()
+	return len(fake.nodeGroupStacksJSONArgsForCall)
+}
+
+func (fake *FakeStackManager) NodeGroupStacksJSONCalls(stub func(context.Context) ([]byte, error)) {
+	fake.nodeGroupStacksJSONMutex.Lock()
+	defer fake.nodeGroupStacksJSONMutex.Unlock()
+	fake.NodeGroupStacksJSONStub = stub
+}
+
+func (fake *FakeStackManager) NodeGroupStacksJSONArgsForCall(i int) context.Context {
+	fake.nodeGroupStacksJSONMutex.RLock()
+	defer fake.nodeGroupStacksJSONMutex.RUnlock()
+	argsForCall := fake.nodeGroupStacksJSONArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) NodeGroupStacksJSONReturns(result1 []byte, result2 error) {
+	fake.nodeGroupStacksJSONMutex.Lock()
+	defer fake.nodeGroupStacksJSONMutex.Unlock()
+	fake.NodeGroupStacksJSONStub = nil
+	fake.nodeGroupStacksJSONReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NodeGroupStacksJSONReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.nodeGroupStacksJSONMutex.Lock()
+	defer fake.nodeGroupStacksJSONMutex.Unlock()
+	fake.NodeGroupStacksJSONStub = nil
+	if fake.nodeGroupStacksJSONReturnsOnCall == nil {
+		fake.nodeGroupStacksJSONReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.nodeGroupStacksJSONReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NodeGroupTemplateDrift(arg1 context.Context, arg2 *v1alpha5.NodeGroup) (bool, string, error) {
+	fake.nodeGroupTemplateDriftMutex.Lock()
+	ret, specificReturn := fake.nodeGroupTemplateDriftReturnsOnCall[len(fake.nodeGroupTemplateDriftArgsForCall)]
+	fake.nodeGroupTemplateDriftArgsForCall = append(fake.nodeGroupTemplateDriftArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha5.NodeGroup
+	}{arg1, arg2})
+	stub := fake.NodeGroupTemplateDriftStub
+	fakeReturns := fake.nodeGroupTemplateDriftReturns
+	fake.recordInvocation("NodeGroupTemplateDrift", []interface{}{arg1, arg2})
+	fake.nodeGroupTemplateDriftMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStackManager) NodeGroupTemplateDriftCallCount() int {
+	fake.nodeGroupTemplateDriftMutex.RLock()
+	defer fake.nodeGroupTemplateDriftMutex.RUnlock()
+	return len(fake.nodeGroupTemplateDriftArgsForCall)
+}
+
+func (fake *FakeStackManager) NodeGroupTemplateDriftCalls(stub func(context.Context, *v1alpha5.NodeGroup) (bool, string, error)) {
+	fake.nodeGroupTemplateDriftMutex.Lock()
+	defer fake.nodeGroupTemplateDriftMutex.Unlock()
+	fake.NodeGroupTemplateDriftStub = stub
+}
+
+func (fake *FakeStackManager) NodeGroupTemplateDriftArgsForCall(i int) (context.Context, *v1alpha5.NodeGroup) {
+	fake.nodeGroupTemplateDriftMutex.RLock()
+	defer fake.nodeGroupTemplateDriftMutex.RUnlock()
+	argsForCall := fake.nodeGroupTemplateDriftArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) NodeGroupTemplateDriftReturns(result1 bool, result2 string, result3 error) {
+	fake.nodeGroupTemplateDriftMutex.Lock()
+	defer fake.nodeGroupTemplateDriftMutex.Unlock()
+	fake.NodeGroupTemplateDriftStub = nil
+	fake.nodeGroupTemplateDriftReturns = struct {
+		result1 bool
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStackManager) NodeGroupTemplateDriftReturnsOnCall(i int, result1 bool, result2 string, result3 error) {
+	fake.nodeGroupTemplateDriftMutex.Lock()
+	defer fake.nodeGroupTemplateDriftMutex.Unlock()
+	fake.NodeGroupTemplateDriftStub = nil
+	if fake.nodeGroupTemplateDriftReturnsOnCall == nil {
+		fake.nodeGroupTemplateDriftReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 string
+			result3 error
+		})
+	}
+	fake.nodeGroupTemplateDriftReturnsOnCall[i] = struct {
+		result1 bool
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStackManager) PropagateManagedNodeGroupTagsToSecurityGroups(arg1 context.Context, arg2 string, arg3 map[string]string) error {
+	fake.propagateManagedNodeGroupTagsToSecurityGroupsMutex.Lock()
+	ret, specificReturn := fake.propagateManagedNodeGroupTagsToSecurityGroupsReturnsOnCall[len(fake.propagateManagedNodeGroupTagsToSecurityGroupsArgsForCall)]
+	fake.propagateManagedNodeGroupTagsToSecurityGroupsArgsForCall = append(fake.propagateManagedNodeGroupTagsToSecurityGroupsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]string
+	}{arg1, arg2, arg3})
+	stub := fake.PropagateManagedNodeGroupTagsToSecurityGroupsStub
+	fakeReturns := fake.propagateManagedNodeGroupTagsToSecurityGroupsReturns
+	fake.recordInvocation("PropagateManagedNodeGroupTagsToSecurityGroups", []interface{}{arg1, arg2, arg3})
+	fake.propagateManagedNodeGroupTagsToSecurityGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) PropagateManagedNodeGroupTagsToSecurityGroupsCallCount() int {
+	fake.propagateManagedNodeGroupTagsToSecurityGroupsMutex.RLock()
+	defer fake.propagateManagedNodeGroupTagsToSecurityGroupsMutex.RUnlock()
+	return len(fake.propagateManagedNodeGroupTagsToSecurityGroupsArgsForCall)
+}
+
+func (fake *FakeStackManager) PropagateManagedNodeGroupTagsToSecurityGroupsCalls(stub func(context.Context, string, map[string]string) error) {
+	fake.propagateManagedNodeGroupTagsToSecurityGroupsMutex.Lock()
+	defer fake.propagateManagedNodeGroupTagsToSecurityGroupsMutex.Unlock()
+	fake.PropagateManagedNodeGroupTagsToSecurityGroupsStub = stub
+}
+
+func (fake *FakeStackManager) PropagateManagedNodeGroupTagsToSecurityGroupsArgsForCall(i int) (context.Context, string, map[string]string) {
+	fake.propagateManagedNodeGroupTagsToSecurityGroupsMutex.RLock()
+	defer fake.propagateManagedNodeGroupTagsToSecurityGroupsMutex.RUnlock()
+	argsForCall := fake.propagateManagedNodeGroupTagsToSecurityGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) PropagateManagedNodeGroupTagsToSecurityGroupsReturns(result1 error) {
+	fake.propagateManagedNodeGroupTagsToSecurityGroupsMutex.Lock()
+	defer fake.propagateManagedNodeGroupTagsToSecurityGroupsMutex.Unlock()
+	fake.PropagateManagedNodeGroupTagsToSecurityGroupsStub = nil
+	fake.propagateManagedNodeGroupTagsToSecurityGroupsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) PropagateManagedNodeGroupTagsToSecurityGroupsReturnsOnCall(i int, result1 error) {
+	fake.propagateManagedNodeGroupTagsToSecurityGroupsMutex.Lock()
+	defer fake.propagateManagedNodeGroupTagsToSecurityGroupsMutex.Unlock()
+	fake.PropagateManagedNodeGroupTagsToSecurityGroupsStub = nil
+	if fake.propagateManagedNodeGroupTagsToSecurityGroupsReturnsOnCall == nil {
+		fake.propagateManagedNodeGroupTagsToSecurityGroupsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.propagateManagedNodeGroupTagsToSecurityGroupsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) PropagateManagedNodeGroupTagsToVolumes(arg1 context.Context, arg2 string, arg3 map[string]string) error {
+	fake.propagateManagedNodeGroupTagsToVolumesMutex.Lock()
+	ret, specificReturn := fake.propagateManagedNodeGroupTagsToVolumesReturnsOnCall[len(fake.propagateManagedNodeGroupTagsToVolumesArgsForCall)]
+	fake.propagateManagedNodeGroupTagsToVolumesArgsForCall = append(fake.propagateManagedNodeGroupTagsToVolumesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]string
+	}{arg1, arg2, arg3})
+	stub := fake.PropagateManagedNodeGroupTagsToVolumesStub
+	fakeReturns := fake.propagateManagedNodeGroupTagsToVolumesReturns
+	fake.recordInvocation("PropagateManagedNodeGroupTagsToVolumes", []interface{}{arg1, arg2, arg3})
+	fake.propagateManagedNodeGroupTagsToVolumesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) PropagateManagedNodeGroupTagsToVolumesCallCount() int {
+	fake.propagateManagedNodeGroupTagsToVolumesMutex.RLock()
+	defer fake.propagateManagedNodeGroupTagsToVolumesMutex.RUnlock()
+	return len(fake.propagateManagedNodeGroupTagsToVolumesArgsForCall)
+}
+
+func (fake *FakeStackManager) PropagateManagedNodeGroupTagsToVolumesCalls(stub func(context.Context, string, map[string]string) error) {
+	fake.propagateManagedNodeGroupTagsToVolumesMutex.Lock()
+	defer fake.propagateManagedNodeGroupTagsToVolumesMutex.Unlock()
+	fake.PropagateManagedNodeGroupTagsToVolumesStub = stub
+}
+
+func (fake *FakeStackManager) PropagateManagedNodeGroupTagsToVolumesArgsForCall(i int) (context.Context, string, map[string]string) {
+	fake.propagateManagedNodeGroupTagsToVolumesMutex.RLock()
+	defer fake.propagateManagedNodeGroupTagsToVolumesMutex.RUnlock()
+	argsForCall := fake.propagateManagedNodeGroupTagsToVolumesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) PropagateManagedNodeGroupTagsToVolumesReturns(result1 error) {
+	fake.propagateManagedNodeGroupTagsToVolumesMutex.Lock()
+	defer fake.propagateManagedNodeGroupTagsToVolumesMutex.Unlock()
+	fake.PropagateManagedNodeGroupTagsToVolumesStub = nil
+	fake.propagateManagedNodeGroupTagsToVolumesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) PropagateManagedNodeGroupTagsToVolumesReturnsOnCall(i int, result1 error) {
+	fake.propagateManagedNodeGroupTagsToVolumesMutex.Lock()
+	defer fake.propagateManagedNodeGroupTagsToVolumesMutex.Unlock()
+	fake.PropagateManagedNodeGroupTagsToVolumesStub = nil
+	if fake.propagateManagedNodeGroupTagsToVolumesReturnsOnCall == nil {
+		fake.propagateManagedNodeGroupTagsToVolumesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.propagateManagedNodeGroupTagsToVolumesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) ReconcileManagedNodeGroupTagsToASG(arg1 context.Context, arg2 string, arg3 map[string]string) error {
+	fake.reconcileManagedNodeGroupTagsToASGMutex.Lock()
+	ret, specificReturn := fake.reconcileManagedNodeGroupTagsToASGReturnsOnCall[len(fake.reconcileManagedNodeGroupTagsToASGArgsForCall)]
+	fake.reconcileManagedNodeGroupTagsToASGArgsForCall = append(fake.reconcileManagedNodeGroupTagsToASGArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]string
+	}{arg1, arg2, arg3})
+	stub := fake.ReconcileManagedNodeGroupTagsToASGStub
+	fakeReturns := fake.reconcileManagedNodeGroupTagsToASGReturns
+	fake.recordInvocation("ReconcileManagedNodeGroupTagsToASG", []interface{}{arg1, arg2, arg3})
+	fake.reconcileManagedNodeGroupTagsToASGMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) ReconcileManagedNodeGroupTagsToASGCallCount() int {
+	fake.reconcileManagedNodeGroupTagsToASGMutex.RLock()
+	defer fake.reconcileManagedNodeGroupTagsToASGMutex.RUnlock()
+	return len(fake.reconcileManagedNodeGroupTagsToASGArgsForCall)
+}
+
+func (fake *FakeStackManager) ReconcileManagedNodeGroupTagsToASGCalls(stub func(context.Context, string, map[string]string) error) {
+	fake.reconcileManagedNodeGroupTagsToASGMutex.Lock()
+	defer fake.reconcileManagedNodeGroupTagsToASGMutex.Unlock()
+	fake.ReconcileManagedNodeGroupTagsToASGStub = stub
+}
+
+func (fake *FakeStackManager) ReconcileManagedNodeGroupTagsToASGArgsForCall(i int) (context.Context, string, map[string]string) {
+	fake.reconcileManagedNodeGroupTagsToASGMutex.RLock()
+	defer fake.reconcileManagedNodeGroupTagsToASGMutex.RUnlock()
+	argsForCall := fake.reconcileManagedNodeGroupTagsToASGArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) ReconcileManagedNodeGroupTagsToASGReturns(result1 error) {
+	fake.reconcileManagedNodeGroupTagsToASGMutex.Lock()
+	defer fake.reconcileManagedNodeGroupTagsToASGMutex.Unlock()
+	fake.ReconcileManagedNodeGroupTagsToASGStub = nil
+	fake.reconcileManagedNodeGroupTagsToASGReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) ReconcileManagedNodeGroupTagsToASGReturnsOnCall(i int, result1 error) {
+	fake.reconcileManagedNodeGroupTagsToASGMutex.Lock()
+	defer fake.reconcileManagedNodeGroupTagsToASGMutex.Unlock()
+	fake.ReconcileManagedNodeGroupTagsToASGStub = nil
+	if fake.reconcileManagedNodeGroupTagsToASGReturnsOnCall == nil {
+		fake.reconcileManagedNodeGroupTagsToASGReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.reconcileManagedNodeGroupTagsToASGReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) RefreshClusterStackCache() {
+	fake.refreshClusterStackCacheMutex.Lock()
+	fake.refreshClusterStackCacheArgsForCall = append(fake.refreshClusterStackCacheArgsForCall, struct {
+	}{})
+	stub := fake.RefreshClusterStackCacheStub
+	fake.recordInvocation("RefreshClusterStackCache", []interface{}{})
+	fake.refreshClusterStackCacheMutex.Unlock()
+	if stub != nil {
+		fake.RefreshClusterStackCacheStub()
+	}
+}
+
+func (fake *FakeStackManager) RefreshClusterStackCacheCallCount() int {
+	fake.refreshClusterStackCacheMutex.RLock()
+	defer fake.refreshClusterStackCacheMutex.RUnlock()
+	return len(fake.refreshClusterStackCacheArgsForCall)
+}
+
+func (fake *FakeStackManager) RefreshClusterStackCacheCalls(stub func()) {
+	fake.refreshClusterStackCacheMutex.Lock()
+	defer fake.refreshClusterStackCacheMutex.Unlock()
+	fake.RefreshClusterStackCacheStub = stub
+}
+
+func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARN(arg1 context.Context) error {
+	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
+	ret, specificReturn := fake.refreshFargatePodExecutionRoleARNReturnsOnCall[len(fake.refreshFargatePodExecutionRoleARNArgsForCall)]
+	fake.refreshFargatePodExecutionRoleARNArgsForCall = append(fake.refreshFargatePodExecutionRoleARNArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.RefreshFargatePodExecutionRoleARNStub
+	fakeReturns := fake.refreshFargatePodExecutionRoleARNReturns
+	fake.recordInvocation("RefreshFargatePodExecutionRoleARN", []interface{}{arg1})
+	fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNCallCount() int {
+	fake.refreshFargatePodExecutionRoleARNMutex.RLock()
+	defer fake.refreshFargatePodExecutionRoleARNMutex.RUnlock()
+	return len(fake.refreshFargatePodExecutionRoleARNArgsForCall)
+}
+
+func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNCalls(stub func(context.Context) error) {
+	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
+	defer fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
+	fake.RefreshFargatePodExecutionRoleARNStub = stub
+}
+
+func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNArgsForCall(i int) context.Context {
+	fake.refreshFargatePodExecutionRoleARNMutex.RLock()
+	defer fake.refreshFargatePodExecutionRoleARNMutex.RUnlock()
+	argsForCall := fake.refreshFargatePodExecutionRoleARNArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNReturns(result1 error) {
+	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
+	defer fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
+	fake.RefreshFargatePodExecutionRoleARNStub = nil
+	fake.refreshFargatePodExecutionRoleARNReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNReturnsOnCall(i int, result1 error) {
+	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
+	defer fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
+	fake.RefreshFargatePodExecutionRoleARNStub = nil
+	if fake.refreshFargatePodExecutionRoleARNReturnsOnCall == nil {
+		fake.refreshFargatePodExecutionRoleARNReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.refreshFargatePodExecutionRoleARNReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) RollbackStack(arg1 context.Context, arg2 string, arg3 ...string) error {
+	fake.rollbackStackMutex.Lock()
+	ret, specificReturn := fake.rollbackStackReturnsOnCall[len(fake.rollbackStackArgsForCall)]
+	fake.rollbackStackArgsForCall = append(fake.rollbackStackArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3})
+	stub := fake.RollbackStackStub
+	fakeReturns := fake.rollbackStackReturns
+	fake.recordInvocation("RollbackStack", []interface{}{arg1, arg2, arg3})
+	fake.rollbackStackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsCallCount() int {
-	fake.newTasksToDeleteNodeGroupsMutex.RLock()
-	defer fake.newTasksToDeleteNodeGroupsMutex.RUnlock()
-	return len(fake.newTasksToDeleteNodeGroupsArgsForCall)
+func (fake *FakeStackManager) RollbackStackCallCount() int {
+	fake.rollbackStackMutex.RLock()
+	defer fake.rollbackStackMutex.RUnlock()
+	return len(fake.rollbackStackArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsCalls(stub func([]manager.NodeGroupStack, func(_ string) bool, bool, func(chan error, string) error) (*tasks.TaskTree, error)) {
-	fake.newTasksToDeleteNodeGroupsMutex.Lock()
-	defer fake.newTasksToDeleteNodeGroupsMutex.Unlock()
-	fake.NewTasksToDeleteNodeGroupsStub = stub
+func (fake *FakeStackManager) RollbackStackCalls(stub func(context.Context, string, ...string) error) {
+	fake.rollbackStackMutex.Lock()
+	defer fake.rollbackStackMutex.Unlock()
+	fake.RollbackStackStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsArgsForCall(i int) ([]manager.NodeGroupStack, func(_ string) bool, bool, func(chan error, string) error) {
-	fake.newTasksToDeleteNodeGroupsMutex.RLock()
-	defer fake.newTasksToDeleteNodeGroupsMutex.RUnlock()
-	argsForCall := fake.newTasksToDeleteNodeGroupsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeStackManager) RollbackStackArgsForCall(i int) (context.Context, string, []string) {
+	fake.rollbackStackMutex.RLock()
+	defer fake.rollbackStackMutex.RUnlock()
+	argsForCall := fake.rollbackStackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsReturns(result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteNodeGroupsMutex.Lock()
-	defer fake.newTasksToDeleteNodeGroupsMutex.Unlock()
-	fake.NewTasksToDeleteNodeGroupsStub = nil
-	fake.newTasksToDeleteNodeGroupsReturns = struct {
-		result1 *tasks.TaskTree
-		result2 error
-	}{result1, result2}
+func (fake *FakeStackManager) RollbackStackReturns(result1 error) {
+	fake.rollbackStackMutex.Lock()
+	defer fake.rollbackStackMutex.Unlock()
+	fake.RollbackStackStub = nil
+	fake.rollbackStackReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteNodeGroupsMutex.Lock()
-	defer fake.newTasksToDeleteNodeGroupsMutex.Unlock()
-	fake.NewTasksToDeleteNodeGroupsStub = nil
-	if fake.newTasksToDeleteNodeGroupsReturnsOnCall == nil {
-		fake.newTasksToDeleteNodeGroupsReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
-			result2 error
+func (fake *FakeStackManager) RollbackStackReturnsOnCall(i int, result1 error) {
+	fake.rollbackStackMutex.Lock()
+	defer fake.rollbackStackMutex.Unlock()
+	fake.RollbackStackStub = nil
+	if fake.rollbackStackReturnsOnCall == nil {
+		fake.rollbackStackReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.newTasksToDeleteNodeGroupsReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
-		result2 error
-	}{result1, result2}
+	fake.rollbackStackReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(arg1 context.Context, arg2 *iamoidc.OpenIDConnectManager, arg3 kubernetes.ClientSetGetter) (*tasks.TaskTree, error) {
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
-	ret, specificReturn := fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall[len(fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall)]
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall = append(fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall, struct {
+func (fake *FakeStackManager) SetAutoScalingGroupCapacity(arg1 context.Context, arg2 string, arg3 manager.ScalingConfig) error {
+	fake.setAutoScalingGroupCapacityMutex.Lock()
+	ret, specificReturn := fake.setAutoScalingGroupCapacityReturnsOnCall[len(fake.setAutoScalingGroupCapacityArgsForCall)]
+	fake.setAutoScalingGroupCapacityArgsForCall = append(fake.setAutoScalingGroupCapacityArgsForCall, struct {
 		arg1 context.Context
-		arg2 *iamoidc.OpenIDConnectManager
-		arg3 kubernetes.ClientSetGetter
+		arg2 string
+		arg3 manager.ScalingConfig
 	}{arg1, arg2, arg3})
-	stub := fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub
-	fakeReturns := fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturns
-	fake.recordInvocation("NewTasksToDeleteOIDCProviderWithIAMServiceAccounts", []interface{}{arg1, arg2, arg3})
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
+	stub := fake.SetAutoScalingGroupCapacityStub
+	fakeReturns := fake.setAutoScalingGroupCapacityReturns
+	fake.recordInvocation("SetAutoScalingGroupCapacity", []interface{}{arg1, arg2, arg3})
+	fake.setAutoScalingGroupCapacityMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsCallCount() int {
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RLock()
-	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RUnlock()
-	return len(fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall)
+func (fake *FakeStackManager) SetAutoScalingGroupCapacityCallCount() int {
+	fake.setAutoScalingGroupCapacityMutex.RLock()
+	defer fake.setAutoScalingGroupCapacityMutex.RUnlock()
+	return len(fake.setAutoScalingGroupCapacityArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsCalls(stub func(context.Context, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) (*tasks.TaskTree, error)) {
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub = stub
+func (fake *FakeStackManager) SetAutoScalingGroupCapacityCalls(stub func(context.Context, string, manager.ScalingConfig) error) {
+	fake.setAutoScalingGroupCapacityMutex.Lock()
+	defer fake.setAutoScalingGroupCapacityMutex.Unlock()
+	fake.SetAutoScalingGroupCapacityStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall(i int) (context.Context, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) {
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RLock()
-	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RUnlock()
-	argsForCall := fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall[i]
+func (fake *FakeStackManager) SetAutoScalingGroupCapacityArgsForCall(i int) (context.Context, string, manager.ScalingConfig) {
+	fake.setAutoScalingGroupCapacityMutex.RLock()
+	defer fake.setAutoScalingGroupCapacityMutex.RUnlock()
+	argsForCall := fake.setAutoScalingGroupCapacityArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsReturns(result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub = nil
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturns = struct {
-		result1 *tasks.TaskTree
-		result2 error
-	}{result1, result2}
+func (fake *FakeStackManager) SetAutoScalingGroupCapacityReturns(result1 error) {
+	fake.setAutoScalingGroupCapacityMutex.Lock()
+	defer fake.setAutoScalingGroupCapacityMutex.Unlock()
+	fake.SetAutoScalingGroupCapacityStub = nil
+	fake.setAutoScalingGroupCapacityReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub = nil
-	if fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall == nil {
-		fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
-			result2 error
+func (fake *FakeStackManager) SetAutoScalingGroupCapacityReturnsOnCall(i int, result1 error) {
+	fake.setAutoScalingGroupCapacityMutex.Lock()
+	defer fake.setAutoScalingGroupCapacityMutex.Unlock()
+	fake.SetAutoScalingGroupCapacityStub = nil
+	if fake.setAutoScalingGroupCapacityReturnsOnCall == nil {
+		fake.setAutoScalingGroupCapacityReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
-		result2 error
-	}{result1, result2}
+	fake.setAutoScalingGroupCapacityReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) NewUnmanagedNodeGroupTask(arg1 context.Context, arg2 []*v1alpha5.NodeGroup, arg3 bool, arg4 vpc.Importer) *tasks.TaskTree {
-	var arg2Copy []*v1alpha5.NodeGroup
-	if arg2 != nil {
-		arg2Copy = make([]*v1alpha5.NodeGroup, len(arg2))
-		copy(arg2Copy, arg2)
+func (fake *FakeStackManager) SetDeploymentID(arg1 string) {
+	fake.setDeploymentIDMutex.Lock()
+	fake.setDeploymentIDArgsForCall = append(fake.setDeploymentIDArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SetDeploymentIDStub
+	fake.recordInvocation("SetDeploymentID", []interface{}{arg1})
+	fake.setDeploymentIDMutex.Unlock()
+	if stub != nil {
+		fake.SetDeploymentIDStub(arg1)
 	}
-	fake.newUnmanagedNodeGroupTaskMutex.Lock()
-	ret, specificReturn := fake.newUnmanagedNodeGroupTaskReturnsOnCall[len(fake.newUnmanagedNodeGroupTaskArgsForCall)]
-	fake.newUnmanagedNodeGroupTaskArgsForCall = append(fake.newUnmanagedNodeGroupTaskArgsForCall, struct {
-		arg1 context.Context
-		arg2 []*v1alpha5.NodeGroup
-		arg3 bool
-		arg4 vpc.Importer
-	}{arg1, arg2Copy, arg3, arg4})
-	stub := fake.NewUnmanagedNodeGroupTaskStub
-	fakeReturns := fake.newUnmanagedNodeGroupTaskReturns
-	fake.recordInvocation("NewUnmanagedNodeGroupTask", []interface{}{arg1, arg2Copy, arg3, arg4})
-	fake.newUnmanagedNodeGroupTaskMutex.Unlock()
+}
+
+func (fake *FakeStackManager) SetDeploymentIDCallCount() int {
+	fake.setDeploymentIDMutex.RLock()
+	defer fake.setDeploymentIDMutex.RUnlock()
+	return len(fake.setDeploymentIDArgsForCall)
+}
+
+func (fake *FakeStackManager) SetDeploymentIDCalls(stub func(string)) {
+	fake.setDeploymentIDMutex.Lock()
+	defer fake.setDeploymentIDMutex.Unlock()
+	fake.SetDeploymentIDStub = stub
+}
+
+func (fake *FakeStackManager) SetDeploymentIDArgsForCall(i int) string {
+	fake.setDeploymentIDMutex.RLock()
+	defer fake.setDeploymentIDMutex.RUnlock()
+	argsForCall := fake.setDeploymentIDArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) SetDescribeConcurrency(arg1 int) error {
+	fake.setDescribeConcurrencyMutex.Lock()
+	ret, specificReturn := fake.setDescribeConcurrencyReturnsOnCall[len(fake.setDescribeConcurrencyArgsForCall)]
+	fake.setDescribeConcurrencyArgsForCall = append(fake.setDescribeConcurrencyArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.SetDescribeConcurrencyStub
+	fakeReturns := fake.setDescribeConcurrencyReturns
+	fake.recordInvocation("SetDescribeConcurrency", []interface{}{arg1})
+	fake.setDescribeConcurrencyMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
@@ -4124,60 +9726,126 @@ func (fake *FakeStackManager) NewUnmanagedNodeGroupTask(arg1 context.Context, ar
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskCallCount() int {
-	fake.newUnmanagedNodeGroupTaskMutex.RLock()
-	defer fake.newUnmanagedNodeGroupTaskMutex.RUnlock()
-	return len(fake.newUnmanagedNodeGroupTaskArgsForCall)
+func (fake *FakeStackManager) SetDescribeConcurrencyCallCount() int {
+	fake.setDescribeConcurrencyMutex.RLock()
+	defer fake.setDescribeConcurrencyMutex.RUnlock()
+	return len(fake.setDescribeConcurrencyArgsForCall)
 }
 
-func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskCalls(stub func(context.Context, []*v1alpha5.NodeGroup, bool, vpc.Importer) *tasks.TaskTree) {
-	fake.newUnmanagedNodeGroupTaskMutex.Lock()
-	defer fake.newUnmanagedNodeGroupTaskMutex.Unlock()
-	fake.NewUnmanagedNodeGroupTaskStub = stub
+func (fake *FakeStackManager) SetDescribeConcurrencyCalls(stub func(int) error) {
+	fake.setDescribeConcurrencyMutex.Lock()
+	defer fake.setDescribeConcurrencyMutex.Unlock()
+	fake.SetDescribeConcurrencyStub = stub
 }
 
-func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskArgsForCall(i int) (context.Context, []*v1alpha5.NodeGroup, bool, vpc.Importer) {
-	fake.newUnmanagedNodeGroupTaskMutex.RLock()
-	defer fake.newUnmanagedNodeGroupTaskMutex.RUnlock()
-	argsForCall := fake.newUnmanagedNodeGroupTaskArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeStackManager) SetDescribeConcurrencyArgsForCall(i int) int {
+	fake.setDescribeConcurrencyMutex.RLock()
+	defer fake.setDescribeConcurrencyMutex.RUnlock()
+	argsForCall := fake.setDescribeConcurrencyArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskReturns(result1 *tasks.TaskTree) {
-	fake.newUnmanagedNodeGroupTaskMutex.Lock()
-	defer fake.newUnmanagedNodeGroupTaskMutex.Unlock()
-	fake.NewUnmanagedNodeGroupTaskStub = nil
-	fake.newUnmanagedNodeGroupTaskReturns = struct {
-		result1 *tasks.TaskTree
+func (fake *FakeStackManager) SetDescribeConcurrencyReturns(result1 error) {
+	fake.setDescribeConcurrencyMutex.Lock()
+	defer fake.setDescribeConcurrencyMutex.Unlock()
+	fake.SetDescribeConcurrencyStub = nil
+	fake.setDescribeConcurrencyReturns = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskReturnsOnCall(i int, result1 *tasks.TaskTree) {
-	fake.newUnmanagedNodeGroupTaskMutex.Lock()
-	defer fake.newUnmanagedNodeGroupTaskMutex.Unlock()
-	fake.NewUnmanagedNodeGroupTaskStub = nil
-	if fake.newUnmanagedNodeGroupTaskReturnsOnCall == nil {
-		fake.newUnmanagedNodeGroupTaskReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
+func (fake *FakeStackManager) SetDescribeConcurrencyReturnsOnCall(i int, result1 error) {
+	fake.setDescribeConcurrencyMutex.Lock()
+	defer fake.setDescribeConcurrencyMutex.Unlock()
+	fake.SetDescribeConcurrencyStub = nil
+	if fake.setDescribeConcurrencyReturnsOnCall == nil {
+		fake.setDescribeConcurrencyReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.newUnmanagedNodeGroupTaskReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
+	fake.setDescribeConcurrencyReturnsOnCall[i] = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARN(arg1 context.Context) error {
-	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
-	ret, specificReturn := fake.refreshFargatePodExecutionRoleARNReturnsOnCall[len(fake.refreshFargatePodExecutionRoleARNArgsForCall)]
-	fake.refreshFargatePodExecutionRoleARNArgsForCall = append(fake.refreshFargatePodExecutionRoleARNArgsForCall, struct {
-		arg1 context.Context
+func (fake *FakeStackManager) SetStackNamer(arg1 manager.StackNamer) {
+	fake.setStackNamerMutex.Lock()
+	fake.setStackNamerArgsForCall = append(fake.setStackNamerArgsForCall, struct {
+		arg1 manager.StackNamer
 	}{arg1})
-	stub := fake.RefreshFargatePodExecutionRoleARNStub
-	fakeReturns := fake.refreshFargatePodExecutionRoleARNReturns
-	fake.recordInvocation("RefreshFargatePodExecutionRoleARN", []interface{}{arg1})
-	fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
+	stub := fake.SetStackNamerStub
+	fake.recordInvocation("SetStackNamer", []interface{}{arg1})
+	fake.setStackNamerMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		fake.SetStackNamerStub(arg1)
+	}
+}
+
+func (fake *FakeStackManager) SetStackNamerCallCount() int {
+	fake.setStackNamerMutex.RLock()
+	defer fake.setStackNamerMutex.RUnlock()
+	return len(fake.setStackNamerArgsForCall)
+}
+
+func (fake *FakeStackManager) SetStackNamerCalls(stub func(manager.StackNamer)) {
+	fake.setStackNamerMutex.Lock()
+	defer fake.setStackNamerMutex.Unlock()
+	fake.SetStackNamerStub = stub
+}
+
+func (fake *FakeStackManager) SetStackNamerArgsForCall(i int) manager.StackNamer {
+	fake.setStackNamerMutex.RLock()
+	defer fake.setStackNamerMutex.RUnlock()
+	argsForCall := fake.setStackNamerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) SetTemplateBucket(arg1 string) {
+	fake.setTemplateBucketMutex.Lock()
+	fake.setTemplateBucketArgsForCall = append(fake.setTemplateBucketArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SetTemplateBucketStub
+	fake.recordInvocation("SetTemplateBucket", []interface{}{arg1})
+	fake.setTemplateBucketMutex.Unlock()
+	if stub != nil {
+		fake.SetTemplateBucketStub(arg1)
+	}
+}
+
+func (fake *FakeStackManager) SetTemplateBucketCallCount() int {
+	fake.setTemplateBucketMutex.RLock()
+	defer fake.setTemplateBucketMutex.RUnlock()
+	return len(fake.setTemplateBucketArgsForCall)
+}
+
+func (fake *FakeStackManager) SetTemplateBucketCalls(stub func(string)) {
+	fake.setTemplateBucketMutex.Lock()
+	defer fake.setTemplateBucketMutex.Unlock()
+	fake.SetTemplateBucketStub = stub
+}
+
+func (fake *FakeStackManager) SetTemplateBucketArgsForCall(i int) string {
+	fake.setTemplateBucketMutex.RLock()
+	defer fake.setTemplateBucketMutex.RUnlock()
+	argsForCall := fake.setTemplateBucketArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) SetTerminationProtection(arg1 context.Context, arg2 string, arg3 bool) error {
+	fake.setTerminationProtectionMutex.Lock()
+	ret, specificReturn := fake.setTerminationProtectionReturnsOnCall[len(fake.setTerminationProtectionArgsForCall)]
+	fake.setTerminationProtectionArgsForCall = append(fake.setTerminationProtectionArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.SetTerminationProtectionStub
+	fakeReturns := fake.setTerminationProtectionReturns
+	fake.recordInvocation("SetTerminationProtection", []interface{}{arg1, arg2, arg3})
+	fake.setTerminationProtectionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -4185,44 +9853,44 @@ func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARN(arg1 context.Con
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNCallCount() int {
-	fake.refreshFargatePodExecutionRoleARNMutex.RLock()
-	defer fake.refreshFargatePodExecutionRoleARNMutex.RUnlock()
-	return len(fake.refreshFargatePodExecutionRoleARNArgsForCall)
+func (fake *FakeStackManager) SetTerminationProtectionCallCount() int {
+	fake.setTerminationProtectionMutex.RLock()
+	defer fake.setTerminationProtectionMutex.RUnlock()
+	return len(fake.setTerminationProtectionArgsForCall)
 }
 
-func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNCalls(stub func(context.Context) error) {
-	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
-	defer fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
-	fake.RefreshFargatePodExecutionRoleARNStub = stub
+func (fake *FakeStackManager) SetTerminationProtectionCalls(stub func(context.Context, string, bool) error) {
+	fake.setTerminationProtectionMutex.Lock()
+	defer fake.setTerminationProtectionMutex.Unlock()
+	fake.SetTerminationProtectionStub = stub
 }
 
-func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNArgsForCall(i int) context.Context {
-	fake.refreshFargatePodExecutionRoleARNMutex.RLock()
-	defer fake.refreshFargatePodExecutionRoleARNMutex.RUnlock()
-	argsForCall := fake.refreshFargatePodExecutionRoleARNArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) SetTerminationProtectionArgsForCall(i int) (context.Context, string, bool) {
+	fake.setTerminationProtectionMutex.RLock()
+	defer fake.setTerminationProtectionMutex.RUnlock()
+	argsForCall := fake.setTerminationProtectionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNReturns(result1 error) {
-	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
-	defer fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
-	fake.RefreshFargatePodExecutionRoleARNStub = nil
-	fake.refreshFargatePodExecutionRoleARNReturns = struct {
+func (fake *FakeStackManager) SetTerminationProtectionReturns(result1 error) {
+	fake.setTerminationProtectionMutex.Lock()
+	defer fake.setTerminationProtectionMutex.Unlock()
+	fake.SetTerminationProtectionStub = nil
+	fake.setTerminationProtectionReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNReturnsOnCall(i int, result1 error) {
-	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
-	defer fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
-	fake.RefreshFargatePodExecutionRoleARNStub = nil
-	if fake.refreshFargatePodExecutionRoleARNReturnsOnCall == nil {
-		fake.refreshFargatePodExecutionRoleARNReturnsOnCall = make(map[int]struct {
+func (fake *FakeStackManager) SetTerminationProtectionReturnsOnCall(i int, result1 error) {
+	fake.setTerminationProtectionMutex.Lock()
+	defer fake.setTerminationProtectionMutex.Unlock()
+	fake.SetTerminationProtectionStub = nil
+	if fake.setTerminationProtectionReturnsOnCall == nil {
+		fake.setTerminationProtectionReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.refreshFargatePodExecutionRoleARNReturnsOnCall[i] = struct {
+	fake.setTerminationProtectionReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
@@ -4335,18 +10003,82 @@ func (fake *FakeStackManager) StackStatusIsNotTransitionalReturns(result1 bool)
 	}{result1}
 }
 
-func (fake *FakeStackManager) StackStatusIsNotTransitionalReturnsOnCall(i int, result1 bool) {
-	fake.stackStatusIsNotTransitionalMutex.Lock()
-	defer fake.stackStatusIsNotTransitionalMutex.Unlock()
-	fake.StackStatusIsNotTransitionalStub = nil
-	if fake.stackStatusIsNotTransitionalReturnsOnCall == nil {
-		fake.stackStatusIsNotTransitionalReturnsOnCall = make(map[int]struct {
-			result1 bool
+func (fake *FakeStackManager) StackStatusIsNotTransitionalReturnsOnCall(i int, result1 bool) {
+	fake.stackStatusIsNotTransitionalMutex.Lock()
+	defer fake.stackStatusIsNotTransitionalMutex.Unlock()
+	fake.StackStatusIsNotTransitionalStub = nil
+	if fake.stackStatusIsNotTransitionalReturnsOnCall == nil {
+		fake.stackStatusIsNotTransitionalReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.stackStatusIsNotTransitionalReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeStackManager) TotalDesiredCapacity(arg1 context.Context) (int, error) {
+	fake.totalDesiredCapacityMutex.Lock()
+	ret, specificReturn := fake.totalDesiredCapacityReturnsOnCall[len(fake.totalDesiredCapacityArgsForCall)]
+	fake.totalDesiredCapacityArgsForCall = append(fake.totalDesiredCapacityArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.TotalDesiredCapacityStub
+	fakeReturns := fake.totalDesiredCapacityReturns
+	fake.recordInvocation("TotalDesiredCapacity", []interface{}{arg1})
+	fake.totalDesiredCapacityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) TotalDesiredCapacityCallCount() int {
+	fake.totalDesiredCapacityMutex.RLock()
+	defer fake.totalDesiredCapacityMutex.RUnlock()
+	return len(fake.totalDesiredCapacityArgsForCall)
+}
+
+func (fake *FakeStackManager) TotalDesiredCapacityCalls(stub func(context.Context) (int, error)) {
+	fake.totalDesiredCapacityMutex.Lock()
+	defer fake.totalDesiredCapacityMutex.Unlock()
+	fake.TotalDesiredCapacityStub = stub
+}
+
+func (fake *FakeStackManager) TotalDesiredCapacityArgsForCall(i int) context.Context {
+	fake.totalDesiredCapacityMutex.RLock()
+	defer fake.totalDesiredCapacityMutex.RUnlock()
+	argsForCall := fake.totalDesiredCapacityArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) TotalDesiredCapacityReturns(result1 int, result2 error) {
+	fake.totalDesiredCapacityMutex.Lock()
+	defer fake.totalDesiredCapacityMutex.Unlock()
+	fake.TotalDesiredCapacityStub = nil
+	fake.totalDesiredCapacityReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) TotalDesiredCapacityReturnsOnCall(i int, result1 int, result2 error) {
+	fake.totalDesiredCapacityMutex.Lock()
+	defer fake.totalDesiredCapacityMutex.Unlock()
+	fake.TotalDesiredCapacityStub = nil
+	if fake.totalDesiredCapacityReturnsOnCall == nil {
+		fake.totalDesiredCapacityReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
 		})
 	}
-	fake.stackStatusIsNotTransitionalReturnsOnCall[i] = struct {
-		result1 bool
-	}{result1}
+	fake.totalDesiredCapacityReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeStackManager) UpdateNodeGroupStack(arg1 context.Context, arg2 string, arg3 string, arg4 bool) error {
@@ -4475,53 +10207,654 @@ func (fake *FakeStackManager) UpdateStackReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStackManager) UpdateStackWithChanges(arg1 context.Context, arg2 manager.UpdateStackOptions) (*manager.ChangeSummary, error) {
+	fake.updateStackWithChangesMutex.Lock()
+	ret, specificReturn := fake.updateStackWithChangesReturnsOnCall[len(fake.updateStackWithChangesArgsForCall)]
+	fake.updateStackWithChangesArgsForCall = append(fake.updateStackWithChangesArgsForCall, struct {
+		arg1 context.Context
+		arg2 manager.UpdateStackOptions
+	}{arg1, arg2})
+	stub := fake.UpdateStackWithChangesStub
+	fakeReturns := fake.updateStackWithChangesReturns
+	fake.recordInvocation("UpdateStackWithChanges", []interface{}{arg1, arg2})
+	fake.updateStackWithChangesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) UpdateStackWithChangesCallCount() int {
+	fake.updateStackWithChangesMutex.RLock()
+	defer fake.updateStackWithChangesMutex.RUnlock()
+	return len(fake.updateStackWithChangesArgsForCall)
+}
+
+func (fake *FakeStackManager) UpdateStackWithChangesCalls(stub func(context.Context, manager.UpdateStackOptions) (*manager.ChangeSummary, error)) {
+	fake.updateStackWithChangesMutex.Lock()
+	defer fake.updateStackWithChangesMutex.Unlock()
+	fake.UpdateStackWithChangesStub = stub
+}
+
+func (fake *FakeStackManager) UpdateStackWithChangesArgsForCall(i int) (context.Context, manager.UpdateStackOptions) {
+	fake.updateStackWithChangesMutex.RLock()
+	defer fake.updateStackWithChangesMutex.RUnlock()
+	argsForCall := fake.updateStackWithChangesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) UpdateStackWithChangesReturns(result1 *manager.ChangeSummary, result2 error) {
+	fake.updateStackWithChangesMutex.Lock()
+	defer fake.updateStackWithChangesMutex.Unlock()
+	fake.UpdateStackWithChangesStub = nil
+	fake.updateStackWithChangesReturns = struct {
+		result1 *manager.ChangeSummary
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) UpdateStackWithChangesReturnsOnCall(i int, result1 *manager.ChangeSummary, result2 error) {
+	fake.updateStackWithChangesMutex.Lock()
+	defer fake.updateStackWithChangesMutex.Unlock()
+	fake.UpdateStackWithChangesStub = nil
+	if fake.updateStackWithChangesReturnsOnCall == nil {
+		fake.updateStackWithChangesReturnsOnCall = make(map[int]struct {
+			result1 *manager.ChangeSummary
+			result2 error
+		})
+	}
+	fake.updateStackWithChangesReturnsOnCall[i] = struct {
+		result1 *manager.ChangeSummary
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) UsesCustomLaunchTemplate(arg1 context.Context, arg2 string) (bool, string, error) {
+	fake.usesCustomLaunchTemplateMutex.Lock()
+	ret, specificReturn := fake.usesCustomLaunchTemplateReturnsOnCall[len(fake.usesCustomLaunchTemplateArgsForCall)]
+	fake.usesCustomLaunchTemplateArgsForCall = append(fake.usesCustomLaunchTemplateArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.UsesCustomLaunchTemplateStub
+	fakeReturns := fake.usesCustomLaunchTemplateReturns
+	fake.recordInvocation("UsesCustomLaunchTemplate", []interface{}{arg1, arg2})
+	fake.usesCustomLaunchTemplateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStackManager) UsesCustomLaunchTemplateCallCount() int {
+	fake.usesCustomLaunchTemplateMutex.RLock()
+	defer fake.usesCustomLaunchTemplateMutex.RUnlock()
+	return len(fake.usesCustomLaunchTemplateArgsForCall)
+}
+
+func (fake *FakeStackManager) UsesCustomLaunchTemplateCalls(stub func(context.Context, string) (bool, string, error)) {
+	fake.usesCustomLaunchTemplateMutex.Lock()
+	defer fake.usesCustomLaunchTemplateMutex.Unlock()
+	fake.UsesCustomLaunchTemplateStub = stub
+}
+
+func (fake *FakeStackManager) UsesCustomLaunchTemplateArgsForCall(i int) (context.Context, string) {
+	fake.usesCustomLaunchTemplateMutex.RLock()
+	defer fake.usesCustomLaunchTemplateMutex.RUnlock()
+	argsForCall := fake.usesCustomLaunchTemplateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) UsesCustomLaunchTemplateReturns(result1 bool, result2 string, result3 error) {
+	fake.usesCustomLaunchTemplateMutex.Lock()
+	defer fake.usesCustomLaunchTemplateMutex.Unlock()
+	fake.UsesCustomLaunchTemplateStub = nil
+	fake.usesCustomLaunchTemplateReturns = struct {
+		result1 bool
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStackManager) UsesCustomLaunchTemplateReturnsOnCall(i int, result1 bool, result2 string, result3 error) {
+	fake.usesCustomLaunchTemplateMutex.Lock()
+	defer fake.usesCustomLaunchTemplateMutex.Unlock()
+	fake.UsesCustomLaunchTemplateStub = nil
+	if fake.usesCustomLaunchTemplateReturnsOnCall == nil {
+		fake.usesCustomLaunchTemplateReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 string
+			result3 error
+		})
+	}
+	fake.usesCustomLaunchTemplateReturnsOnCall[i] = struct {
+		result1 bool
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStackManager) ValidateNodeGroupOwnership(arg1 context.Context) ([]manager.OwnershipWarning, error) {
+	fake.validateNodeGroupOwnershipMutex.Lock()
+	ret, specificReturn := fake.validateNodeGroupOwnershipReturnsOnCall[len(fake.validateNodeGroupOwnershipArgsForCall)]
+	fake.validateNodeGroupOwnershipArgsForCall = append(fake.validateNodeGroupOwnershipArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ValidateNodeGroupOwnershipStub
+	fakeReturns := fake.validateNodeGroupOwnershipReturns
+	fake.recordInvocation("ValidateNodeGroupOwnership", []interface{}{arg1})
+	fake.validateNodeGroupOwnershipMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ValidateNodeGroupOwnershipCallCount() int {
+	fake.validateNodeGroupOwnershipMutex.RLock()
+	defer fake.validateNodeGroupOwnershipMutex.RUnlock()
+	return len(fake.validateNodeGroupOwnershipArgsForCall)
+}
+
+func (fake *FakeStackManager) ValidateNodeGroupOwnershipCalls(stub func(context.Context) ([]manager.OwnershipWarning, error)) {
+	fake.validateNodeGroupOwnershipMutex.Lock()
+	defer fake.validateNodeGroupOwnershipMutex.Unlock()
+	fake.ValidateNodeGroupOwnershipStub = stub
+}
+
+func (fake *FakeStackManager) ValidateNodeGroupOwnershipArgsForCall(i int) context.Context {
+	fake.validateNodeGroupOwnershipMutex.RLock()
+	defer fake.validateNodeGroupOwnershipMutex.RUnlock()
+	argsForCall := fake.validateNodeGroupOwnershipArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) ValidateNodeGroupOwnershipReturns(result1 []manager.OwnershipWarning, result2 error) {
+	fake.validateNodeGroupOwnershipMutex.Lock()
+	defer fake.validateNodeGroupOwnershipMutex.Unlock()
+	fake.ValidateNodeGroupOwnershipStub = nil
+	fake.validateNodeGroupOwnershipReturns = struct {
+		result1 []manager.OwnershipWarning
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ValidateNodeGroupOwnershipReturnsOnCall(i int, result1 []manager.OwnershipWarning, result2 error) {
+	fake.validateNodeGroupOwnershipMutex.Lock()
+	defer fake.validateNodeGroupOwnershipMutex.Unlock()
+	fake.ValidateNodeGroupOwnershipStub = nil
+	if fake.validateNodeGroupOwnershipReturnsOnCall == nil {
+		fake.validateNodeGroupOwnershipReturnsOnCall = make(map[int]struct {
+			result1 []manager.OwnershipWarning
+			result2 error
+		})
+	}
+	fake.validateNodeGroupOwnershipReturnsOnCall[i] = struct {
+		result1 []manager.OwnershipWarning
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ValidateNodeGroupSubnetAZs(arg1 context.Context, arg2 string) ([]string, error) {
+	fake.validateNodeGroupSubnetAZsMutex.Lock()
+	ret, specificReturn := fake.validateNodeGroupSubnetAZsReturnsOnCall[len(fake.validateNodeGroupSubnetAZsArgsForCall)]
+	fake.validateNodeGroupSubnetAZsArgsForCall = append(fake.validateNodeGroupSubnetAZsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ValidateNodeGroupSubnetAZsStub
+	fakeReturns := fake.validateNodeGroupSubnetAZsReturns
+	fake.recordInvocation("ValidateNodeGroupSubnetAZs", []interface{}{arg1, arg2})
+	fake.validateNodeGroupSubnetAZsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ValidateNodeGroupSubnetAZsCallCount() int {
+	fake.validateNodeGroupSubnetAZsMutex.RLock()
+	defer fake.validateNodeGroupSubnetAZsMutex.RUnlock()
+	return len(fake.validateNodeGroupSubnetAZsArgsForCall)
+}
+
+func (fake *FakeStackManager) ValidateNodeGroupSubnetAZsCalls(stub func(context.Context, string) ([]string, error)) {
+	fake.validateNodeGroupSubnetAZsMutex.Lock()
+	defer fake.validateNodeGroupSubnetAZsMutex.Unlock()
+	fake.ValidateNodeGroupSubnetAZsStub = stub
+}
+
+func (fake *FakeStackManager) ValidateNodeGroupSubnetAZsArgsForCall(i int) (context.Context, string) {
+	fake.validateNodeGroupSubnetAZsMutex.RLock()
+	defer fake.validateNodeGroupSubnetAZsMutex.RUnlock()
+	argsForCall := fake.validateNodeGroupSubnetAZsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) ValidateNodeGroupSubnetAZsReturns(result1 []string, result2 error) {
+	fake.validateNodeGroupSubnetAZsMutex.Lock()
+	defer fake.validateNodeGroupSubnetAZsMutex.Unlock()
+	fake.ValidateNodeGroupSubnetAZsStub = nil
+	fake.validateNodeGroupSubnetAZsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ValidateNodeGroupSubnetAZsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.validateNodeGroupSubnetAZsMutex.Lock()
+	defer fake.validateNodeGroupSubnetAZsMutex.Unlock()
+	fake.ValidateNodeGroupSubnetAZsStub = nil
+	if fake.validateNodeGroupSubnetAZsReturnsOnCall == nil {
+		fake.validateNodeGroupSubnetAZsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.validateNodeGroupSubnetAZsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ValidateTemplate(arg1 context.Context, arg2 manager.TemplateData) (*manager.ValidationResult, error) {
+	fake.validateTemplateMutex.Lock()
+	ret, specificReturn := fake.validateTemplateReturnsOnCall[len(fake.validateTemplateArgsForCall)]
+	fake.validateTemplateArgsForCall = append(fake.validateTemplateArgsForCall, struct {
+		arg1 context.Context
+		arg2 manager.TemplateData
+	}{arg1, arg2})
+	stub := fake.ValidateTemplateStub
+	fakeReturns := fake.validateTemplateReturns
+	fake.recordInvocation("ValidateTemplate", []interface{}{arg1, arg2})
+	fake.validateTemplateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ValidateTemplateCallCount() int {
+	fake.validateTemplateMutex.RLock()
+	defer fake.validateTemplateMutex.RUnlock()
+	return len(fake.validateTemplateArgsForCall)
+}
+
+func (fake *FakeStackManager) ValidateTemplateCalls(stub func(context.Context, manager.TemplateData) (*manager.ValidationResult, error)) {
+	fake.validateTemplateMutex.Lock()
+	defer fake.validateTemplateMutex.Unlock()
+	fake.ValidateTemplateStub = stub
+}
+
+func (fake *FakeStackManager) ValidateTemplateArgsForCall(i int) (context.Context, manager.TemplateData) {
+	fake.validateTemplateMutex.RLock()
+	defer fake.validateTemplateMutex.RUnlock()
+	argsForCall := fake.validateTemplateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) ValidateTemplateReturns(result1 *manager.ValidationResult, result2 error) {
+	fake.validateTemplateMutex.Lock()
+	defer fake.validateTemplateMutex.Unlock()
+	fake.ValidateTemplateStub = nil
+	fake.validateTemplateReturns = struct {
+		result1 *manager.ValidationResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ValidateTemplateReturnsOnCall(i int, result1 *manager.ValidationResult, result2 error) {
+	fake.validateTemplateMutex.Lock()
+	defer fake.validateTemplateMutex.Unlock()
+	fake.ValidateTemplateStub = nil
+	if fake.validateTemplateReturnsOnCall == nil {
+		fake.validateTemplateReturnsOnCall = make(map[int]struct {
+			result1 *manager.ValidationResult
+			result2 error
+		})
+	}
+	fake.validateTemplateReturnsOnCall[i] = struct {
+		result1 *manager.ValidationResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) WaitForASGInService(arg1 context.Context, arg2 string, arg3 time.Duration) error {
+	fake.waitForASGInServiceMutex.Lock()
+	ret, specificReturn := fake.waitForASGInServiceReturnsOnCall[len(fake.waitForASGInServiceArgsForCall)]
+	fake.waitForASGInServiceArgsForCall = append(fake.waitForASGInServiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
+	stub := fake.WaitForASGInServiceStub
+	fakeReturns := fake.waitForASGInServiceReturns
+	fake.recordInvocation("WaitForASGInService", []interface{}{arg1, arg2, arg3})
+	fake.waitForASGInServiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) WaitForASGInServiceCallCount() int {
+	fake.waitForASGInServiceMutex.RLock()
+	defer fake.waitForASGInServiceMutex.RUnlock()
+	return len(fake.waitForASGInServiceArgsForCall)
+}
+
+func (fake *FakeStackManager) WaitForASGInServiceCalls(stub func(context.Context, string, time.Duration) error) {
+	fake.waitForASGInServiceMutex.Lock()
+	defer fake.waitForASGInServiceMutex.Unlock()
+	fake.WaitForASGInServiceStub = stub
+}
+
+func (fake *FakeStackManager) WaitForASGInServiceArgsForCall(i int) (context.Context, string, time.Duration) {
+	fake.waitForASGInServiceMutex.RLock()
+	defer fake.waitForASGInServiceMutex.RUnlock()
+	argsForCall := fake.waitForASGInServiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) WaitForASGInServiceReturns(result1 error) {
+	fake.waitForASGInServiceMutex.Lock()
+	defer fake.waitForASGInServiceMutex.Unlock()
+	fake.WaitForASGInServiceStub = nil
+	fake.waitForASGInServiceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) WaitForASGInServiceReturnsOnCall(i int, result1 error) {
+	fake.waitForASGInServiceMutex.Lock()
+	defer fake.waitForASGInServiceMutex.Unlock()
+	fake.WaitForASGInServiceStub = nil
+	if fake.waitForASGInServiceReturnsOnCall == nil {
+		fake.waitForASGInServiceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.waitForASGInServiceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) WaitForNodeGroupStacks(arg1 context.Context, arg2 []string, arg3 time.Duration) ([]manager.NodeGroupStackResult, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.waitForNodeGroupStacksMutex.Lock()
+	ret, specificReturn := fake.waitForNodeGroupStacksReturnsOnCall[len(fake.waitForNodeGroupStacksArgsForCall)]
+	fake.waitForNodeGroupStacksArgsForCall = append(fake.waitForNodeGroupStacksArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 time.Duration
+	}{arg1, arg2Copy, arg3})
+	stub := fake.WaitForNodeGroupStacksStub
+	fakeReturns := fake.waitForNodeGroupStacksReturns
+	fake.recordInvocation("WaitForNodeGroupStacks", []interface{}{arg1, arg2Copy, arg3})
+	fake.waitForNodeGroupStacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) WaitForNodeGroupStacksCallCount() int {
+	fake.waitForNodeGroupStacksMutex.RLock()
+	defer fake.waitForNodeGroupStacksMutex.RUnlock()
+	return len(fake.waitForNodeGroupStacksArgsForCall)
+}
+
+func (fake *FakeStackManager) WaitForNodeGroupStacksCalls(stub func(context.Context, []string, time.Duration) ([]manager.NodeGroupStackResult, error)) {
+	fake.waitForNodeGroupStacksMutex.Lock()
+	defer fake.waitForNodeGroupStacksMutex.Unlock()
+	fake.WaitForNodeGroupStacksStub = stub
+}
+
+func (fake *FakeStackManager) WaitForNodeGroupStacksArgsForCall(i int) (context.Context, []string, time.Duration) {
+	fake.waitForNodeGroupStacksMutex.RLock()
+	defer fake.waitForNodeGroupStacksMutex.RUnlock()
+	argsForCall := fake.waitForNodeGroupStacksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) WaitForNodeGroupStacksReturns(result1 []manager.NodeGroupStackResult, result2 error) {
+	fake.waitForNodeGroupStacksMutex.Lock()
+	defer fake.waitForNodeGroupStacksMutex.Unlock()
+	fake.WaitForNodeGroupStacksStub = nil
+	fake.waitForNodeGroupStacksReturns = struct {
+		result1 []manager.NodeGroupStackResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) WaitForNodeGroupStacksReturnsOnCall(i int, result1 []manager.NodeGroupStackResult, result2 error) {
+	fake.waitForNodeGroupStacksMutex.Lock()
+	defer fake.waitForNodeGroupStacksMutex.Unlock()
+	fake.WaitForNodeGroupStacksStub = nil
+	if fake.waitForNodeGroupStacksReturnsOnCall == nil {
+		fake.waitForNodeGroupStacksReturnsOnCall = make(map[int]struct {
+			result1 []manager.NodeGroupStackResult
+			result2 error
+		})
+	}
+	fake.waitForNodeGroupStacksReturnsOnCall[i] = struct {
+		result1 []manager.NodeGroupStackResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) WriteNodeGroupInventoryCSV(arg1 context.Context, arg2 io.Writer) error {
+	fake.writeNodeGroupInventoryCSVMutex.Lock()
+	ret, specificReturn := fake.writeNodeGroupInventoryCSVReturnsOnCall[len(fake.writeNodeGroupInventoryCSVArgsForCall)]
+	fake.writeNodeGroupInventoryCSVArgsForCall = append(fake.writeNodeGroupInventoryCSVArgsForCall, struct {
+		arg1 context.Context
+		arg2 io.Writer
+	}{arg1, arg2})
+	stub := fake.WriteNodeGroupInventoryCSVStub
+	fakeReturns := fake.writeNodeGroupInventoryCSVReturns
+	fake.recordInvocation("WriteNodeGroupInventoryCSV", []interface{}{arg1, arg2})
+	fake.writeNodeGroupInventoryCSVMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) WriteNodeGroupInventoryCSVCallCount() int {
+	fake.writeNodeGroupInventoryCSVMutex.RLock()
+	defer fake.writeNodeGroupInventoryCSVMutex.RUnlock()
+	return len(fake.writeNodeGroupInventoryCSVArgsForCall)
+}
+
+func (fake *FakeStackManager) WriteNodeGroupInventoryCSVCalls(stub func(context.Context, io.Writer) error) {
+	fake.writeNodeGroupInventoryCSVMutex.Lock()
+	defer fake.writeNodeGroupInventoryCSVMutex.Unlock()
+	fake.WriteNodeGroupInventoryCSVStub = stub
+}
+
+func (fake *FakeStackManager) WriteNodeGroupInventoryCSVArgsForCall(i int) (context.Context, io.Writer) {
+	fake.writeNodeGroupInventoryCSVMutex.RLock()
+	defer fake.writeNodeGroupInventoryCSVMutex.RUnlock()
+	argsForCall := fake.writeNodeGroupInventoryCSVArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) WriteNodeGroupInventoryCSVReturns(result1 error) {
+	fake.writeNodeGroupInventoryCSVMutex.Lock()
+	defer fake.writeNodeGroupInventoryCSVMutex.Unlock()
+	fake.WriteNodeGroupInventoryCSVStub = nil
+	fake.writeNodeGroupInventoryCSVReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) WriteNodeGroupInventoryCSVReturnsOnCall(i int, result1 error) {
+	fake.writeNodeGroupInventoryCSVMutex.Lock()
+	defer fake.writeNodeGroupInventoryCSVMutex.Unlock()
+	fake.WriteNodeGroupInventoryCSVStub = nil
+	if fake.writeNodeGroupInventoryCSVReturnsOnCall == nil {
+		fake.writeNodeGroupInventoryCSVReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeNodeGroupInventoryCSVReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addAutoscalerTagsMutex.RLock()
+	defer fake.addAutoscalerTagsMutex.RUnlock()
+	fake.addClusterStackTagsMutex.RLock()
+	defer fake.addClusterStackTagsMutex.RUnlock()
 	fake.appendNewClusterStackResourceMutex.RLock()
 	defer fake.appendNewClusterStackResourceMutex.RUnlock()
+	fake.applyTagsToAllClusterStacksMutex.RLock()
+	defer fake.applyTagsToAllClusterStacksMutex.RUnlock()
+	fake.backfillEksctlVersionTagMutex.RLock()
+	defer fake.backfillEksctlVersionTagMutex.RUnlock()
+	fake.cancelStackUpdateMutex.RLock()
+	defer fake.cancelStackUpdateMutex.RUnlock()
+	fake.checkNodeGroupVersionCompatibilityMutex.RLock()
+	defer fake.checkNodeGroupVersionCompatibilityMutex.RUnlock()
+	fake.collectStackOutputsMutex.RLock()
+	defer fake.collectStackOutputsMutex.RUnlock()
+	fake.createNodeGroupStackSetMutex.RLock()
+	defer fake.createNodeGroupStackSetMutex.RUnlock()
+	fake.createNodeGroupStacksMutex.RLock()
+	defer fake.createNodeGroupStacksMutex.RUnlock()
 	fake.createStackMutex.RLock()
 	defer fake.createStackMutex.RUnlock()
+	fake.createStackIfNotExistsMutex.RLock()
+	defer fake.createStackIfNotExistsMutex.RUnlock()
+	fake.createStackNoWaitMutex.RLock()
+	defer fake.createStackNoWaitMutex.RUnlock()
+	fake.createStackWithCapabilitiesMutex.RLock()
+	defer fake.createStackWithCapabilitiesMutex.RUnlock()
+	fake.createStackWithEventHandlerMutex.RLock()
+	defer fake.createStackWithEventHandlerMutex.RUnlock()
+	fake.deleteNodeGroupStackSetMutex.RLock()
+	defer fake.deleteNodeGroupStackSetMutex.RUnlock()
+	fake.deleteNodeGroupStacksMutex.RLock()
+	defer fake.deleteNodeGroupStacksMutex.RUnlock()
 	fake.deleteStackBySpecMutex.RLock()
 	defer fake.deleteStackBySpecMutex.RUnlock()
 	fake.deleteStackBySpecSyncMutex.RLock()
 	defer fake.deleteStackBySpecSyncMutex.RUnlock()
+	fake.deleteStackChangeSetMutex.RLock()
+	defer fake.deleteStackChangeSetMutex.RUnlock()
 	fake.deleteStackSyncMutex.RLock()
 	defer fake.deleteStackSyncMutex.RUnlock()
 	fake.deleteTasksForDeprecatedStacksMutex.RLock()
 	defer fake.deleteTasksForDeprecatedStacksMutex.RUnlock()
+	fake.describeAllClusterStacksMutex.RLock()
+	defer fake.describeAllClusterStacksMutex.RUnlock()
 	fake.describeClusterStackMutex.RLock()
 	defer fake.describeClusterStackMutex.RUnlock()
+	fake.describeFargateProfileStacksMutex.RLock()
+	defer fake.describeFargateProfileStacksMutex.RUnlock()
 	fake.describeIAMServiceAccountStacksMutex.RLock()
 	defer fake.describeIAMServiceAccountStacksMutex.RUnlock()
+	fake.describeNodeGroupAMIMutex.RLock()
+	defer fake.describeNodeGroupAMIMutex.RUnlock()
+	fake.describeNodeGroupDeletionImpactMutex.RLock()
+	defer fake.describeNodeGroupDeletionImpactMutex.RUnlock()
 	fake.describeNodeGroupStackMutex.RLock()
 	defer fake.describeNodeGroupStackMutex.RUnlock()
+	fake.describeNodeGroupStackAndResourcesMutex.RLock()
+	defer fake.describeNodeGroupStackAndResourcesMutex.RUnlock()
+	fake.describeNodeGroupStackByIDMutex.RLock()
+	defer fake.describeNodeGroupStackByIDMutex.RUnlock()
+	fake.describeNodeGroupStackSetInstancesMutex.RLock()
+	defer fake.describeNodeGroupStackSetInstancesMutex.RUnlock()
 	fake.describeNodeGroupStacksMutex.RLock()
 	defer fake.describeNodeGroupStacksMutex.RUnlock()
 	fake.describeNodeGroupStacksAndResourcesMutex.RLock()
 	defer fake.describeNodeGroupStacksAndResourcesMutex.RUnlock()
+	fake.describeNodeGroupStacksCreatedBetweenMutex.RLock()
+	defer fake.describeNodeGroupStacksCreatedBetweenMutex.RUnlock()
+	fake.describeNodeGroupStacksMatchingTagsMutex.RLock()
+	defer fake.describeNodeGroupStacksMatchingTagsMutex.RUnlock()
 	fake.describeStackMutex.RLock()
 	defer fake.describeStackMutex.RUnlock()
 	fake.describeStackChangeSetMutex.RLock()
 	defer fake.describeStackChangeSetMutex.RUnlock()
 	fake.describeStackEventsMutex.RLock()
 	defer fake.describeStackEventsMutex.RUnlock()
+	fake.describeStackIfExistsMutex.RLock()
+	defer fake.describeStackIfExistsMutex.RUnlock()
 	fake.describeStacksMutex.RLock()
 	defer fake.describeStacksMutex.RUnlock()
+	fake.detectResourceDriftMutex.RLock()
+	defer fake.detectResourceDriftMutex.RUnlock()
+	fake.detectStackDriftMutex.RLock()
+	defer fake.detectStackDriftMutex.RUnlock()
+	fake.diffManagedNodeGroupTagsMutex.RLock()
+	defer fake.diffManagedNodeGroupTagsMutex.RUnlock()
 	fake.doCreateStackRequestMutex.RLock()
 	defer fake.doCreateStackRequestMutex.RUnlock()
 	fake.doWaitUntilStackIsCreatedMutex.RLock()
 	defer fake.doWaitUntilStackIsCreatedMutex.RUnlock()
+	fake.enableDefaultTemplateBucketMutex.RLock()
+	defer fake.enableDefaultTemplateBucketMutex.RUnlock()
 	fake.ensureMapPublicIPOnLaunchEnabledMutex.RLock()
 	defer fake.ensureMapPublicIPOnLaunchEnabledMutex.RUnlock()
+	fake.exportNodeGroupTemplateMutex.RLock()
+	defer fake.exportNodeGroupTemplateMutex.RUnlock()
+	fake.findClusterStackByTagMutex.RLock()
+	defer fake.findClusterStackByTagMutex.RUnlock()
+	fake.findNodeGroupsMissingAutoscalerTagsMutex.RLock()
+	defer fake.findNodeGroupsMissingAutoscalerTagsMutex.RUnlock()
+	fake.findNodeGroupsUsingInstanceTypesMutex.RLock()
+	defer fake.findNodeGroupsUsingInstanceTypesMutex.RUnlock()
+	fake.findNodeGroupsWithStaleLaunchTemplateMutex.RLock()
+	defer fake.findNodeGroupsWithStaleLaunchTemplateMutex.RUnlock()
+	fake.findOrphanedNodeGroupENIsMutex.RLock()
+	defer fake.findOrphanedNodeGroupENIsMutex.RUnlock()
 	fake.fixClusterCompatibilityMutex.RLock()
 	defer fake.fixClusterCompatibilityMutex.RUnlock()
+	fake.forceDeleteNodeGroupStackMutex.RLock()
+	defer fake.forceDeleteNodeGroupStackMutex.RUnlock()
+	fake.getAutoScalingGroupCapacityMutex.RLock()
+	defer fake.getAutoScalingGroupCapacityMutex.RUnlock()
 	fake.getAutoScalingGroupDesiredCapacityMutex.RLock()
 	defer fake.getAutoScalingGroupDesiredCapacityMutex.RUnlock()
 	fake.getAutoScalingGroupNameMutex.RLock()
 	defer fake.getAutoScalingGroupNameMutex.RUnlock()
 	fake.getClusterStackIfExistsMutex.RLock()
 	defer fake.getClusterStackIfExistsMutex.RUnlock()
+	fake.getClusterVPCConfigMutex.RLock()
+	defer fake.getClusterVPCConfigMutex.RUnlock()
 	fake.getFargateStackMutex.RLock()
 	defer fake.getFargateStackMutex.RUnlock()
 	fake.getIAMAddonNameMutex.RLock()
@@ -4532,24 +10865,66 @@ func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	defer fake.getIAMServiceAccountsMutex.RUnlock()
 	fake.getKarpenterStackMutex.RLock()
 	defer fake.getKarpenterStackMutex.RUnlock()
+	fake.getManagedNodeGroupLaunchTemplateMutex.RLock()
+	defer fake.getManagedNodeGroupLaunchTemplateMutex.RUnlock()
 	fake.getManagedNodeGroupTemplateMutex.RLock()
 	defer fake.getManagedNodeGroupTemplateMutex.RUnlock()
+	fake.getNodeGroupAMIMutex.RLock()
+	defer fake.getNodeGroupAMIMutex.RUnlock()
+	fake.getNodeGroupInstanceRoleARNMutex.RLock()
+	defer fake.getNodeGroupInstanceRoleARNMutex.RUnlock()
+	fake.getNodeGroupKubernetesVersionMutex.RLock()
+	defer fake.getNodeGroupKubernetesVersionMutex.RUnlock()
 	fake.getNodeGroupNameMutex.RLock()
 	defer fake.getNodeGroupNameMutex.RUnlock()
+	fake.getNodeGroupSecurityGroupIDsMutex.RLock()
+	defer fake.getNodeGroupSecurityGroupIDsMutex.RUnlock()
+	fake.getNodeGroupStackTemplateMutex.RLock()
+	defer fake.getNodeGroupStackTemplateMutex.RUnlock()
 	fake.getNodeGroupStackTypeMutex.RLock()
 	defer fake.getNodeGroupStackTypeMutex.RUnlock()
+	fake.getStackParametersMutex.RLock()
+	defer fake.getStackParametersMutex.RUnlock()
+	fake.getStackPhysicalResourceIDMutex.RLock()
+	defer fake.getStackPhysicalResourceIDMutex.RUnlock()
+	fake.getStackPolicyMutex.RLock()
+	defer fake.getStackPolicyMutex.RUnlock()
+	fake.getStackRollbackConfigurationMutex.RLock()
+	defer fake.getStackRollbackConfigurationMutex.RUnlock()
 	fake.getStackTemplateMutex.RLock()
 	defer fake.getStackTemplateMutex.RUnlock()
 	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.RLock()
 	defer fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.RUnlock()
+	fake.groupNodeGroupsByInstanceRoleMutex.RLock()
+	defer fake.groupNodeGroupsByInstanceRoleMutex.RUnlock()
+	fake.groupNodeGroupsBySecurityGroupMutex.RLock()
+	defer fake.groupNodeGroupsBySecurityGroupMutex.RUnlock()
+	fake.hasCNIPolicyMutex.RLock()
+	defer fake.hasCNIPolicyMutex.RUnlock()
 	fake.hasClusterStackFromListMutex.RLock()
 	defer fake.hasClusterStackFromListMutex.RUnlock()
+	fake.hasProtectedResourcesMutex.RLock()
+	defer fake.hasProtectedResourcesMutex.RUnlock()
+	fake.importUnmanagedNodeGroupMutex.RLock()
+	defer fake.importUnmanagedNodeGroupMutex.RUnlock()
+	fake.isSpotNodeGroupMutex.RLock()
+	defer fake.isSpotNodeGroupMutex.RUnlock()
+	fake.isTerminationProtectedMutex.RLock()
+	defer fake.isTerminationProtectedMutex.RUnlock()
 	fake.listClusterStackNamesMutex.RLock()
 	defer fake.listClusterStackNamesMutex.RUnlock()
 	fake.listIAMServiceAccountStacksMutex.RLock()
 	defer fake.listIAMServiceAccountStacksMutex.RUnlock()
+	fake.listInProgressStacksMutex.RLock()
+	defer fake.listInProgressStacksMutex.RUnlock()
 	fake.listNodeGroupStacksMutex.RLock()
 	defer fake.listNodeGroupStacksMutex.RUnlock()
+	fake.listNodeGroupStacksByTypeMutex.RLock()
+	defer fake.listNodeGroupStacksByTypeMutex.RUnlock()
+	fake.listNodeGroupStacksPagedMutex.RLock()
+	defer fake.listNodeGroupStacksPagedMutex.RUnlock()
+	fake.listStackChangeSetsMutex.RLock()
+	defer fake.listStackChangeSetsMutex.RUnlock()
 	fake.listStacksMutex.RLock()
 	defer fake.listStacksMutex.RUnlock()
 	fake.listStacksMatchingMutex.RLock()
@@ -4582,16 +10957,60 @@ func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RUnlock()
 	fake.newUnmanagedNodeGroupTaskMutex.RLock()
 	defer fake.newUnmanagedNodeGroupTaskMutex.RUnlock()
+	fake.nodeGroupStacksJSONMutex.RLock()
+	defer fake.nodeGroupStacksJSONMutex.RUnlock()
+	fake.nodeGroupTemplateDriftMutex.RLock()
+	defer fake.nodeGroupTemplateDriftMutex.RUnlock()
+	fake.propagateManagedNodeGroupTagsToSecurityGroupsMutex.RLock()
+	defer fake.propagateManagedNodeGroupTagsToSecurityGroupsMutex.RUnlock()
+	fake.propagateManagedNodeGroupTagsToVolumesMutex.RLock()
+	defer fake.propagateManagedNodeGroupTagsToVolumesMutex.RUnlock()
+	fake.reconcileManagedNodeGroupTagsToASGMutex.RLock()
+	defer fake.reconcileManagedNodeGroupTagsToASGMutex.RUnlock()
+	fake.refreshClusterStackCacheMutex.RLock()
+	defer fake.refreshClusterStackCacheMutex.RUnlock()
 	fake.refreshFargatePodExecutionRoleARNMutex.RLock()
 	defer fake.refreshFargatePodExecutionRoleARNMutex.RUnlock()
+	fake.rollbackStackMutex.RLock()
+	defer fake.rollbackStackMutex.RUnlock()
+	fake.setAutoScalingGroupCapacityMutex.RLock()
+	defer fake.setAutoScalingGroupCapacityMutex.RUnlock()
+	fake.setDeploymentIDMutex.RLock()
+	defer fake.setDeploymentIDMutex.RUnlock()
+	fake.setDescribeConcurrencyMutex.RLock()
+	defer fake.setDescribeConcurrencyMutex.RUnlock()
+	fake.setStackNamerMutex.RLock()
+	defer fake.setStackNamerMutex.RUnlock()
+	fake.setTemplateBucketMutex.RLock()
+	defer fake.setTemplateBucketMutex.RUnlock()
+	fake.setTerminationProtectionMutex.RLock()
+	defer fake.setTerminationProtectionMutex.RUnlock()
 	fake.stackStatusIsNotReadyMutex.RLock()
 	defer fake.stackStatusIsNotReadyMutex.RUnlock()
 	fake.stackStatusIsNotTransitionalMutex.RLock()
 	defer fake.stackStatusIsNotTransitionalMutex.RUnlock()
+	fake.totalDesiredCapacityMutex.RLock()
+	defer fake.totalDesiredCapacityMutex.RUnlock()
 	fake.updateNodeGroupStackMutex.RLock()
 	defer fake.updateNodeGroupStackMutex.RUnlock()
 	fake.updateStackMutex.RLock()
 	defer fake.updateStackMutex.RUnlock()
+	fake.updateStackWithChangesMutex.RLock()
+	defer fake.updateStackWithChangesMutex.RUnlock()
+	fake.usesCustomLaunchTemplateMutex.RLock()
+	defer fake.usesCustomLaunchTemplateMutex.RUnlock()
+	fake.validateNodeGroupOwnershipMutex.RLock()
+	defer fake.validateNodeGroupOwnershipMutex.RUnlock()
+	fake.validateNodeGroupSubnetAZsMutex.RLock()
+	defer fake.validateNodeGroupSubnetAZsMutex.RUnlock()
+	fake.validateTemplateMutex.RLock()
+	defer fake.validateTemplateMutex.RUnlock()
+	fake.waitForASGInServiceMutex.RLock()
+	defer fake.waitForASGInServiceMutex.RUnlock()
+	fake.waitForNodeGroupStacksMutex.RLock()
+	defer fake.waitForNodeGroupStacksMutex.RUnlock()
+	fake.writeNodeGroupInventoryCSVMutex.RLock()
+	defer fake.writeNodeGroupInventoryCSVMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/kris-nova/logger"
 )
 
 // GetFargateStack returns the stack holding the fargate IAM
@@ -27,6 +28,31 @@ func (c *StackCollection) GetFargateStack(ctx context.Context) (*Stack, error) {
 	return nil, nil
 }
 
+// DescribeFargateProfileStacks calls DescribeStacks and filters out the stacks holding
+// the fargate resources of the cluster
+func (c *StackCollection) DescribeFargateProfileStacks(ctx context.Context) ([]*Stack, error) {
+	stacks, err := c.DescribeStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fargateStacks := []*Stack{}
+	for _, s := range stacks {
+		switch s.StackStatus {
+		case types.StackStatusDeleteComplete:
+			continue
+		case types.StackStatusDeleteFailed:
+			logger.Warning("stack's status of fargate stack named %s is %s", *s.StackName, s.StackStatus)
+			continue
+		}
+		if isFargateStack(s) {
+			fargateStacks = append(fargateStacks, s)
+		}
+	}
+	logger.Debug("fargate stacks = %v", fargateStacks)
+	return fargateStacks, nil
+}
+
 func isFargateStack(s *Stack) bool {
 	return strings.HasSuffix(*s.StackName, "-fargate")
 }
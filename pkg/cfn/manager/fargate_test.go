@@ -0,0 +1,47 @@
+package manager
+
+import (
+	"context"
+
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection Fargate", func() {
+	Describe("DescribeFargateProfileStacks", func() {
+		It("returns the fargate stacks, skipping deleted stacks", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			for stackName, status := range map[string]types.StackStatus{
+				"eksctl-test-cluster-fargate":      types.StackStatusCreateComplete,
+				"eksctl-test-cluster-nodegroup-ng": types.StackStatusCreateComplete,
+				"eksctl-other-cluster-fargate":     types.StackStatusDeleteFailed,
+			} {
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: aws.String(stackName)}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: aws.String(stackName), StackStatus: status}},
+				}, nil)
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{
+				StackSummaries: []types.StackSummary{
+					{StackName: aws.String("eksctl-test-cluster-fargate")},
+					{StackName: aws.String("eksctl-test-cluster-nodegroup-ng")},
+					{StackName: aws.String("eksctl-other-cluster-fargate")},
+				},
+			}, nil)
+
+			stacks, err := NewStackCollection(p, cfg).DescribeFargateProfileStacks(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stacks).To(HaveLen(1))
+			Expect(*stacks[0].StackName).To(Equal("eksctl-test-cluster-fargate"))
+		})
+	})
+})
@@ -0,0 +1,131 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	importedNodeGroupResourceName = "NodeGroup"
+	autoScalingGroupResourceType  = "AWS::AutoScaling::AutoScalingGroup"
+)
+
+// ImportUnmanagedNodeGroup creates a nodegroup stack for an existing autoscaling group by importing it
+// into CloudFormation, so that it is managed by eksctl as the unmanaged nodegroup ngName.
+// The autoscaling group is imported as the NodeGroup resource of the stack, identified by its
+// AutoScalingGroupName, and must use either a launch template or a launch configuration; it is
+// retained should the stack be deleted.
+func (c *StackCollection) ImportUnmanagedNodeGroup(ctx context.Context, ngName, asgName string) error {
+	out, err := c.asgAPI.DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{asgName},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing autoscaling group %q", asgName)
+	}
+	if len(out.AutoScalingGroups) != 1 {
+		return fmt.Errorf("autoscaling group %q not found", asgName)
+	}
+
+	templateBody, err := makeImportedNodeGroupTemplate(out.AutoScalingGroups[0])
+	if err != nil {
+		return errors.Wrapf(err, "importing autoscaling group %q", asgName)
+	}
+
+	stackName := c.makeNodeGroupStackName(ngName)
+	changeSetName := c.MakeChangeSetName("import-nodegroup")
+	input := &cloudformation.CreateChangeSetInput{
+		StackName:     aws.String(stackName),
+		ChangeSetName: aws.String(changeSetName),
+		ChangeSetType: types.ChangeSetTypeImport,
+		TemplateBody:  aws.String(string(templateBody)),
+		ResourcesToImport: []types.ResourceToImport{{
+			ResourceType:       aws.String(autoScalingGroupResourceType),
+			LogicalResourceId:  aws.String(importedNodeGroupResourceName),
+			ResourceIdentifier: map[string]string{"AutoScalingGroupName": asgName},
+		}},
+		Tags: append([]types.Tag{
+			newTag(api.NodeGroupNameTag, ngName),
+			newTag(api.NodeGroupTypeTag, string(api.NodeGroupTypeUnmanaged)),
+		}, c.sharedTags...),
+	}
+	if cfnRole := c.roleARN; cfnRole != "" {
+		input.RoleARN = &cfnRole
+	}
+
+	logger.Info("importing autoscaling group %q into stack %q", asgName, stackName)
+	if _, err := c.cloudformationAPI.CreateChangeSet(ctx, input); err != nil {
+		return errors.Wrapf(err, "creating import ChangeSet %q for stack %q", changeSetName, stackName)
+	}
+	stack := &Stack{StackName: aws.String(stackName)}
+	if err := c.doWaitUntilChangeSetIsCreated(ctx, stack, changeSetName, 0, 0); err != nil {
+		return err
+	}
+	if err := c.doExecuteChangeSet(ctx, stackName, changeSetName); err != nil {
+		return err
+	}
+	return c.doWaitUntilStackIsImported(ctx, stack)
+}
+
+// makeImportedNodeGroupTemplate renders a template describing the given autoscaling group as it is
+func makeImportedNodeGroupTemplate(asg asgtypes.AutoScalingGroup) ([]byte, error) {
+	properties := map[string]interface{}{
+		"AutoScalingGroupName": aws.StringValue(asg.AutoScalingGroupName),
+		"MinSize":              strconv.Itoa(int(aws.Int32Value(asg.MinSize))),
+		"MaxSize":              strconv.Itoa(int(aws.Int32Value(asg.MaxSize))),
+		"DesiredCapacity":      strconv.Itoa(int(aws.Int32Value(asg.DesiredCapacity))),
+	}
+	if subnets := aws.StringValue(asg.VPCZoneIdentifier); subnets != "" {
+		properties["VPCZoneIdentifier"] = strings.Split(subnets, ",")
+	}
+	switch {
+	case asg.LaunchTemplate != nil:
+		properties["LaunchTemplate"] = map[string]interface{}{
+			"LaunchTemplateId": aws.StringValue(asg.LaunchTemplate.LaunchTemplateId),
+			"Version":          aws.StringValue(asg.LaunchTemplate.Version),
+		}
+	case asg.LaunchConfigurationName != nil:
+		properties["LaunchConfigurationName"] = *asg.LaunchConfigurationName
+	default:
+		return nil, errors.New("only autoscaling groups using a launch template or launch configuration can be imported")
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"AWSTemplateFormatVersion": "2010-09-09",
+		"Description":              "EKS nodes imported from an existing autoscaling group [managed by eksctl]",
+		"Resources": map[string]interface{}{
+			importedNodeGroupResourceName: map[string]interface{}{
+				"Type":           autoScalingGroupResourceType,
+				"DeletionPolicy": "Retain",
+				"Properties":     properties,
+			},
+		},
+	})
+}
+
+func (c *StackCollection) doWaitUntilStackIsImported(ctx context.Context, i *Stack) error {
+	setCustomRetryer := func(o *cloudformation.StackImportCompleteWaiterOptions) {
+		defaultRetryer := o.Retryable
+		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
+			logger.Info("waiting for CloudFormation stack %q to be imported", *i.StackName)
+			return defaultRetryer(ctx, in, out, err)
+		}
+	}
+
+	waiter := cloudformation.NewStackImportCompleteWaiter(c.cloudformationAPI)
+	return waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
+		StackName: i.StackName,
+	}, c.waitTimeout, setCustomRetryer)
+}
@@ -0,0 +1,99 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection Import", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sm StackManager
+	)
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sm = NewStackCollection(p, cfg)
+	})
+
+	It("imports the autoscaling group into a nodegroup stack", func() {
+		stackName := aws.String("eksctl-test-cluster-nodegroup-ng")
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []string{"my-asg"},
+		}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []asgtypes.AutoScalingGroup{{
+				AutoScalingGroupName: aws.String("my-asg"),
+				MinSize:              aws.Int32(1),
+				MaxSize:              aws.Int32(3),
+				DesiredCapacity:      aws.Int32(2),
+				VPCZoneIdentifier:    aws.String("subnet-1,subnet-2"),
+				LaunchTemplate:       &asgtypes.LaunchTemplateSpecification{LaunchTemplateId: aws.String("lt-1"), Version: aws.String("$Latest")},
+			}},
+		}, nil)
+		p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(&cfn.CreateChangeSetOutput{}, nil)
+		p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+			StackName: stackName,
+			Status:    types.ChangeSetStatusCreateComplete,
+		}, nil)
+		p.MockCloudFormation().On("ExecuteChangeSet", mock.Anything, mock.Anything).Return(&cfn.ExecuteChangeSetOutput{}, nil)
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+			Stacks: []types.Stack{{StackName: stackName, StackStatus: types.StackStatusImportComplete}},
+		}, nil)
+
+		Expect(sm.ImportUnmanagedNodeGroup(context.TODO(), "ng", "my-asg")).To(Succeed())
+
+		input := p.MockCloudFormation().Calls[0].Arguments.Get(1).(*cfn.CreateChangeSetInput)
+		Expect(*input.StackName).To(Equal(*stackName))
+		Expect(input.ChangeSetType).To(Equal(types.ChangeSetTypeImport))
+		Expect(input.ResourcesToImport).To(Equal([]types.ResourceToImport{{
+			ResourceType:       aws.String("AWS::AutoScaling::AutoScalingGroup"),
+			LogicalResourceId:  aws.String("NodeGroup"),
+			ResourceIdentifier: map[string]string{"AutoScalingGroupName": "my-asg"},
+		}}))
+		Expect(input.Tags).To(ContainElements(
+			types.Tag{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng")},
+			types.Tag{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeUnmanaged))},
+			types.Tag{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+		))
+		Expect(*input.TemplateBody).To(MatchJSON(`{
+			"AWSTemplateFormatVersion": "2010-09-09",
+			"Description": "EKS nodes imported from an existing autoscaling group [managed by eksctl]",
+			"Resources": {
+				"NodeGroup": {
+					"Type": "AWS::AutoScaling::AutoScalingGroup",
+					"DeletionPolicy": "Retain",
+					"Properties": {
+						"AutoScalingGroupName": "my-asg",
+						"MinSize": "1",
+						"MaxSize": "3",
+						"DesiredCapacity": "2",
+						"VPCZoneIdentifier": ["subnet-1", "subnet-2"],
+						"LaunchTemplate": {"LaunchTemplateId": "lt-1", "Version": "$Latest"}
+					}
+				}
+			}
+		}`))
+		p.MockCloudFormation().AssertCalled(GinkgoT(), "ExecuteChangeSet", mock.Anything, mock.Anything)
+	})
+
+	It("errors when the autoscaling group does not exist", func() {
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+
+		err := sm.ImportUnmanagedNodeGroup(context.TODO(), "ng", "my-asg")
+		Expect(err).To(MatchError(`autoscaling group "my-asg" not found`))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything, mock.Anything)
+	})
+})
@@ -2,6 +2,8 @@ package manager
 
 import (
 	"context"
+	"io"
+	"time"
 
 	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 
@@ -26,6 +28,35 @@ type UpdateStackOptions struct {
 	TemplateData  TemplateData
 	Parameters    map[string]string
 	Wait          bool
+	// StreamEvents logs stack events as they occur while waiting for the update to complete
+	StreamEvents bool
+	// DryRun logs the changes that would be made and deletes the change set instead of executing it
+	DryRun bool
+	// PreserveParameters lists the keys of stack parameters that keep their previous value
+	PreserveParameters []string
+	// WaitInterval is the interval at which the change set and stack are polled while waiting,
+	// defaulting to the CloudFormation waiters' backoff when zero
+	WaitInterval time.Duration
+	// WaitTimeout is the maximum time to wait for the change set and stack, defaulting to the
+	// StackCollection's wait timeout when zero
+	WaitTimeout time.Duration
+	// DeploymentID is recorded in the deployment id tag of the stack, defaulting to the StackCollection's
+	// deployment id; when neither is set, the stack keeps its previous deployment id
+	DeploymentID string
+	// RollbackConfiguration sets the CloudWatch alarms that roll the update back when they go off,
+	// and the time they are monitored for after the update
+	RollbackConfiguration *RollbackConfiguration
+}
+
+// DeleteOptions options for deleting nodegroup stacks in bulk.
+type DeleteOptions struct {
+	// Drain, when set, is called for each nodegroup before its stack is deleted
+	Drain func(ctx context.Context, nodeGroupName string) error
+	// RetainResources maps nodegroup names to the logical ids of resources to retain when their stacks are in
+	// DELETE_FAILED state; stacks that end up in that state are deleted once more, retaining these resources
+	RetainResources map[string][]string
+	// Concurrency is the maximum number of stacks deleted at once, defaulting to 5
+	Concurrency int
 }
 
 // GetNodegroupOption nodegroup options.
@@ -39,42 +70,102 @@ var _ StackManager = &StackCollection{}
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
 //counterfeiter:generate -o fakes/fake_stack_manager.go . StackManager
 type StackManager interface {
+	AddAutoscalerTags(ctx context.Context, ngName string) error
+	AddClusterStackTags(ctx context.Context, tags map[string]string) error
 	AppendNewClusterStackResource(ctx context.Context, plan bool) (bool, error)
+	ApplyTagsToAllClusterStacks(ctx context.Context, tags map[string]string) error
+	BackfillEksctlVersionTag(ctx context.Context) ([]string, error)
+	CancelStackUpdate(ctx context.Context, stackName string, wait bool) error
+	CheckNodeGroupVersionCompatibility(ctx context.Context) ([]string, error)
+	CollectStackOutputs(stack *Stack, into interface{}) error
+	CreateNodeGroupStackSet(ctx context.Context, ng *v1alpha5.NodeGroup, regions []string) error
+	CreateNodeGroupStacks(ctx context.Context, ngs []NodeGroupSpec, parallelism int) error
 	CreateStack(ctx context.Context, name string, stack builder.ResourceSetReader, tags, parameters map[string]string, errs chan error) error
+	CreateStackIfNotExists(ctx context.Context, name string, stack builder.ResourceSetReader, tags, parameters map[string]string, errs chan error) error
+	CreateStackNoWait(ctx context.Context, stackName string, resourceSet builder.ResourceSetReader, tags, parameters map[string]string) (string, error)
+	CreateStackWithCapabilities(ctx context.Context, name string, stack builder.ResourceSetReader, tags, parameters map[string]string, capabilities []string, errs chan error) error
+	CreateStackWithEventHandler(ctx context.Context, name string, stack builder.ResourceSetReader, tags, parameters map[string]string, eventHandler func(StackEvent), errs chan error) error
+	DeleteNodeGroupStackSet(ctx context.Context, ngName string) error
+	DeleteNodeGroupStacks(ctx context.Context, names []string, opts DeleteOptions) error
 	DeleteStackBySpec(ctx context.Context, s *Stack) (*Stack, error)
 	DeleteStackBySpecSync(ctx context.Context, s *Stack, errs chan error) error
+	DeleteStackChangeSet(ctx context.Context, stackName, changeSetName string) error
 	DeleteStackSync(ctx context.Context, s *Stack) error
 	DeleteTasksForDeprecatedStacks(ctx context.Context) (*tasks.TaskTree, error)
+	DescribeAllClusterStacks(ctx context.Context) (cluster *Stack, nodegroups []*Stack, others []*Stack, err error)
 	DescribeClusterStack(ctx context.Context) (*Stack, error)
+	DescribeFargateProfileStacks(ctx context.Context) ([]*Stack, error)
 	DescribeIAMServiceAccountStacks(ctx context.Context) ([]*Stack, error)
+	DescribeNodeGroupAMI(ctx context.Context, ngName string) (*NodeGroupAMI, error)
+	DescribeNodeGroupDeletionImpact(ctx context.Context, ngName string) (*DeletionImpact, error)
 	DescribeNodeGroupStack(ctx context.Context, nodeGroupName string) (*Stack, error)
+	DescribeNodeGroupStackAndResources(ctx context.Context, ngName string) (StackInfo, error)
+	DescribeNodeGroupStackByID(ctx context.Context, stackID string) (*Stack, error)
+	DescribeNodeGroupStackSetInstances(ctx context.Context, ngName string) ([]StackSetInstanceStatus, error)
 	DescribeNodeGroupStacks(ctx context.Context) ([]*Stack, error)
 	DescribeNodeGroupStacksAndResources(ctx context.Context) (map[string]StackInfo, error)
+	DescribeNodeGroupStacksCreatedBetween(ctx context.Context, start, end time.Time) ([]*Stack, error)
+	DescribeNodeGroupStacksMatchingTags(ctx context.Context, filters map[string]string) ([]*Stack, error)
 	DescribeStack(ctx context.Context, i *Stack) (*Stack, error)
 	DescribeStackChangeSet(ctx context.Context, i *Stack, changeSetName string) (*ChangeSet, error)
 	DescribeStackEvents(ctx context.Context, i *Stack) ([]cfntypes.StackEvent, error)
+	DescribeStackIfExists(ctx context.Context, i *Stack) (*Stack, bool, error)
 	DescribeStacks(ctx context.Context) ([]*Stack, error)
+	DetectResourceDrift(ctx context.Context, stackName, logicalID string) (*ResourceDrift, error)
+	DetectStackDrift(ctx context.Context, stackName string) (*DriftResult, error)
+	DiffManagedNodeGroupTags(ctx context.Context, ngName string, desired map[string]string) (toAdd, toUpdate, toRemove map[string]string, err error)
 	DoCreateStackRequest(ctx context.Context, i *Stack, templateData TemplateData, tags, parameters map[string]string, withIAM bool, withNamedIAM bool) error
 	DoWaitUntilStackIsCreated(ctx context.Context, i *Stack) error
+	EnableDefaultTemplateBucket()
 	EnsureMapPublicIPOnLaunchEnabled(ctx context.Context) error
+	ExportNodeGroupTemplate(ctx context.Context, ngName, path string) error
+	FindClusterStackByTag(ctx context.Context) (*Stack, error)
+	FindNodeGroupsMissingAutoscalerTags(ctx context.Context) ([]string, error)
+	FindNodeGroupsUsingInstanceTypes(ctx context.Context, instanceTypes []string) ([]string, error)
+	FindNodeGroupsWithStaleLaunchTemplate(ctx context.Context) ([]string, error)
+	FindOrphanedNodeGroupENIs(ctx context.Context, nodeGroupName string) ([]string, error)
 	FixClusterCompatibility(ctx context.Context) error
+	ForceDeleteNodeGroupStack(ctx context.Context, name string, retainResources []string) error
+	GetAutoScalingGroupCapacity(ctx context.Context, name string) (ScalingConfig, error)
 	GetAutoScalingGroupDesiredCapacity(ctx context.Context, name string) (asgtypes.AutoScalingGroup, error)
 	GetAutoScalingGroupName(ctx context.Context, s *Stack) (string, error)
 	GetClusterStackIfExists(ctx context.Context) (*Stack, error)
+	GetClusterVPCConfig(ctx context.Context) (*ClusterVPCInfo, error)
 	GetFargateStack(ctx context.Context) (*Stack, error)
 	GetIAMAddonName(s *Stack) string
 	GetIAMAddonsStacks(ctx context.Context) ([]*Stack, error)
 	GetIAMServiceAccounts(ctx context.Context) ([]*v1alpha5.ClusterIAMServiceAccount, error)
 	GetKarpenterStack(ctx context.Context) (*Stack, error)
+	GetManagedNodeGroupLaunchTemplate(ctx context.Context, s *Stack) (string, string, error)
 	GetManagedNodeGroupTemplate(ctx context.Context, options GetNodegroupOption) (string, error)
+	GetNodeGroupAMI(ctx context.Context, ngName string) (string, error)
+	GetNodeGroupInstanceRoleARN(ctx context.Context, ngName string) (string, error)
+	GetNodeGroupKubernetesVersion(ctx context.Context, ngName string) (string, error)
 	GetNodeGroupName(s *Stack) string
+	GetNodeGroupSecurityGroupIDs(ctx context.Context, ngName string) ([]string, error)
+	GetNodeGroupStackTemplate(ctx context.Context, nodeGroupName string) (string, error)
 	GetNodeGroupStackType(ctx context.Context, options GetNodegroupOption) (v1alpha5.NodeGroupType, error)
+	GetStackParameters(ctx context.Context, stackName string) (map[string]string, error)
+	GetStackPhysicalResourceID(ctx context.Context, stackName, logicalID string) (string, error)
+	GetStackPolicy(ctx context.Context, stackName string) (string, error)
+	GetStackRollbackConfiguration(ctx context.Context, stackName string) (*RollbackConfiguration, error)
 	GetStackTemplate(ctx context.Context, stackName string) (string, error)
 	GetUnmanagedNodeGroupAutoScalingGroupName(ctx context.Context, s *Stack) (string, error)
+	GroupNodeGroupsByInstanceRole(ctx context.Context) (map[string][]string, error)
+	GroupNodeGroupsBySecurityGroup(ctx context.Context) (map[string][]string, error)
+	HasCNIPolicy(ctx context.Context, s *Stack) (bool, error)
 	HasClusterStackFromList(ctx context.Context, clusterStackNames []string, clusterName string) (bool, error)
+	HasProtectedResources(ctx context.Context, stackName string) (bool, error)
+	ImportUnmanagedNodeGroup(ctx context.Context, ngName, asgName string) error
+	IsSpotNodeGroup(ctx context.Context, s *Stack) (bool, error)
+	IsTerminationProtected(ctx context.Context, stackName string) (bool, error)
 	ListClusterStackNames(ctx context.Context) ([]string, error)
 	ListIAMServiceAccountStacks(ctx context.Context) ([]string, error)
+	ListInProgressStacks(ctx context.Context) ([]*Stack, error)
 	ListNodeGroupStacks(ctx context.Context) ([]NodeGroupStack, error)
+	ListNodeGroupStacksByType(ctx context.Context, ngType v1alpha5.NodeGroupType) ([]NodeGroupStack, error)
+	ListNodeGroupStacksPaged(ctx context.Context, pageSize int, token string) ([]NodeGroupStack, string, error)
+	ListStackChangeSets(ctx context.Context, stackName string) ([]ChangeSetSummary, error)
 	ListStacks(ctx context.Context, statusFilters ...cfntypes.StackStatus) ([]*Stack, error)
 	ListStacksMatching(ctx context.Context, nameRegex string, statusFilters ...cfntypes.StackStatus) ([]*Stack, error)
 	LookupCloudTrailEvents(ctx context.Context, i *Stack) ([]cttypes.Event, error)
@@ -88,12 +179,34 @@ type StackManager interface {
 	NewTasksToCreateIAMServiceAccounts(serviceAccounts []*v1alpha5.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter) *tasks.TaskTree
 	NewTasksToDeleteClusterWithNodeGroups(ctx context.Context, stack *Stack, stacks []NodeGroupStack, deleteOIDCProvider bool, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter, wait bool, cleanup func(chan error, string) error) (*tasks.TaskTree, error)
 	NewTasksToDeleteIAMServiceAccounts(ctx context.Context, serviceAccounts []string, clientSetGetter kubernetes.ClientSetGetter, wait bool) (*tasks.TaskTree, error)
-	NewTasksToDeleteNodeGroups(stacks []NodeGroupStack, shouldDelete func(_ string) bool, wait bool, cleanup func(chan error, string) error) (*tasks.TaskTree, error)
+	NewTasksToDeleteNodeGroups(ctx context.Context, stacks []NodeGroupStack, shouldDelete func(_ string) bool, wait bool, cleanup func(chan error, string) error) (*tasks.TaskTree, error)
 	NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(ctx context.Context, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter) (*tasks.TaskTree, error)
 	NewUnmanagedNodeGroupTask(ctx context.Context, nodeGroups []*v1alpha5.NodeGroup, forceAddCNIPolicy bool, importer vpc.Importer) *tasks.TaskTree
+	NodeGroupStacksJSON(ctx context.Context) ([]byte, error)
+	NodeGroupTemplateDrift(ctx context.Context, ng *v1alpha5.NodeGroup) (bool, string, error)
+	PropagateManagedNodeGroupTagsToSecurityGroups(ctx context.Context, ngName string, tags map[string]string) error
+	PropagateManagedNodeGroupTagsToVolumes(ctx context.Context, ngName string, tags map[string]string) error
+	ReconcileManagedNodeGroupTagsToASG(ctx context.Context, ngName string, desired map[string]string) error
+	RefreshClusterStackCache()
 	RefreshFargatePodExecutionRoleARN(ctx context.Context) error
+	RollbackStack(ctx context.Context, stackName string, skipResources ...string) error
+	SetAutoScalingGroupCapacity(ctx context.Context, name string, cfg ScalingConfig) error
+	SetDeploymentID(id string)
+	SetDescribeConcurrency(n int) error
+	SetStackNamer(namer StackNamer)
+	SetTemplateBucket(bucket string)
+	SetTerminationProtection(ctx context.Context, stackName string, enabled bool) error
 	StackStatusIsNotReady(s *Stack) bool
 	StackStatusIsNotTransitional(s *Stack) bool
+	TotalDesiredCapacity(ctx context.Context) (int, error)
 	UpdateNodeGroupStack(ctx context.Context, nodeGroupName, template string, wait bool) error
 	UpdateStack(ctx context.Context, options UpdateStackOptions) error
+	UpdateStackWithChanges(ctx context.Context, options UpdateStackOptions) (*ChangeSummary, error)
+	UsesCustomLaunchTemplate(ctx context.Context, ngName string) (bool, string, error)
+	ValidateNodeGroupOwnership(ctx context.Context) ([]OwnershipWarning, error)
+	ValidateNodeGroupSubnetAZs(ctx context.Context, ngName string) ([]string, error)
+	ValidateTemplate(ctx context.Context, templateData TemplateData) (*ValidationResult, error)
+	WaitForASGInService(ctx context.Context, name string, timeout time.Duration) error
+	WaitForNodeGroupStacks(ctx context.Context, names []string, timeout time.Duration) ([]NodeGroupStackResult, error)
+	WriteNodeGroupInventoryCSV(ctx context.Context, w io.Writer) error
 }
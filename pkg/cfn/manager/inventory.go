@@ -0,0 +1,111 @@
+package manager
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var nodeGroupInventoryHeader = []string{
+	"name", "type", "status", "instance type", "AMI", "min", "max", "desired", "created", "eksctl version",
+}
+
+// WriteNodeGroupInventoryCSV writes a CSV inventory of all nodegroups to w, one row per nodegroup sorted by name.
+// Details that cannot be looked up for a nodegroup are left as empty cells rather than failing the inventory.
+func (c *StackCollection) WriteNodeGroupInventoryCSV(ctx context.Context, w io.Writer) error {
+	stacks, err := c.ListNodeGroupStacks(ctx)
+	if err != nil {
+		return err
+	}
+	sort.Slice(stacks, func(i, j int) bool {
+		return stacks[i].NodeGroupName < stacks[j].NodeGroupName
+	})
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(nodeGroupInventoryHeader); err != nil {
+		return err
+	}
+	for _, s := range stacks {
+		if err := cw.Write(c.makeNodeGroupInventoryRecord(ctx, s)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func (c *StackCollection) makeNodeGroupInventoryRecord(ctx context.Context, s NodeGroupStack) []string {
+	var (
+		instanceTypes []string
+		ami, created  string
+		err           error
+	)
+	if s.Type == api.NodeGroupTypeManaged {
+		instanceTypes, err = c.getManagedNodeGroupInstanceTypes(ctx, s.Stack)
+	} else {
+		instanceTypes, err = c.getUnmanagedNodeGroupInstanceTypes(ctx, s.Stack)
+	}
+	if err != nil {
+		logger.Debug("couldn't get the instance types of nodegroup %q: %v", s.NodeGroupName, err)
+	}
+
+	if ami, err = c.GetNodeGroupAMI(ctx, s.NodeGroupName); err != nil {
+		logger.Debug("couldn't get the AMI of nodegroup %q: %v", s.NodeGroupName, err)
+	}
+
+	capacity := []string{"", "", ""}
+	if scaling, err := c.getNodeGroupCapacity(ctx, s); err != nil {
+		logger.Debug("couldn't get the capacity of nodegroup %q: %v", s.NodeGroupName, err)
+	} else if scaling != nil {
+		capacity = []string{strconv.Itoa(scaling.Min), strconv.Itoa(scaling.Max), strconv.Itoa(scaling.Desired)}
+	}
+
+	if createdAt := s.CreatedAt(); !createdAt.IsZero() {
+		created = createdAt.UTC().Format(time.RFC3339)
+	}
+
+	return append([]string{s.NodeGroupName, string(s.Type), string(s.Stack.StackStatus), strings.Join(instanceTypes, ","), ami},
+		append(capacity, created, getEksctlVersionTag(s.Stack.Tags))...)
+}
+
+// getNodeGroupCapacity returns the scaling configuration of the given nodegroup, or nil when it has none
+func (c *StackCollection) getNodeGroupCapacity(ctx context.Context, s NodeGroupStack) (*ScalingConfig, error) {
+	if s.Type == api.NodeGroupTypeManaged {
+		res, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+			ClusterName:   aws.String(getClusterNameTag(s.Stack)),
+			NodegroupName: aws.String(s.NodeGroupName),
+		})
+		if err != nil {
+			return nil, err
+		}
+		scaling := res.Nodegroup.ScalingConfig
+		if scaling == nil {
+			return nil, nil
+		}
+		return &ScalingConfig{
+			Min:     int(aws.Int64Value(scaling.MinSize)),
+			Max:     int(aws.Int64Value(scaling.MaxSize)),
+			Desired: int(aws.Int64Value(scaling.DesiredSize)),
+		}, nil
+	}
+
+	asgName, err := c.GetUnmanagedNodeGroupAutoScalingGroupName(ctx, s.Stack)
+	if err != nil {
+		return nil, err
+	}
+	capacity, err := c.GetAutoScalingGroupCapacity(ctx, asgName)
+	if err != nil {
+		return nil, err
+	}
+	return &capacity, nil
+}
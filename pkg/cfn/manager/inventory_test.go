@@ -0,0 +1,76 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection Inventory", func() {
+	It("writes a CSV row per nodegroup, leaving unknown details empty", func() {
+		p := mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+
+		stacks := map[string]types.Stack{
+			"eksctl-test-cluster-nodegroup-ng-1": {
+				StackStatus:  types.StackStatusCreateComplete,
+				CreationTime: aws.Time(time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)),
+				Tags: []types.Tag{
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+					{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeUnmanaged))},
+					{Key: aws.String(api.EksctlVersionTag), Value: aws.String("0.90.0")},
+				},
+			},
+			"eksctl-test-cluster-nodegroup-ng-2": {
+				StackStatus: types.StackStatusUpdateComplete,
+				Tags: []types.Tag{
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-2")},
+					{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeManaged))},
+				},
+			},
+		}
+		var summaries []types.StackSummary
+		for stackName, stack := range stacks {
+			stack.StackName = aws.String(stackName)
+			summaries = append(summaries, types.StackSummary{StackName: aws.String(stackName)})
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: aws.String(stackName)}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{stack},
+			}, nil)
+		}
+		p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{
+			StackSummaries: summaries,
+		}, nil)
+
+		p.MockCloudFormation().On("GetTemplate", mock.Anything, mock.Anything).Return(&cfn.GetTemplateOutput{
+			TemplateBody: aws.String(`{"Resources": {"NodeGroupLaunchTemplate": {"Type": "AWS::EC2::LaunchTemplate", "Properties": {"LaunchTemplateData": {"ImageId": "ami-123", "InstanceType": "m5.large"}}}}}`),
+		}, nil)
+		p.MockCloudFormation().On("DescribeStackResource", mock.Anything, mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+			StackResourceDetail: &types.StackResourceDetail{PhysicalResourceId: aws.String("asg-1")},
+		}, nil)
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []asgtypes.AutoScalingGroup{{MinSize: aws.Int32(1), MaxSize: aws.Int32(3), DesiredCapacity: aws.Int32(2)}},
+		}, nil)
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(nil, errors.New("access denied"))
+
+		var buf bytes.Buffer
+		Expect(NewStackCollection(p, cfg).WriteNodeGroupInventoryCSV(context.TODO(), &buf)).To(Succeed())
+		Expect(buf.String()).To(Equal(`name,type,status,instance type,AMI,min,max,desired,created,eksctl version
+ng-1,unmanaged,CREATE_COMPLETE,m5.large,ami-123,1,3,2,2022-03-01T12:00:00Z,0.90.0
+ng-2,managed,UPDATE_COMPLETE,,,,,,,
+`))
+	})
+})
@@ -0,0 +1,51 @@
+package manager
+
+import (
+	"fmt"
+)
+
+// StackNamer determines the names of the stacks of a cluster; the stacks of a cluster are looked up by
+// the same StackNamer that named them, so it must not change over the lifetime of a cluster
+type StackNamer interface {
+	// ClusterStackName returns the name of the cluster stack of the given cluster
+	ClusterStackName(clusterName string) string
+	// NodeGroupStackName returns the name of the stack of the given nodegroup of the given cluster
+	NodeGroupStackName(clusterName, nodeGroupName string) string
+	// StacksRegex returns a regular expression matching the names of all stacks of the given cluster
+	StacksRegex(clusterName string) string
+	// ClusterStacksRegex returns a regular expression matching the names of the cluster stacks of all clusters
+	ClusterStacksRegex() string
+}
+
+// DefaultStackNamer names stacks after the eksctl-<cluster>-<kind> convention
+type DefaultStackNamer struct{}
+
+// ClusterStackName returns eksctl-<cluster>-cluster
+func (DefaultStackNamer) ClusterStackName(clusterName string) string {
+	return "eksctl-" + clusterName + "-cluster"
+}
+
+// NodeGroupStackName returns eksctl-<cluster>-nodegroup-<nodegroup>
+func (DefaultStackNamer) NodeGroupStackName(clusterName, nodeGroupName string) string {
+	return fmt.Sprintf("eksctl-%s-nodegroup-%s", clusterName, nodeGroupName)
+}
+
+// StacksRegex matches the stacks of the cluster, including those created by legacy versions of eksctl
+func (DefaultStackNamer) StacksRegex(clusterName string) string {
+	return fmt.Sprintf(ourStackRegexFmt, clusterName)
+}
+
+// ClusterStacksRegex matches eksctl-<cluster>-cluster
+func (DefaultStackNamer) ClusterStacksRegex() string {
+	return clusterStackRegex
+}
+
+// SetStackNamer sets the StackNamer used to name and look up the stacks of the cluster
+func (c *StackCollection) SetStackNamer(namer StackNamer) {
+	c.stackNamer = namer
+}
+
+// isClusterStack reports whether s is a cluster stack, named either by the StackNamer or after the legacy naming convention
+func (c *StackCollection) isClusterStack(s *Stack) bool {
+	return *s.StackName == c.MakeClusterStackName() || getClusterName(s) != ""
+}
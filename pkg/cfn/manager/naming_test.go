@@ -0,0 +1,110 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+type acmeStackNamer struct{}
+
+func (acmeStackNamer) ClusterStackName(clusterName string) string {
+	return "acme-" + clusterName
+}
+
+func (acmeStackNamer) NodeGroupStackName(clusterName, nodeGroupName string) string {
+	return fmt.Sprintf("acme-%s-ng-%s", clusterName, nodeGroupName)
+}
+
+func (acmeStackNamer) StacksRegex(clusterName string) string {
+	return fmt.Sprintf("^acme-%s(-ng-.+)?$", clusterName)
+}
+
+func (acmeStackNamer) ClusterStacksRegex() string {
+	return "^acme-[^-]+$"
+}
+
+var _ = Describe("StackNamer", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sm StackManager
+	)
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test"
+		p = mockprovider.NewMockProvider()
+		sm = NewStackCollection(p, cfg)
+		sm.SetStackNamer(acmeStackNamer{})
+	})
+
+	It("names the stacks after the default convention", func() {
+		sm = NewStackCollection(p, &api.ClusterConfig{Metadata: &api.ClusterMeta{Name: "test"}})
+		Expect(sm.MakeClusterStackName()).To(Equal("eksctl-test-cluster"))
+	})
+
+	It("uses the custom namer to create and describe the stacks of the cluster", func() {
+		clusterTag := types.Tag{Key: aws.String(api.ClusterNameTag), Value: aws.String("test")}
+		clusterStack := types.Stack{StackName: aws.String("acme-test"), Tags: []types.Tag{clusterTag}}
+		nodeGroupStack := types.Stack{
+			StackName:   aws.String("acme-test-ng-ng-1"),
+			StackStatus: types.StackStatusImportComplete,
+			Tags:        []types.Tag{clusterTag, {Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")}},
+		}
+
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []asgtypes.AutoScalingGroup{{
+				AutoScalingGroupName:    aws.String("my-asg"),
+				LaunchConfigurationName: aws.String("my-lc"),
+			}},
+		}, nil)
+		p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(&cfn.CreateChangeSetOutput{}, nil)
+		p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+			StackName: nodeGroupStack.StackName,
+			Status:    types.ChangeSetStatusCreateComplete,
+		}, nil)
+		p.MockCloudFormation().On("ExecuteChangeSet", mock.Anything, mock.Anything).Return(&cfn.ExecuteChangeSetOutput{}, nil)
+		p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{
+			StackSummaries: []types.StackSummary{
+				{StackName: clusterStack.StackName},
+				{StackName: nodeGroupStack.StackName},
+				{StackName: aws.String("eksctl-test-cluster")},
+			},
+		}, nil)
+		for _, s := range []types.Stack{clusterStack, nodeGroupStack} {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: s.StackName}, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{s},
+			}, nil)
+		}
+
+		Expect(sm.ImportUnmanagedNodeGroup(context.TODO(), "ng-1", "my-asg")).To(Succeed())
+		input := p.MockCloudFormation().Calls[0].Arguments.Get(1).(*cfn.CreateChangeSetInput)
+		Expect(*input.StackName).To(Equal("acme-test-ng-ng-1"))
+
+		stack, err := sm.DescribeNodeGroupStack(context.TODO(), "ng-1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*stack.StackName).To(Equal("acme-test-ng-ng-1"))
+
+		cluster, nodegroups, others, err := sm.DescribeAllClusterStacks(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*cluster.StackName).To(Equal("acme-test"))
+		Expect(nodegroups).To(HaveLen(1))
+		Expect(*nodegroups[0].StackName).To(Equal("acme-test-ng-ng-1"))
+		Expect(others).To(BeEmpty())
+
+		clusterStackNames, err := sm.ListClusterStackNames(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusterStackNames).To(ConsistOf("acme-test"))
+	})
+})
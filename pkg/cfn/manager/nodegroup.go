@@ -2,22 +2,33 @@ package manager
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 
 	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/blang/semver"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
 	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
 	"github.com/weaveworks/eksctl/pkg/version"
 	"github.com/weaveworks/eksctl/pkg/vpc"
@@ -30,13 +41,29 @@ type NodeGroupStack struct {
 	Stack         *Stack
 }
 
+// CreatedAt returns the time the nodegroup stack was created, or the zero time when unknown
+func (s NodeGroupStack) CreatedAt() time.Time {
+	if s.Stack == nil {
+		return time.Time{}
+	}
+	return aws.TimeValue(s.Stack.CreationTime)
+}
+
+// UpdatedAt returns the time the nodegroup stack was last updated, or the zero time when it has never been updated
+func (s NodeGroupStack) UpdatedAt() time.Time {
+	if s.Stack == nil {
+		return time.Time{}
+	}
+	return aws.TimeValue(s.Stack.LastUpdatedTime)
+}
+
 // makeNodeGroupStackName generates the name of the nodegroup stack identified by its name, isolated by the cluster this StackCollection operates on
 func (c *StackCollection) makeNodeGroupStackName(name string) string {
-	return fmt.Sprintf("eksctl-%s-nodegroup-%s", c.spec.Metadata.Name, name)
+	return c.stackNamer.NodeGroupStackName(c.spec.Metadata.Name, name)
 }
 
 // createNodeGroupTask creates the nodegroup
-func (c *StackCollection) createNodeGroupTask(ctx context.Context, errs chan error, ng *api.NodeGroup, forceAddCNIPolicy bool, vpcImporter vpc.Importer) error {
+func (c *StackCollection) createNodeGroupTask(ctx context.Context, errs chan error, ng *api.NodeGroup, forceAddCNIPolicy bool, vpcImporter vpc.Importer, validateTemplate bool) error {
 	name := c.makeNodeGroupStackName(ng.Name)
 
 	logger.Info("building nodegroup stack %q", name)
@@ -56,7 +83,10 @@ func (c *StackCollection) createNodeGroupTask(ctx context.Context, errs chan err
 	ng.Tags[api.OldNodeGroupNameTag] = ng.Name
 	ng.Tags[api.NodeGroupTypeTag] = string(api.NodeGroupTypeUnmanaged)
 
-	return c.CreateStack(ctx, name, stack, ng.Tags, nil, errs)
+	if validateTemplate {
+		return c.createValidatedStack(ctx, name, stack, makeNodeGroupStackTags(ng.NodeGroupBase), nil, errs)
+	}
+	return c.CreateStack(ctx, name, stack, makeNodeGroupStackTags(ng.NodeGroupBase), nil, errs)
 }
 
 func (c *StackCollection) createManagedNodeGroupTask(ctx context.Context, errorCh chan error, ng *api.ManagedNodeGroup, forceAddCNIPolicy bool, vpcImporter vpc.Importer) error {
@@ -75,7 +105,80 @@ func (c *StackCollection) createManagedNodeGroupTask(ctx context.Context, errorC
 		return err
 	}
 
-	return c.CreateStack(ctx, name, stack, ng.Tags, nil, errorCh)
+	return c.CreateStack(ctx, name, stack, makeNodeGroupStackTags(ng.NodeGroupBase), nil, errorCh)
+}
+
+// makeNodeGroupStackTags returns the tags of the nodegroup's stack, which are its StackTags when set, along with
+// the tags identifying the nodegroup, or its Tags otherwise
+func makeNodeGroupStackTags(ng *api.NodeGroupBase) map[string]string {
+	if ng.StackTags == nil {
+		return ng.Tags
+	}
+	tags := make(map[string]string, len(ng.StackTags))
+	for k, v := range ng.StackTags {
+		tags[k] = v
+	}
+	for _, key := range []string{api.NodeGroupNameTag, api.OldNodeGroupNameTag, api.NodeGroupTypeTag} {
+		if v, ok := ng.Tags[key]; ok {
+			tags[key] = v
+		}
+	}
+	return tags
+}
+
+// NodeGroupSpec describes a nodegroup stack to create; exactly one of NodeGroup and ManagedNodeGroup must be set
+type NodeGroupSpec struct {
+	NodeGroup         *api.NodeGroup
+	ManagedNodeGroup  *api.ManagedNodeGroup
+	ForceAddCNIPolicy bool
+	VPCImporter       vpc.Importer
+	// ValidateTemplate validates the template of unmanaged nodegroups with CloudFormation before creating their stack
+	ValidateTemplate bool
+}
+
+func (s NodeGroupSpec) name() string {
+	if s.ManagedNodeGroup != nil {
+		return s.ManagedNodeGroup.Name
+	}
+	return s.NodeGroup.Name
+}
+
+// CreateNodeGroupStacks creates the stacks of the given nodegroups and waits until they are created, with at most
+// parallelism stacks in flight; a failure to create one nodegroup does not prevent the creation of others, and all
+// failures are reported in the returned error
+func (c *StackCollection) CreateNodeGroupStacks(ctx context.Context, ngs []NodeGroupSpec, parallelism int) error {
+	if parallelism <= 0 {
+		return fmt.Errorf("parallelism must be positive, got %d", parallelism)
+	}
+	for i, ng := range ngs {
+		if (ng.NodeGroup == nil) == (ng.ManagedNodeGroup == nil) {
+			return fmt.Errorf("exactly one of NodeGroup and ManagedNodeGroup must be set for nodegroup spec at index %d", i)
+		}
+	}
+
+	errs := make([]error, len(ngs))
+	if err := forEachConcurrently(ctx, len(ngs), parallelism, func(i int) {
+		if err := c.createNodeGroupStack(ctx, ngs[i]); err != nil {
+			errs[i] = errors.Wrapf(err, "creating nodegroup %q", ngs[i].name())
+		}
+	}); err != nil {
+		return err
+	}
+	return combineErrors(errs)
+}
+
+func (c *StackCollection) createNodeGroupStack(ctx context.Context, ng NodeGroupSpec) error {
+	errCh := make(chan error)
+	var err error
+	if ng.ManagedNodeGroup != nil {
+		err = c.createManagedNodeGroupTask(ctx, errCh, ng.ManagedNodeGroup, ng.ForceAddCNIPolicy, ng.VPCImporter)
+	} else {
+		err = c.createNodeGroupTask(ctx, errCh, ng.NodeGroup, ng.ForceAddCNIPolicy, ng.VPCImporter, ng.ValidateTemplate)
+	}
+	if err != nil {
+		return err
+	}
+	return <-errCh
 }
 
 // DescribeNodeGroupStacks calls DescribeStacks and filters out nodegroups
@@ -106,7 +209,81 @@ func (c *StackCollection) DescribeNodeGroupStacks(ctx context.Context) ([]*Stack
 	return nodeGroupStacks, nil
 }
 
-// ListNodeGroupStacks returns a list of NodeGroupStacks
+// DescribeNodeGroupStacksMatchingTags calls DescribeNodeGroupStacks and filters out the stacks
+// that do not bear all of the given tags
+func (c *StackCollection) DescribeNodeGroupStacksMatchingTags(ctx context.Context, filters map[string]string) ([]*Stack, error) {
+	stacks, err := c.DescribeNodeGroupStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var matching []*Stack
+	for _, s := range stacks {
+		if hasTags(s.Tags, filters) {
+			matching = append(matching, s)
+		}
+	}
+	return matching, nil
+}
+
+// OwnershipWarning describes a nodegroup stack that is named after this cluster but tagged as belonging to another one
+type OwnershipWarning struct {
+	StackName   string
+	ClusterName string
+}
+
+// ValidateNodeGroupOwnership calls DescribeNodeGroupStacks and reports the stacks that are tagged with the name of
+// a different cluster, which happens when the stack names of clusters in the same account collide
+func (c *StackCollection) ValidateNodeGroupOwnership(ctx context.Context) ([]OwnershipWarning, error) {
+	stacks, err := c.DescribeNodeGroupStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var warnings []OwnershipWarning
+	for _, s := range stacks {
+		clusterName := getClusterNameTag(s)
+		if clusterName == "" || clusterName == c.spec.Metadata.Name {
+			continue
+		}
+		logger.Warning("stack %q is tagged as belonging to cluster %q rather than %q", *s.StackName, clusterName, c.spec.Metadata.Name)
+		warnings = append(warnings, OwnershipWarning{
+			StackName:   *s.StackName,
+			ClusterName: clusterName,
+		})
+	}
+	return warnings, nil
+}
+
+// DescribeNodeGroupStacksCreatedBetween calls DescribeNodeGroupStacks and filters out the stacks
+// that were not created between start and end, inclusive
+func (c *StackCollection) DescribeNodeGroupStacksCreatedBetween(ctx context.Context, start, end time.Time) ([]*Stack, error) {
+	stacks, err := c.DescribeNodeGroupStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var matching []*Stack
+	for _, s := range stacks {
+		if s.CreationTime == nil || s.CreationTime.Before(start) || s.CreationTime.After(end) {
+			continue
+		}
+		matching = append(matching, s)
+	}
+	return matching, nil
+}
+
+func hasTags(tags []types.Tag, filters map[string]string) bool {
+	values := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		values[*tag.Key] = *tag.Value
+	}
+	for k, v := range filters {
+		if value, ok := values[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// ListNodeGroupStacks returns a list of all NodeGroupStacks; see ListNodeGroupStacksPaged to list them a page at a time
 func (c *StackCollection) ListNodeGroupStacks(ctx context.Context) ([]NodeGroupStack, error) {
 	stacks, err := c.DescribeNodeGroupStacks(ctx)
 	if err != nil {
@@ -127,26 +304,185 @@ func (c *StackCollection) ListNodeGroupStacks(ctx context.Context) ([]NodeGroupS
 	return nodeGroupStacks, nil
 }
 
-// DescribeNodeGroupStacksAndResources calls DescribeNodeGroupStacks and fetches all resources,
-// then returns it in a map by nodegroup name
-func (c *StackCollection) DescribeNodeGroupStacksAndResources(ctx context.Context) (map[string]StackInfo, error) {
-	stacks, err := c.DescribeNodeGroupStacks(ctx)
+// nodeGroupStacksPageToken is the position in the stack listing at which the next page of nodegroup stacks starts:
+// the summary at Offset in the ListStacks page requested with NextToken
+type nodeGroupStacksPageToken struct {
+	NextToken string `json:"nextToken,omitempty"`
+	Offset    int    `json:"offset"`
+}
+
+// ListNodeGroupStacksPaged returns at most pageSize NodeGroupStacks starting at the position identified by token,
+// along with the token of the next page, which is empty on the last page. An empty token starts at the first page.
+func (c *StackCollection) ListNodeGroupStacksPaged(ctx context.Context, pageSize int, token string) ([]NodeGroupStack, string, error) {
+	if pageSize <= 0 {
+		return nil, "", fmt.Errorf("invalid page size %d", pageSize)
+	}
+	var position nodeGroupStacksPageToken
+	if token != "" {
+		data, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "invalid page token")
+		}
+		if err := json.Unmarshal(data, &position); err != nil {
+			return nil, "", errors.Wrap(err, "invalid page token")
+		}
+	}
+	re, err := regexp.Compile(c.stackNamer.StacksRegex(c.spec.Metadata.Name))
+	if err != nil {
+		return nil, "", errors.Wrap(err, "cannot list stacks")
+	}
+
+	var nodeGroupStacks []NodeGroupStack
+	for {
+		input := &cfn.ListStacksInput{
+			StackStatusFilter: defaultStackStatusFilter(),
+		}
+		if position.NextToken != "" {
+			input.NextToken = aws.String(position.NextToken)
+		}
+		out, err := c.cloudformationAPI.ListStacks(ctx, input)
+		if err != nil {
+			return nil, "", errors.Wrapf(err, "listing CloudFormation stacks for %q", c.spec.Metadata.Name)
+		}
+		nextToken := aws.StringValue(out.NextToken)
+
+		for i := position.Offset; i < len(out.StackSummaries); i++ {
+			summary := out.StackSummaries[i]
+			if !re.MatchString(aws.StringValue(summary.StackName)) {
+				continue
+			}
+			stack, err := c.DescribeStack(ctx, &Stack{StackName: summary.StackName, StackId: summary.StackId})
+			if err != nil {
+				return nil, "", err
+			}
+			if stack.StackStatus == types.StackStatusDeleteFailed || c.GetNodeGroupName(stack) == "" {
+				continue
+			}
+			nodeGroupType, err := GetNodeGroupType(stack.Tags)
+			if err != nil {
+				return nil, "", err
+			}
+			nodeGroupStacks = append(nodeGroupStacks, NodeGroupStack{
+				NodeGroupName: c.GetNodeGroupName(stack),
+				Type:          nodeGroupType,
+				Stack:         stack,
+			})
+
+			if len(nodeGroupStacks) == pageSize {
+				next := nodeGroupStacksPageToken{NextToken: position.NextToken, Offset: i + 1}
+				if next.Offset == len(out.StackSummaries) {
+					if nextToken == "" {
+						return nodeGroupStacks, "", nil
+					}
+					next = nodeGroupStacksPageToken{NextToken: nextToken}
+				}
+				data, err := json.Marshal(next)
+				if err != nil {
+					return nil, "", err
+				}
+				return nodeGroupStacks, base64.RawURLEncoding.EncodeToString(data), nil
+			}
+		}
+
+		if nextToken == "" {
+			return nodeGroupStacks, "", nil
+		}
+		position = nodeGroupStacksPageToken{NextToken: nextToken}
+	}
+}
+
+// ListNodeGroupStacksByType returns the NodeGroupStacks of the given nodegroup type; stacks without
+// a type tag are unmanaged nodegroups
+func (c *StackCollection) ListNodeGroupStacksByType(ctx context.Context, ngType api.NodeGroupType) ([]NodeGroupStack, error) {
+	stacks, err := c.ListNodeGroupStacks(ctx)
 	if err != nil {
 		return nil, err
 	}
+	var filtered []NodeGroupStack
+	for _, s := range stacks {
+		if s.Type == ngType {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered, nil
+}
 
-	allResources := make(map[string]StackInfo)
+// NodeGroupStackSummary is the machine-readable representation of a nodegroup stack
+type NodeGroupStackSummary struct {
+	Name          string            `json:"name"`
+	Type          api.NodeGroupType `json:"type"`
+	StackName     string            `json:"stackName"`
+	StackStatus   string            `json:"stackStatus"`
+	CreationTime  time.Time         `json:"creationTime"`
+	EksctlVersion string            `json:"eksctlVersion,omitempty"`
+}
 
+// NodeGroupStacksJSON returns a JSON list of the summaries of all nodegroup stacks, sorted by nodegroup name
+func (c *StackCollection) NodeGroupStacksJSON(ctx context.Context) ([]byte, error) {
+	stacks, err := c.ListNodeGroupStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	summaries := make([]NodeGroupStackSummary, 0, len(stacks))
 	for _, s := range stacks {
-		input := &cfn.DescribeStackResourcesInput{
-			StackName: s.StackName,
+		summaries = append(summaries, NodeGroupStackSummary{
+			Name:          s.NodeGroupName,
+			Type:          s.Type,
+			StackName:     aws.StringValue(s.Stack.StackName),
+			StackStatus:   string(s.Stack.StackStatus),
+			CreationTime:  aws.TimeValue(s.Stack.CreationTime),
+			EksctlVersion: getEksctlVersionTag(s.Stack.Tags),
+		})
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Name < summaries[j].Name
+	})
+	return json.Marshal(summaries)
+}
+
+func getEksctlVersionTag(tags []types.Tag) string {
+	for _, tag := range tags {
+		if *tag.Key == api.EksctlVersionTag {
+			return *tag.Value
 		}
-		resources, err := c.cloudformationAPI.DescribeStackResources(ctx, input)
+	}
+	return ""
+}
+
+// DescribeNodeGroupStacksAndResources calls DescribeNodeGroupStacks and fetches all resources,
+// then returns it in a map by nodegroup name; resources are fetched concurrently, with at most
+// describeConcurrency requests in flight
+func (c *StackCollection) DescribeNodeGroupStacksAndResources(ctx context.Context) (map[string]StackInfo, error) {
+	stacks, err := c.DescribeNodeGroupStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		resources = make([][]types.StackResource, len(stacks))
+		errs      = make([]error, len(stacks))
+	)
+	if err := forEachConcurrently(ctx, len(stacks), c.describeConcurrency, func(i int) {
+		out, err := c.cloudformationAPI.DescribeStackResources(ctx, &cfn.DescribeStackResourcesInput{
+			StackName: stacks[i].StackName,
+		})
 		if err != nil {
-			return nil, errors.Wrapf(err, "getting all resources for %q stack", *s.StackName)
+			errs[i] = errors.Wrapf(err, "getting all resources for %q stack", *stacks[i].StackName)
+			return
 		}
+		resources[i] = out.StackResources
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := combineErrors(errs); err != nil {
+		return nil, err
+	}
+
+	allResources := make(map[string]StackInfo)
+	for i, s := range stacks {
 		allResources[c.GetNodeGroupName(s)] = StackInfo{
-			Resources: resources.StackResources,
+			Resources: resources[i],
 			Stack:     s,
 		}
 	}
@@ -154,6 +490,29 @@ func (c *StackCollection) DescribeNodeGroupStacksAndResources(ctx context.Contex
 	return allResources, nil
 }
 
+// DescribeNodeGroupStackAndResources describes the stack of the given nodegroup and fetches all of its resources
+func (c *StackCollection) DescribeNodeGroupStackAndResources(ctx context.Context, ngName string) (StackInfo, error) {
+	stackName := c.makeNodeGroupStackName(ngName)
+	stack, found, err := c.DescribeStackIfExists(ctx, &Stack{StackName: &stackName})
+	if err != nil {
+		return StackInfo{}, err
+	}
+	if !found {
+		return StackInfo{}, fmt.Errorf("stack %q of nodegroup %q not found", stackName, ngName)
+	}
+
+	out, err := c.cloudformationAPI.DescribeStackResources(ctx, &cfn.DescribeStackResourcesInput{
+		StackName: stack.StackName,
+	})
+	if err != nil {
+		return StackInfo{}, errors.Wrapf(err, "getting all resources for %q stack", stackName)
+	}
+	return StackInfo{
+		Stack:     stack,
+		Resources: out.StackResources,
+	}, nil
+}
+
 func (c *StackCollection) GetAutoScalingGroupName(ctx context.Context, s *Stack) (string, error) {
 
 	nodeGroupType, err := GetNodeGroupType(s.Tags)
@@ -182,16 +541,7 @@ func (c *StackCollection) GetAutoScalingGroupName(ctx context.Context, s *Stack)
 
 // GetNodeGroupAutoScalingGroupName returns the unmanaged nodegroup's AutoScalingGroupName
 func (c *StackCollection) GetUnmanagedNodeGroupAutoScalingGroupName(ctx context.Context, s *Stack) (string, error) {
-	input := &cfn.DescribeStackResourceInput{
-		StackName:         s.StackName,
-		LogicalResourceId: aws.String("NodeGroup"),
-	}
-
-	res, err := c.cloudformationAPI.DescribeStackResource(ctx, input)
-	if err != nil {
-		return "", err
-	}
-	return *res.StackResourceDetail.PhysicalResourceId, nil
+	return c.GetStackPhysicalResourceID(ctx, *s.StackName, "NodeGroup")
 }
 
 // GetManagedNodeGroupAutoScalingGroupName returns the managed nodegroup's AutoScalingGroup names
@@ -206,100 +556,1439 @@ func (c *StackCollection) getManagedNodeGroupAutoScalingGroupName(ctx context.Co
 		logger.Warning("couldn't get managed nodegroup details for stack %q", *s.StackName)
 		return "", nil
 	}
+	return managedNodeGroupAutoScalingGroupNames(res.Nodegroup), nil
+}
 
-	var asgs []string
+// waitForManagedNodeGroupAutoScalingGroupNames works like getManagedNodeGroupAutoScalingGroupName, but as EKS may
+// report the AutoScalingGroups of a nodegroup only some time after it was created, polls the nodegroup until they
+// are reported, the nodegroup is neither being created nor active, or nodeGroupResourcesWaitTimeout elapses.
+// Errors describing the nodegroup are returned immediately
+func (c *StackCollection) waitForManagedNodeGroupAutoScalingGroupNames(ctx context.Context, s *Stack) (string, error) {
+	input := &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(getClusterNameTag(s)),
+		NodegroupName: aws.String(c.GetNodeGroupName(s)),
+	}
+
+	var asgNames string
+	w := &waiter.Waiter{
+		NextDelay: func(attempts int) time.Duration {
+			if attempts == 1 {
+				return 0
+			}
+			return c.nodeGroupResourcesPollInterval
+		},
+		Operation: func() (bool, error) {
+			res, err := c.eksAPI.DescribeNodegroup(input)
+			if err != nil {
+				return false, errors.Wrapf(err, "describing managed nodegroup %q", *input.NodegroupName)
+			}
+			asgNames = managedNodeGroupAutoScalingGroupNames(res.Nodegroup)
+			if asgNames != "" {
+				return true, nil
+			}
+			switch aws.StringValue(res.Nodegroup.Status) {
+			case eks.NodegroupStatusCreating, eks.NodegroupStatusActive:
+				logger.Debug("waiting for the autoscaling groups of managed nodegroup %q", *input.NodegroupName)
+				return false, nil
+			default:
+				return true, nil
+			}
+		},
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, c.nodeGroupResourcesWaitTimeout)
+	defer cancel()
+	if err := w.Wait(timeoutCtx); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			logger.Warning("EKS did not report the autoscaling groups of managed nodegroup %q within %v", *input.NodegroupName, c.nodeGroupResourcesWaitTimeout)
+			return "", nil
+		}
+		return "", err
+	}
+	return asgNames, nil
+}
 
-	if res.Nodegroup.Resources != nil {
-		for _, v := range res.Nodegroup.Resources.AutoScalingGroups {
+func managedNodeGroupAutoScalingGroupNames(ng *eks.Nodegroup) string {
+	var asgs []string
+	if ng.Resources != nil {
+		for _, v := range ng.Resources.AutoScalingGroups {
 			asgs = append(asgs, aws.StringValue(v.Name))
 		}
 	}
-	return strings.Join(asgs, ","), nil
+	return strings.Join(asgs, ",")
 }
 
-func (c *StackCollection) GetAutoScalingGroupDesiredCapacity(ctx context.Context, name string) (asgtypes.AutoScalingGroup, error) {
-	asg, err := c.asgAPI.DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
-		AutoScalingGroupNames: []string{
-			name,
-		},
+// GetManagedNodeGroupLaunchTemplate returns the id and version of the launch template used by the managed
+// nodegroup of the given stack. When the launch template was created by eksctl as part of the stack, its id and
+// version are returned along with ErrEksctlManagedLaunchTemplate
+func (c *StackCollection) GetManagedNodeGroupLaunchTemplate(ctx context.Context, s *Stack) (string, string, error) {
+	nodeGroupName := c.GetNodeGroupName(s)
+	res, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(getClusterNameTag(s)),
+		NodegroupName: aws.String(nodeGroupName),
 	})
-
 	if err != nil {
-		return asgtypes.AutoScalingGroup{}, fmt.Errorf("couldn't describe ASG: %s", name)
+		return "", "", errors.Wrapf(err, "describing managed nodegroup %q", nodeGroupName)
 	}
-	if len(asg.AutoScalingGroups) != 1 {
-		logger.Warning("couldn't find ASG %s", name)
-		return asgtypes.AutoScalingGroup{}, fmt.Errorf("couldn't find ASG: %s", name)
+	lt := res.Nodegroup.LaunchTemplate
+	if lt == nil {
+		return "", "", fmt.Errorf("managed nodegroup %q does not use a launch template", nodeGroupName)
 	}
+	id, version := aws.StringValue(lt.Id), aws.StringValue(lt.Version)
 
-	return asg.AutoScalingGroups[0], nil
-}
-
-// DescribeNodeGroupStack gets the specified nodegroup stack
-func (c *StackCollection) DescribeNodeGroupStack(ctx context.Context, nodeGroupName string) (*Stack, error) {
-	stackName := c.makeNodeGroupStackName(nodeGroupName)
-	return c.DescribeStack(ctx, &Stack{StackName: &stackName})
+	eksctlManaged, err := c.isStackLaunchTemplate(ctx, s, id)
+	if err != nil {
+		return "", "", err
+	}
+	if eksctlManaged {
+		return id, version, ErrEksctlManagedLaunchTemplate
+	}
+	return id, version, nil
 }
 
-// GetNodeGroupStackType returns the nodegroup stack type
-func (c *StackCollection) GetNodeGroupStackType(ctx context.Context, options GetNodegroupOption) (api.NodeGroupType, error) {
-	var (
-		err   error
-		stack *Stack
-	)
-	if options.Stack != nil && options.Stack.Stack != nil {
-		stack = options.Stack.Stack
-	}
-	if stack == nil {
-		stack, err = c.DescribeNodeGroupStack(ctx, options.NodeGroupName)
-		if err != nil {
-			return "", err
+// UsesCustomLaunchTemplate reports whether the given nodegroup uses a launch template supplied by the user rather
+// than one created by eksctl, and returns the id of the launch template it uses, if any. The launch template set in
+// the nodegroup's config takes precedence; otherwise, it is looked up from the nodegroup and its stack
+func (c *StackCollection) UsesCustomLaunchTemplate(ctx context.Context, ngName string) (bool, string, error) {
+	for _, ng := range c.spec.ManagedNodeGroups {
+		if ng.Name == ngName && ng.LaunchTemplate != nil && ng.LaunchTemplate.ID != "" {
+			return true, ng.LaunchTemplate.ID, nil
 		}
 	}
-	return GetNodeGroupType(stack.Tags)
-}
 
-// GetNodeGroupType returns the nodegroup type
-func GetNodeGroupType(tags []types.Tag) (api.NodeGroupType, error) {
-	var nodeGroupType api.NodeGroupType
+	stack, err := c.DescribeNodeGroupStack(ctx, ngName)
+	if err != nil {
+		return false, "", err
+	}
+	nodeGroupType, err := GetNodeGroupType(stack.Tags)
+	if err != nil {
+		return false, "", err
+	}
+	if nodeGroupType != api.NodeGroupTypeManaged {
+		// unmanaged nodegroups always use a launch template created by eksctl
+		id, _, err := c.getUnmanagedNodeGroupLaunchTemplateVersion(ctx, stack)
+		return false, id, err
+	}
 
-	if ngNameTagValue := GetNodegroupTagName(tags); ngNameTagValue == "" {
-		return "", errors.New("failed to find the nodegroup name tag")
+	id, _, err := c.getManagedNodeGroupLaunchTemplateVersion(stack)
+	if err != nil || id == "" {
+		return false, "", err
+	}
+	eksctlManaged, err := c.isStackLaunchTemplate(ctx, stack, id)
+	if err != nil {
+		return false, "", err
 	}
+	return !eksctlManaged, id, nil
+}
 
-	for _, tag := range tags {
-		switch *tag.Key {
-		case api.NodeGroupTypeTag:
-			nodeGroupType = api.NodeGroupType(*tag.Value)
+// isStackLaunchTemplate reports whether the launch template with the given id is a resource of the stack
+func (c *StackCollection) isStackLaunchTemplate(ctx context.Context, s *Stack, id string) (bool, error) {
+	resources, err := c.cloudformationAPI.DescribeStackResources(ctx, &cfn.DescribeStackResourcesInput{
+		StackName: s.StackName,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "describing resources of stack %q", *s.StackName)
+	}
+	for _, r := range resources.StackResources {
+		if aws.StringValue(r.ResourceType) == "AWS::EC2::LaunchTemplate" && aws.StringValue(r.PhysicalResourceId) == id {
+			return true, nil
 		}
 	}
+	return false, nil
+}
 
-	if nodeGroupType == "" {
-		nodeGroupType = api.NodeGroupTypeUnmanaged
+// FindNodeGroupsUsingInstanceTypes returns the names of the nodegroups that use any of the given instance types
+func (c *StackCollection) FindNodeGroupsUsingInstanceTypes(ctx context.Context, instanceTypes []string) ([]string, error) {
+	stacks, err := c.ListNodeGroupStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wanted := make(map[string]bool, len(instanceTypes))
+	for _, it := range instanceTypes {
+		wanted[it] = true
 	}
 
-	return nodeGroupType, nil
-}
-
-// GetEksctlVersionFromTags returns the eksctl version used to create or update the stack
-func GetEksctlVersionFromTags(tags []types.Tag) (semver.Version, bool, error) {
-	for _, tag := range tags {
-		if *tag.Key == api.EksctlVersionTag {
-			v, err := version.ParseEksctlVersion(*tag.Value)
-			if err != nil {
-				return v, false, errors.Wrapf(err, "unexpected error parsing eksctl version %q", *tag.Value)
+	var nodeGroupNames []string
+	for _, s := range stacks {
+		var ngInstanceTypes []string
+		if s.Type == api.NodeGroupTypeManaged {
+			ngInstanceTypes, err = c.getManagedNodeGroupInstanceTypes(ctx, s.Stack)
+		} else {
+			ngInstanceTypes, err = c.getUnmanagedNodeGroupInstanceTypes(ctx, s.Stack)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting instance types of nodegroup %q", s.NodeGroupName)
+		}
+		for _, it := range ngInstanceTypes {
+			if wanted[it] {
+				nodeGroupNames = append(nodeGroupNames, s.NodeGroupName)
+				break
 			}
-			return v, true, nil
 		}
 	}
-	return semver.Version{}, false, nil
+	return nodeGroupNames, nil
 }
 
-// GetNodeGroupName will return nodegroup name based on tags
-func (*StackCollection) GetNodeGroupName(s *Stack) string {
-	if tagName := GetNodegroupTagName(s.Tags); tagName != "" {
+// getManagedNodeGroupInstanceTypes returns the instance types of a managed nodegroup, falling back
+// to the instance type of its launch template when the nodegroup does not specify any
+func (c *StackCollection) getManagedNodeGroupInstanceTypes(ctx context.Context, s *Stack) ([]string, error) {
+	res, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(getClusterNameTag(s)),
+		NodegroupName: aws.String(c.GetNodeGroupName(s)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if instanceTypes := aws.StringValueSlice(res.Nodegroup.InstanceTypes); len(instanceTypes) > 0 {
+		return instanceTypes, nil
+	}
+	lt := res.Nodegroup.LaunchTemplate
+	if lt == nil {
+		return nil, nil
+	}
+	launchTemplateData, err := builder.NewLaunchTemplateFetcher(c.ec2API).Fetch(ctx, &api.LaunchTemplate{
+		ID:      aws.StringValue(lt.Id),
+		Version: lt.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if launchTemplateData.InstanceType == "" {
+		return nil, nil
+	}
+	return []string{string(launchTemplateData.InstanceType)}, nil
+}
+
+// getUnmanagedNodeGroupInstanceTypes returns the instance types of an unmanaged nodegroup from its stack template
+func (c *StackCollection) getUnmanagedNodeGroupInstanceTypes(ctx context.Context, s *Stack) ([]string, error) {
+	template, err := c.GetStackTemplate(ctx, *s.StackName)
+	if err != nil {
+		return nil, err
+	}
+	var instanceTypes []string
+	if it := gjson.Get(template, "Resources.NodeGroupLaunchTemplate.Properties.LaunchTemplateData.InstanceType"); it.Exists() {
+		instanceTypes = append(instanceTypes, it.String())
+	}
+	for _, it := range gjson.Get(template, "Resources.NodeGroup.Properties.MixedInstancesPolicy.LaunchTemplate.Overrides.#.InstanceType").Array() {
+		instanceTypes = append(instanceTypes, it.String())
+	}
+	return instanceTypes, nil
+}
+
+// FindNodeGroupsWithStaleLaunchTemplate returns the names of the nodegroups whose launch template has a newer version
+// than the one they use. Nodegroups using the $Latest version are never stale, while nodegroups using the $Default
+// version are stale when the default version is not the latest one.
+func (c *StackCollection) FindNodeGroupsWithStaleLaunchTemplate(ctx context.Context) ([]string, error) {
+	stacks, err := c.ListNodeGroupStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodeGroupNames []string
+	for _, s := range stacks {
+		var id, version string
+		if s.Type == api.NodeGroupTypeManaged {
+			id, version, err = c.getManagedNodeGroupLaunchTemplateVersion(s.Stack)
+		} else {
+			id, version, err = c.getUnmanagedNodeGroupLaunchTemplateVersion(ctx, s.Stack)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting launch template of nodegroup %q", s.NodeGroupName)
+		}
+		if id == "" {
+			continue
+		}
+		stale, err := c.isLaunchTemplateVersionStale(ctx, id, version)
+		if err != nil {
+			return nil, errors.Wrapf(err, "checking launch template %q of nodegroup %q", id, s.NodeGroupName)
+		}
+		if stale {
+			nodeGroupNames = append(nodeGroupNames, s.NodeGroupName)
+		}
+	}
+	return nodeGroupNames, nil
+}
+
+// getManagedNodeGroupLaunchTemplateVersion returns the id and version of the launch template of a managed nodegroup,
+// or an empty id when it does not use one
+func (c *StackCollection) getManagedNodeGroupLaunchTemplateVersion(s *Stack) (string, string, error) {
+	res, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(getClusterNameTag(s)),
+		NodegroupName: aws.String(c.GetNodeGroupName(s)),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	lt := res.Nodegroup.LaunchTemplate
+	if lt == nil {
+		return "", "", nil
+	}
+	return aws.StringValue(lt.Id), aws.StringValue(lt.Version), nil
+}
+
+// getUnmanagedNodeGroupLaunchTemplateVersion returns the id and version of the launch template used by the
+// autoscaling group of an unmanaged nodegroup, or an empty id when it does not use one
+func (c *StackCollection) getUnmanagedNodeGroupLaunchTemplateVersion(ctx context.Context, s *Stack) (string, string, error) {
+	asgName, err := c.GetUnmanagedNodeGroupAutoScalingGroupName(ctx, s)
+	if err != nil {
+		return "", "", err
+	}
+	asg, err := c.GetAutoScalingGroupDesiredCapacity(ctx, asgName)
+	if err != nil {
+		return "", "", err
+	}
+	lt := asg.LaunchTemplate
+	if lt == nil && asg.MixedInstancesPolicy != nil && asg.MixedInstancesPolicy.LaunchTemplate != nil {
+		lt = asg.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification
+	}
+	if lt == nil {
+		return "", "", nil
+	}
+	return aws.StringValue(lt.LaunchTemplateId), aws.StringValue(lt.Version), nil
+}
+
+// isLaunchTemplateVersionStale reports whether the given launch template has a newer version than version,
+// which is either a version number, $Latest or $Default, the latter being assumed when version is empty
+func (c *StackCollection) isLaunchTemplateVersionStale(ctx context.Context, id, version string) (bool, error) {
+	if version == "$Latest" {
+		return false, nil
+	}
+	res, err := c.ec2API.DescribeLaunchTemplateVersions(ctx, &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(id),
+		Versions:         []string{"$Latest", "$Default"},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	var latest, current int64
+	for _, v := range res.LaunchTemplateVersions {
+		if n := aws.Int64Value(v.VersionNumber); n > latest {
+			latest = n
+		}
+		if (version == "" || version == "$Default") && aws.BoolValue(v.DefaultVersion) {
+			current = aws.Int64Value(v.VersionNumber)
+		}
+	}
+	if version != "" && version != "$Default" {
+		current, err = strconv.ParseInt(version, 10, 64)
+		if err != nil {
+			return false, fmt.Errorf("unexpected launch template version %q", version)
+		}
+	}
+	return current < latest, nil
+}
+
+// NodeGroupAMI describes the AMI used by a nodegroup
+type NodeGroupAMI struct {
+	// ID is the id of the AMI, empty when a managed nodegroup uses the EKS-optimized AMI of its release version
+	ID string
+	// ReleaseVersion is the AMI release version of a managed nodegroup using the EKS-optimized AMI
+	ReleaseVersion string
+	// Custom reports whether the nodegroup uses an explicitly set AMI id rather than the latest EKS-optimized AMI
+	Custom bool
+}
+
+// GetNodeGroupAMI returns the AMI id used by the given nodegroup, or the release version of a managed nodegroup
+// using the EKS-optimized AMI; use DescribeNodeGroupAMI to tell the two cases apart
+func (c *StackCollection) GetNodeGroupAMI(ctx context.Context, ngName string) (string, error) {
+	ami, err := c.DescribeNodeGroupAMI(ctx, ngName)
+	if err != nil {
+		return "", err
+	}
+	if ami.Custom {
+		return ami.ID, nil
+	}
+	return ami.ReleaseVersion, nil
+}
+
+// DescribeNodeGroupAMI describes the AMI used by the given nodegroup. Unmanaged nodegroups always use the AMI id set
+// in their launch template, while managed nodegroups use either a custom AMI set in their launch template, or the
+// EKS-optimized AMI of their release version.
+func (c *StackCollection) DescribeNodeGroupAMI(ctx context.Context, ngName string) (*NodeGroupAMI, error) {
+	s, err := c.DescribeNodeGroupStack(ctx, ngName)
+	if err != nil {
+		return nil, err
+	}
+	nodeGroupType, err := GetNodeGroupType(s.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if nodeGroupType == api.NodeGroupTypeManaged {
+		return c.getManagedNodeGroupAMI(ctx, s)
+	}
+
+	template, err := c.GetStackTemplate(ctx, *s.StackName)
+	if err != nil {
+		return nil, err
+	}
+	imageID := gjson.Get(template, "Resources.NodeGroupLaunchTemplate.Properties.LaunchTemplateData.ImageId")
+	if imageID.Type != gjson.String {
+		return nil, fmt.Errorf("no AMI id found in the launch template of nodegroup %q", ngName)
+	}
+	return &NodeGroupAMI{ID: imageID.String(), Custom: true}, nil
+}
+
+func (c *StackCollection) getManagedNodeGroupAMI(ctx context.Context, s *Stack) (*NodeGroupAMI, error) {
+	res, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(getClusterNameTag(s)),
+		NodegroupName: aws.String(c.GetNodeGroupName(s)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if lt := res.Nodegroup.LaunchTemplate; lt != nil {
+		launchTemplateData, err := builder.NewLaunchTemplateFetcher(c.ec2API).Fetch(ctx, &api.LaunchTemplate{
+			ID:      aws.StringValue(lt.Id),
+			Version: lt.Version,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if imageID := aws.StringValue(launchTemplateData.ImageId); imageID != "" {
+			return &NodeGroupAMI{ID: imageID, Custom: true}, nil
+		}
+	}
+	return &NodeGroupAMI{ReleaseVersion: aws.StringValue(res.Nodegroup.ReleaseVersion)}, nil
+}
+
+// eksOptimizedAMIVersionPattern matches the Kubernetes version in the names of EKS-optimized AMIs (AmazonLinux2,
+// Ubuntu, Bottlerocket and Windows) and in the SSM parameters they are resolved from
+var eksOptimizedAMIVersionPattern = regexp.MustCompile(`(?:amazon-eks(?:-gpu|-arm64)?-node-|k8s[_-]|EKS_Optimized-|eks/optimized-ami/)(\d+\.\d+)`)
+
+// GetNodeGroupKubernetesVersion returns the Kubernetes version of the given nodegroup. Managed nodegroups report
+// their version through EKS, while the version of unmanaged nodegroups is derived from the EKS-optimized AMI set in
+// their launch template; ErrUnknownNodeGroupVersion is returned when the AMI doesn't identify the version, e.g.
+// when it is a custom AMI
+func (c *StackCollection) GetNodeGroupKubernetesVersion(ctx context.Context, ngName string) (string, error) {
+	s, err := c.DescribeNodeGroupStack(ctx, ngName)
+	if err != nil {
+		return "", err
+	}
+	nodeGroupType, err := GetNodeGroupType(s.Tags)
+	if err != nil {
+		return "", err
+	}
+	if nodeGroupType == api.NodeGroupTypeManaged {
+		res, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+			ClusterName:   aws.String(getClusterNameTag(s)),
+			NodegroupName: aws.String(c.GetNodeGroupName(s)),
+		})
+		if err != nil {
+			return "", errors.Wrapf(err, "describing managed nodegroup %q", ngName)
+		}
+		if version := aws.StringValue(res.Nodegroup.Version); version != "" {
+			return version, nil
+		}
+		return "", errors.Wrapf(ErrUnknownNodeGroupVersion, "EKS did not report the version of managed nodegroup %q", ngName)
+	}
+
+	template, err := c.GetStackTemplate(ctx, *s.StackName)
+	if err != nil {
+		return "", err
+	}
+	imageID := gjson.Get(template, "Resources.NodeGroupLaunchTemplate.Properties.LaunchTemplateData.ImageId")
+	if imageID.Type != gjson.String {
+		return "", errors.Wrapf(ErrUnknownNodeGroupVersion, "no AMI found in the launch template of nodegroup %q", ngName)
+	}
+	if match := eksOptimizedAMIVersionPattern.FindStringSubmatch(imageID.String()); match != nil {
+		return match[1], nil
+	}
+
+	out, err := c.ec2API.DescribeImages(ctx, &ec2.DescribeImagesInput{
+		ImageIds: []string{imageID.String()},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "describing AMI %q of nodegroup %q", imageID.String(), ngName)
+	}
+	if len(out.Images) == 1 {
+		if match := eksOptimizedAMIVersionPattern.FindStringSubmatch(aws.StringValue(out.Images[0].Name)); match != nil {
+			return match[1], nil
+		}
+	}
+	return "", errors.Wrapf(ErrUnknownNodeGroupVersion, "AMI %q of nodegroup %q is not an EKS-optimized AMI", imageID.String(), ngName)
+}
+
+// nodeInstanceRoleResourceName is the logical id of the instance role created as part of nodegroup stacks
+const nodeInstanceRoleResourceName = "NodeInstanceRole"
+
+// GetNodeGroupInstanceRoleARN returns the ARN of the instance role of the given nodegroup. When the role was not
+// created by eksctl as part of the nodegroup stack, its ARN is returned along with ErrPreExistingInstanceRole
+func (c *StackCollection) GetNodeGroupInstanceRoleARN(ctx context.Context, ngName string) (string, error) {
+	s, err := c.DescribeNodeGroupStack(ctx, ngName)
+	if err != nil {
+		return "", err
+	}
+	nodeGroupType, err := GetNodeGroupType(s.Tags)
+	if err != nil {
+		return "", err
+	}
+
+	var roleARN string
+	if nodeGroupType == api.NodeGroupTypeManaged {
+		res, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+			ClusterName:   aws.String(getClusterNameTag(s)),
+			NodegroupName: aws.String(c.GetNodeGroupName(s)),
+		})
+		if err != nil {
+			return "", errors.Wrapf(err, "describing managed nodegroup %q", ngName)
+		}
+		roleARN = aws.StringValue(res.Nodegroup.NodeRole)
+	} else {
+		for _, o := range s.Outputs {
+			if aws.StringValue(o.OutputKey) == outputs.NodeGroupInstanceRoleARN {
+				roleARN = aws.StringValue(o.OutputValue)
+			}
+		}
+	}
+	if roleARN == "" {
+		return "", fmt.Errorf("no instance role found for nodegroup %q", ngName)
+	}
+
+	resources, err := c.cloudformationAPI.DescribeStackResources(ctx, &cfn.DescribeStackResourcesInput{
+		StackName: s.StackName,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "describing resources of stack %q", *s.StackName)
+	}
+	for _, r := range resources.StackResources {
+		if aws.StringValue(r.LogicalResourceId) == nodeInstanceRoleResourceName {
+			return roleARN, nil
+		}
+	}
+	return roleARN, ErrPreExistingInstanceRole
+}
+
+// IsSpotNodeGroup reports whether the nodegroup of the given stack runs spot instances
+func (c *StackCollection) IsSpotNodeGroup(ctx context.Context, s *Stack) (bool, error) {
+	nodeGroupType, err := GetNodeGroupType(s.Tags)
+	if err != nil {
+		return false, err
+	}
+	nodeGroupName := c.GetNodeGroupName(s)
+	if nodeGroupType == api.NodeGroupTypeManaged {
+		res, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+			ClusterName:   aws.String(getClusterNameTag(s)),
+			NodegroupName: aws.String(nodeGroupName),
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "describing managed nodegroup %q", nodeGroupName)
+		}
+		if res.Nodegroup.CapacityType == nil {
+			return false, fmt.Errorf("unable to determine the capacity type of managed nodegroup %q", nodeGroupName)
+		}
+		return *res.Nodegroup.CapacityType == eks.CapacityTypesSpot, nil
+	}
+
+	template, err := c.GetStackTemplate(ctx, *s.StackName)
+	if err != nil {
+		return false, errors.Wrapf(err, "getting template of nodegroup %q", nodeGroupName)
+	}
+	asg := gjson.Get(template, "Resources.NodeGroup.Properties")
+	if !asg.Exists() {
+		return false, fmt.Errorf("unable to determine whether nodegroup %q uses spot instances: no NodeGroup resource in stack %q", nodeGroupName, *s.StackName)
+	}
+	// the ASG defaults to 100% on-demand instances above the base capacity
+	if onDemandPercentage := asg.Get("MixedInstancesPolicy.InstancesDistribution.OnDemandPercentageAboveBaseCapacity"); onDemandPercentage.Exists() && onDemandPercentage.Int() < 100 {
+		return true, nil
+	}
+	marketType := gjson.Get(template, "Resources.NodeGroupLaunchTemplate.Properties.LaunchTemplateData.InstanceMarketOptions.MarketType")
+	return marketType.String() == "spot", nil
+}
+
+// HasCNIPolicy reports whether an IAM role of the given stack has the AmazonEKS_CNI_Policy managed policy attached,
+// whether its ARN is given literally or built with Fn::Sub
+func (c *StackCollection) HasCNIPolicy(ctx context.Context, s *Stack) (bool, error) {
+	template, err := c.GetStackTemplate(ctx, *s.StackName)
+	if err != nil {
+		return false, errors.Wrapf(err, "getting template of stack %q", *s.StackName)
+	}
+	found := false
+	gjson.Get(template, resourcesRootPath).ForEach(func(_, resource gjson.Result) bool {
+		if resource.Get("Type").String() != "AWS::IAM::Role" {
+			return true
+		}
+		for _, policyARN := range resource.Get("Properties.ManagedPolicyArns").Array() {
+			if policyARN.IsObject() {
+				policyARN = policyARN.Get(`Fn\:\:Sub`)
+			}
+			if strings.HasSuffix(policyARN.String(), ":policy/"+cniPolicyName) {
+				found = true
+				return false
+			}
+		}
+		return true
+	})
+	return found, nil
+}
+
+func (c *StackCollection) GetAutoScalingGroupDesiredCapacity(ctx context.Context, name string) (asgtypes.AutoScalingGroup, error) {
+	asg, err := c.asgAPI.DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{
+			name,
+		},
+	})
+
+	if err != nil {
+		return asgtypes.AutoScalingGroup{}, fmt.Errorf("couldn't describe ASG: %s", name)
+	}
+	if len(asg.AutoScalingGroups) != 1 {
+		logger.Warning("couldn't find ASG %s", name)
+		return asgtypes.AutoScalingGroup{}, fmt.Errorf("couldn't find ASG: %s", name)
+	}
+
+	return asg.AutoScalingGroups[0], nil
+}
+
+// ScalingConfig holds the capacity settings of an autoscaling group
+type ScalingConfig struct {
+	Min     int
+	Max     int
+	Desired int
+}
+
+// GetAutoScalingGroupCapacity returns the min, max and desired capacity of the named autoscaling group
+func (c *StackCollection) GetAutoScalingGroupCapacity(ctx context.Context, name string) (ScalingConfig, error) {
+	asg, err := c.GetAutoScalingGroupDesiredCapacity(ctx, name)
+	if err != nil {
+		return ScalingConfig{}, err
+	}
+
+	return ScalingConfig{
+		Min:     int(aws.Int32Value(asg.MinSize)),
+		Max:     int(aws.Int32Value(asg.MaxSize)),
+		Desired: int(aws.Int32Value(asg.DesiredCapacity)),
+	}, nil
+}
+
+// TotalDesiredCapacity returns the sum of the desired capacities of the autoscaling groups of all nodegroups,
+// including all autoscaling groups of managed nodegroups; nodegroups are described concurrently, with at most
+// describeConcurrency nodegroups in flight
+func (c *StackCollection) TotalDesiredCapacity(ctx context.Context) (int, error) {
+	stacks, err := c.ListNodeGroupStacks(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		capacities = make([]int, len(stacks))
+		errs       = make([]error, len(stacks))
+	)
+	if err := forEachConcurrently(ctx, len(stacks), c.describeConcurrency, func(i int) {
+		s := stacks[i]
+		asgNames, err := c.GetAutoScalingGroupName(ctx, s.Stack)
+		if err != nil {
+			errs[i] = errors.Wrapf(err, "getting the autoscaling groups of nodegroup %q", s.NodeGroupName)
+			return
+		}
+		for _, name := range strings.Split(asgNames, ",") {
+			if name == "" {
+				continue
+			}
+			asg, err := c.GetAutoScalingGroupDesiredCapacity(ctx, name)
+			if err != nil {
+				errs[i] = errors.Wrapf(err, "getting the capacity of nodegroup %q", s.NodeGroupName)
+				return
+			}
+			capacities[i] += int(aws.Int32Value(asg.DesiredCapacity))
+		}
+	}); err != nil {
+		return 0, err
+	}
+
+	if err := combineErrors(errs); err != nil {
+		return 0, err
+	}
+	var total int
+	for _, capacity := range capacities {
+		total += capacity
+	}
+	return total, nil
+}
+
+// WaitForASGInService waits until as many healthy instances of the named autoscaling group are in service as its
+// desired capacity, or until timeout expires, in which case the instances that are not yet in service are reported
+func (c *StackCollection) WaitForASGInService(ctx context.Context, name string, timeout time.Duration) error {
+	var pending []string
+	w := &waiter.Waiter{
+		NextDelay: func(attempts int) time.Duration {
+			if attempts == 1 {
+				return 0
+			}
+			return c.autoScalingGroupPollInterval
+		},
+		Operation: func() (bool, error) {
+			asg, err := c.GetAutoScalingGroupDesiredCapacity(ctx, name)
+			if err != nil {
+				return false, err
+			}
+			var inService int
+			pending = nil
+			for _, i := range asg.Instances {
+				if i.LifecycleState == asgtypes.LifecycleStateInService && aws.StringValue(i.HealthStatus) == "Healthy" {
+					inService++
+				} else {
+					pending = append(pending, fmt.Sprintf("%s (%s)", aws.StringValue(i.InstanceId), i.LifecycleState))
+				}
+			}
+			desired := int(aws.Int32Value(asg.DesiredCapacity))
+			logger.Debug("ASG %q has %d of %d desired instances in service", name, inService, desired)
+			return inService >= desired, nil
+		},
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := w.Wait(timeoutCtx); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("timed out after %v waiting for instances of ASG %q to be in service, pending instances: %s", timeout, name, strings.Join(pending, ", "))
+		}
+		return err
+	}
+	return nil
+}
+
+// SetAutoScalingGroupCapacity sets the min, max and desired capacity of the named autoscaling group
+func (c *StackCollection) SetAutoScalingGroupCapacity(ctx context.Context, name string, cfg ScalingConfig) error {
+	if cfg.Min < 0 || cfg.Max < cfg.Min {
+		return fmt.Errorf("invalid capacity for ASG %s: minimum (%d) must be non-negative and not exceed maximum (%d)", name, cfg.Min, cfg.Max)
+	}
+	if cfg.Desired < cfg.Min || cfg.Desired > cfg.Max {
+		return fmt.Errorf("invalid capacity for ASG %s: desired (%d) must be within [%d, %d]", name, cfg.Desired, cfg.Min, cfg.Max)
+	}
+
+	_, err := c.asgAPI.UpdateAutoScalingGroup(ctx, &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(name),
+		MinSize:              aws.Int32(int32(cfg.Min)),
+		MaxSize:              aws.Int32(int32(cfg.Max)),
+		DesiredCapacity:      aws.Int32(int32(cfg.Desired)),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "updating capacity of ASG %s", name)
+	}
+	return nil
+}
+
+// DiffManagedNodeGroupTags compares the tags of the autoscaling groups of the given managed nodegroup with the
+// desired tags, and returns the tags that would be added, updated with a new value, and removed to apply them.
+// Tags set by AWS and EKS are never removed.
+func (c *StackCollection) DiffManagedNodeGroupTags(ctx context.Context, ngName string, desired map[string]string) (toAdd, toUpdate, toRemove map[string]string, err error) {
+	stack, err := c.DescribeNodeGroupStack(ctx, ngName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	asgNames, err := c.getManagedNodeGroupAutoScalingGroupName(ctx, stack)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if asgNames == "" {
+		return nil, nil, nil, fmt.Errorf("no autoscaling groups found for managed nodegroup %q", ngName)
+	}
+
+	current := make(map[string]string)
+	paginator := autoscaling.NewDescribeTagsPaginator(c.asgAPI, &autoscaling.DescribeTagsInput{
+		Filters: []asgtypes.Filter{{
+			Name:   aws.String("auto-scaling-group"),
+			Values: strings.Split(asgNames, ","),
+		}},
+	})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, nil, nil, errors.Wrapf(err, "describing tags of autoscaling groups of nodegroup %q", ngName)
+		}
+		for _, tag := range out.Tags {
+			current[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+	}
+
+	toAdd, toUpdate, toRemove = diffTags(current, desired, func(key string) bool {
+		return !isManagedASGTag(key)
+	})
+	return toAdd, toUpdate, toRemove, nil
+}
+
+// managedASGTagPrefixes are the prefixes of autoscaling group tags set by AWS and EKS, which are
+// never considered for removal when diffing tags
+var managedASGTagPrefixes = []string{"aws:", "eks:", "k8s.io/cluster-autoscaler/"}
+
+func isManagedASGTag(key string) bool {
+	for _, prefix := range managedASGTagPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// DescribeNodeGroupStack gets the specified nodegroup stack
+func (c *StackCollection) DescribeNodeGroupStack(ctx context.Context, nodeGroupName string) (*Stack, error) {
+	stackName := c.makeNodeGroupStackName(nodeGroupName)
+	return c.DescribeStack(ctx, &Stack{StackName: &stackName})
+}
+
+// GroupNodeGroupsBySecurityGroup returns the names of the nodegroups using each security group, keyed by security
+// group id. Unmanaged nodegroups use the security groups of their stack, and managed nodegroups use their remote
+// access security group; nodegroups whose security groups can't be resolved are skipped.
+func (c *StackCollection) GroupNodeGroupsBySecurityGroup(ctx context.Context) (map[string][]string, error) {
+	stacks, err := c.ListNodeGroupStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string][]string)
+	for _, s := range stacks {
+		var securityGroupIDs []string
+		if s.Type == api.NodeGroupTypeManaged {
+			securityGroupIDs, err = c.getManagedNodeGroupSecurityGroups(s)
+		} else {
+			securityGroupIDs, err = c.getUnmanagedNodeGroupSecurityGroups(ctx, s)
+		}
+		if err != nil {
+			logger.Warning("unable to resolve the security groups of nodegroup %q: %v", s.NodeGroupName, err)
+			continue
+		}
+		if len(securityGroupIDs) == 0 {
+			logger.Warning("no security groups found for nodegroup %q", s.NodeGroupName)
+			continue
+		}
+		for _, id := range securityGroupIDs {
+			groups[id] = append(groups[id], s.NodeGroupName)
+		}
+	}
+	for _, names := range groups {
+		sort.Strings(names)
+	}
+	return groups, nil
+}
+
+// GetNodeGroupSecurityGroupIDs returns the ids of the security groups created for the given nodegroup, i.e. those
+// of its stack and, for managed nodegroups, the remote access security group created by EKS; a nodegroup without
+// dedicated security groups has none
+func (c *StackCollection) GetNodeGroupSecurityGroupIDs(ctx context.Context, ngName string) ([]string, error) {
+	stack, err := c.DescribeNodeGroupStack(ctx, ngName)
+	if err != nil {
+		return nil, err
+	}
+	nodeGroupType, err := GetNodeGroupType(stack.Tags)
+	if err != nil {
+		return nil, err
+	}
+	s := NodeGroupStack{NodeGroupName: ngName, Type: nodeGroupType, Stack: stack}
+
+	securityGroupIDs, err := c.getUnmanagedNodeGroupSecurityGroups(ctx, s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting the security groups of nodegroup %q", ngName)
+	}
+	if nodeGroupType == api.NodeGroupTypeManaged {
+		remoteAccess, err := c.getManagedNodeGroupSecurityGroups(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting the remote access security group of nodegroup %q", ngName)
+		}
+		securityGroupIDs = append(securityGroupIDs, remoteAccess...)
+	}
+	if securityGroupIDs == nil {
+		return []string{}, nil
+	}
+	return securityGroupIDs, nil
+}
+
+// GroupNodeGroupsByInstanceRole returns the names of the nodegroups using each instance role, keyed by role ARN.
+// Instance roles are looked up concurrently, with at most describeConcurrency nodegroups in flight; nodegroups whose
+// instance role can't be resolved are skipped.
+func (c *StackCollection) GroupNodeGroupsByInstanceRole(ctx context.Context) (map[string][]string, error) {
+	stacks, err := c.ListNodeGroupStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roleARNs := make([]string, len(stacks))
+	if err := forEachConcurrently(ctx, len(stacks), c.describeConcurrency, func(i int) {
+		ngName := stacks[i].NodeGroupName
+		roleARN, err := c.GetNodeGroupInstanceRoleARN(ctx, ngName)
+		if err != nil && !errors.Is(err, ErrPreExistingInstanceRole) {
+			logger.Warning("unable to resolve the instance role of nodegroup %q: %v", ngName, err)
+			return
+		}
+		roleARNs[i] = roleARN
+	}); err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string][]string)
+	for i, s := range stacks {
+		if roleARNs[i] != "" {
+			groups[roleARNs[i]] = append(groups[roleARNs[i]], s.NodeGroupName)
+		}
+	}
+	for _, names := range groups {
+		sort.Strings(names)
+	}
+	return groups, nil
+}
+
+func (c *StackCollection) getManagedNodeGroupSecurityGroups(s NodeGroupStack) ([]string, error) {
+	res, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(c.spec.Metadata.Name),
+		NodegroupName: aws.String(s.NodeGroupName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Nodegroup.Resources == nil || res.Nodegroup.Resources.RemoteAccessSecurityGroup == nil {
+		return nil, nil
+	}
+	return []string{*res.Nodegroup.Resources.RemoteAccessSecurityGroup}, nil
+}
+
+func (c *StackCollection) getUnmanagedNodeGroupSecurityGroups(ctx context.Context, s NodeGroupStack) ([]string, error) {
+	resources, err := c.cloudformationAPI.DescribeStackResources(ctx, &cfn.DescribeStackResourcesInput{
+		StackName: s.Stack.StackName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var securityGroupIDs []string
+	for _, r := range resources.StackResources {
+		if aws.StringValue(r.ResourceType) == "AWS::EC2::SecurityGroup" && r.PhysicalResourceId != nil {
+			securityGroupIDs = append(securityGroupIDs, *r.PhysicalResourceId)
+		}
+	}
+	return securityGroupIDs, nil
+}
+
+// DescribeNodeGroupStackByID gets the nodegroup stack identified by its id (ARN)
+func (c *StackCollection) DescribeNodeGroupStackByID(ctx context.Context, stackID string) (*Stack, error) {
+	stack, err := c.DescribeStack(ctx, &Stack{StackName: &stackID, StackId: &stackID})
+	if err != nil {
+		return nil, err
+	}
+	if GetNodegroupTagName(stack.Tags) == "" {
+		return nil, fmt.Errorf("stack %q is not a nodegroup stack", *stack.StackName)
+	}
+	return stack, nil
+}
+
+// ForceDeleteNodeGroupStack deletes a nodegroup stack in DELETE_FAILED state, retaining retainResources,
+// which are the logical ids of the resources blocking the deletion, and waits until status is DELETE_COMPLETE.
+// When retainResources is empty, the resources that failed to be deleted are returned in the error as candidates
+func (c *StackCollection) ForceDeleteNodeGroupStack(ctx context.Context, name string, retainResources []string) error {
+	stack, err := c.DescribeNodeGroupStack(ctx, name)
+	if err != nil {
+		return err
+	}
+	if stack.StackStatus != types.StackStatusDeleteFailed {
+		return fmt.Errorf("cannot force delete stack %q in %s state, expected %s", *stack.StackName, stack.StackStatus, types.StackStatusDeleteFailed)
+	}
+
+	if len(retainResources) == 0 {
+		failedResources, err := c.describeDeleteFailedResources(ctx, stack)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("no resources to retain were specified for stack %q; resources that failed to be deleted: %s",
+			*stack.StackName, strings.Join(failedResources, ", "))
+	}
+
+	input := &cfn.DeleteStackInput{
+		StackName:       stack.StackId,
+		RetainResources: retainResources,
+	}
+	if cfnRole := c.roleARN; cfnRole != "" {
+		input.RoleARN = &cfnRole
+	}
+
+	logger.Info("deleting stack %q, retaining resources %v", *stack.StackName, retainResources)
+	if _, err := c.cloudformationAPI.DeleteStack(ctx, input); err != nil {
+		return errors.Wrapf(err, "not able to delete stack %q", *stack.StackName)
+	}
+	logger.Info("waiting for stack %q to get deleted", *stack.StackName)
+	return c.doWaitUntilStackIsDeleted(ctx, stack)
+}
+
+// DeleteNodeGroupStacks deletes the stacks of the given nodegroups and waits until they are deleted. Unmanaged
+// nodegroups are deleted before managed ones, with at most opts.Concurrency deletions in flight; a failure to
+// delete one nodegroup does not prevent the deletion of others, and all failures are reported in the returned error
+func (c *StackCollection) DeleteNodeGroupStacks(ctx context.Context, names []string, opts DeleteOptions) error {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultDeleteConcurrency
+	}
+
+	var (
+		errs      []error
+		unmanaged []NodeGroupStack
+		managed   []NodeGroupStack
+	)
+	for _, name := range names {
+		stack, err := c.DescribeNodeGroupStack(ctx, name)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "deleting nodegroup %q", name))
+			continue
+		}
+		nodeGroupType, err := GetNodeGroupType(stack.Tags)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "deleting nodegroup %q", name))
+			continue
+		}
+		s := NodeGroupStack{NodeGroupName: name, Type: nodeGroupType, Stack: stack}
+		if nodeGroupType == api.NodeGroupTypeManaged {
+			managed = append(managed, s)
+		} else {
+			unmanaged = append(unmanaged, s)
+		}
+	}
+
+	for _, stacks := range [][]NodeGroupStack{unmanaged, managed} {
+		deleteErr := make([]error, len(stacks))
+		if err := forEachConcurrently(ctx, len(stacks), concurrency, func(i int) {
+			if err := c.deleteNodeGroupStack(ctx, stacks[i], opts); err != nil {
+				deleteErr[i] = errors.Wrapf(err, "deleting nodegroup %q", stacks[i].NodeGroupName)
+			}
+		}); err != nil {
+			return err
+		}
+		errs = append(errs, deleteErr...)
+	}
+	return combineErrors(errs)
+}
+
+func (c *StackCollection) deleteNodeGroupStack(ctx context.Context, s NodeGroupStack, opts DeleteOptions) error {
+	if opts.Drain != nil {
+		if err := opts.Drain(ctx, s.NodeGroupName); err != nil {
+			return errors.Wrap(err, "draining nodegroup")
+		}
+	}
+	retainResources, retain := opts.RetainResources[s.NodeGroupName]
+	if retain && s.Stack.StackStatus == types.StackStatusDeleteFailed {
+		return c.ForceDeleteNodeGroupStack(ctx, s.NodeGroupName, retainResources)
+	}
+	err := c.DeleteStackSync(ctx, s.Stack)
+	if err == nil || !retain {
+		return err
+	}
+
+	stack, describeErr := c.DescribeNodeGroupStack(ctx, s.NodeGroupName)
+	if describeErr != nil || stack.StackStatus != types.StackStatusDeleteFailed {
+		return err
+	}
+	logger.Warning("stack %q failed to be deleted, retrying while retaining resources %v", *stack.StackName, retainResources)
+	return c.ForceDeleteNodeGroupStack(ctx, s.NodeGroupName, retainResources)
+}
+
+// describeDeleteFailedResources returns the logical ids of the resources of the stack that failed to be deleted
+func (c *StackCollection) describeDeleteFailedResources(ctx context.Context, s *Stack) ([]string, error) {
+	output, err := c.cloudformationAPI.DescribeStackResources(ctx, &cfn.DescribeStackResourcesInput{
+		StackName: s.StackName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing resources of stack %q", *s.StackName)
+	}
+	var failedResources []string
+	for _, r := range output.StackResources {
+		if r.ResourceStatus == types.ResourceStatusDeleteFailed {
+			failedResources = append(failedResources, aws.StringValue(r.LogicalResourceId))
+		}
+	}
+	return failedResources, nil
+}
+
+// DeletionImpact describes what deleting a nodegroup would affect
+type DeletionImpact struct {
+	NodeGroupName string
+	// Instances is the number of EC2 instances the nodegroup currently runs
+	Instances int
+	// LastInAvailabilityZones lists the availability zones of the nodegroup that no other nodegroup of the cluster uses
+	LastInAvailabilityZones []string
+	// LastInSubnets lists the subnets of the nodegroup that no other nodegroup of the cluster uses
+	LastInSubnets []string
+	// DeletedResources lists the logical ids of the stack resources that would be deleted
+	DeletedResources []string
+	// RetainedResources lists the logical ids of the stack resources that would be retained, as their deletion policy is Retain
+	RetainedResources []string
+}
+
+// nodeGroupPlacement holds the instance count and the availability zones and subnets of a nodegroup's ASGs
+type nodeGroupPlacement struct {
+	instances int
+	zones     []string
+	subnets   []string
+}
+
+// DescribeNodeGroupDeletionImpact previews the impact of deleting the given nodegroup: the number of instances it
+// runs, the availability zones and subnets it is the last nodegroup of, and the resources of its stack that would
+// be deleted or retained. The placement of the other nodegroups is looked up concurrently, with at most
+// describeConcurrency nodegroups in flight; nodegroups whose placement can't be resolved are skipped.
+func (c *StackCollection) DescribeNodeGroupDeletionImpact(ctx context.Context, ngName string) (*DeletionImpact, error) {
+	stack, err := c.DescribeNodeGroupStack(ctx, ngName)
+	if err != nil {
+		return nil, err
+	}
+	placement, err := c.getNodeGroupPlacement(ctx, stack)
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing the instances of nodegroup %q", ngName)
+	}
+	impact := &DeletionImpact{
+		NodeGroupName: ngName,
+		Instances:     placement.instances,
+	}
+
+	stacks, err := c.ListNodeGroupStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	placements := make([]*nodeGroupPlacement, len(stacks))
+	if err := forEachConcurrently(ctx, len(stacks), c.describeConcurrency, func(i int) {
+		s := stacks[i]
+		if s.NodeGroupName == ngName {
+			return
+		}
+		p, err := c.getNodeGroupPlacement(ctx, s.Stack)
+		if err != nil {
+			logger.Warning("unable to resolve the placement of nodegroup %q: %v", s.NodeGroupName, err)
+			return
+		}
+		placements[i] = p
+	}); err != nil {
+		return nil, err
+	}
+
+	usedZones, usedSubnets := make(map[string]bool), make(map[string]bool)
+	for _, p := range placements {
+		if p == nil {
+			continue
+		}
+		for _, z := range p.zones {
+			usedZones[z] = true
+		}
+		for _, s := range p.subnets {
+			usedSubnets[s] = true
+		}
+	}
+	for _, z := range placement.zones {
+		if !usedZones[z] {
+			impact.LastInAvailabilityZones = append(impact.LastInAvailabilityZones, z)
+		}
+	}
+	for _, s := range placement.subnets {
+		if !usedSubnets[s] {
+			impact.LastInSubnets = append(impact.LastInSubnets, s)
+		}
+	}
+
+	impact.DeletedResources, impact.RetainedResources, err = c.describeNodeGroupResourcesOnDeletion(ctx, stack)
+	if err != nil {
+		return nil, err
+	}
+	return impact, nil
+}
+
+// getNodeGroupPlacement returns the placement of the nodegroup of the given stack; managed nodegroups whose ASGs
+// aren't known to EKS have no instances
+func (c *StackCollection) getNodeGroupPlacement(ctx context.Context, s *Stack) (*nodeGroupPlacement, error) {
+	asgNames, err := c.GetAutoScalingGroupName(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		placement      nodeGroupPlacement
+		zones, subnets = make(map[string]bool), make(map[string]bool)
+	)
+	if asgNames == "" {
+		return &placement, nil
+	}
+	for _, name := range strings.Split(asgNames, ",") {
+		asg, err := c.GetAutoScalingGroupDesiredCapacity(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		placement.instances += len(asg.Instances)
+		for _, z := range asg.AvailabilityZones {
+			zones[z] = true
+		}
+		for _, s := range strings.Split(aws.StringValue(asg.VPCZoneIdentifier), ",") {
+			if s != "" {
+				subnets[s] = true
+			}
+		}
+	}
+	placement.zones = sortedKeys(zones)
+	placement.subnets = sortedKeys(subnets)
+	return &placement, nil
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ValidateNodeGroupSubnetAZs checks that the subnets of the given nodegroup's ASGs are spread across distinct
+// availability zones, and returns the issues found: subnets sharing an availability zone, and nodegroups running
+// in a single availability zone, which won't survive the outage of that zone
+func (c *StackCollection) ValidateNodeGroupSubnetAZs(ctx context.Context, ngName string) ([]string, error) {
+	stack, err := c.DescribeNodeGroupStack(ctx, ngName)
+	if err != nil {
+		return nil, err
+	}
+	placement, err := c.getNodeGroupPlacement(ctx, stack)
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing the subnets of nodegroup %q", ngName)
+	}
+	if len(placement.subnets) == 0 {
+		return nil, fmt.Errorf("unable to determine the subnets of nodegroup %q", ngName)
+	}
+
+	out, err := c.ec2API.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{
+		SubnetIds: placement.subnets,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing the subnets of nodegroup %q", ngName)
+	}
+	subnetsByZone := make(map[string][]string)
+	for _, s := range out.Subnets {
+		zone := aws.StringValue(s.AvailabilityZone)
+		subnetsByZone[zone] = append(subnetsByZone[zone], aws.StringValue(s.SubnetId))
+	}
+
+	var issues []string
+	zones := make([]string, 0, len(subnetsByZone))
+	for zone := range subnetsByZone {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+	for _, zone := range zones {
+		if subnets := subnetsByZone[zone]; len(subnets) > 1 {
+			sort.Strings(subnets)
+			issues = append(issues, fmt.Sprintf("subnets %s of nodegroup %q are all in availability zone %q", strings.Join(subnets, ", "), ngName, zone))
+		}
+	}
+	if len(zones) == 1 {
+		logger.Warning("nodegroup %q only runs in availability zone %q", ngName, zones[0])
+		issues = append(issues, fmt.Sprintf("nodegroup %q only runs in availability zone %q", ngName, zones[0]))
+	}
+	return issues, nil
+}
+
+// describeNodeGroupResourcesOnDeletion returns the logical ids of the resources of the given stack that deleting it
+// would delete and retain, according to the deletion policies of its template; resources already deleted are omitted
+func (c *StackCollection) describeNodeGroupResourcesOnDeletion(ctx context.Context, s *Stack) (deleted, retained []string, err error) {
+	template, err := c.GetStackTemplate(ctx, *s.StackName)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "getting template of stack %q", *s.StackName)
+	}
+	output, err := c.cloudformationAPI.DescribeStackResources(ctx, &cfn.DescribeStackResourcesInput{
+		StackName: s.StackName,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "describing resources of stack %q", *s.StackName)
+	}
+	for _, r := range output.StackResources {
+		if r.ResourceStatus == types.ResourceStatusDeleteComplete {
+			continue
+		}
+		logicalID := aws.StringValue(r.LogicalResourceId)
+		switch gjson.Get(template, "Resources."+logicalID+".DeletionPolicy").String() {
+		case "Retain", "RetainExceptOnCreate":
+			retained = append(retained, logicalID)
+		default:
+			deleted = append(deleted, logicalID)
+		}
+	}
+	sort.Strings(deleted)
+	sort.Strings(retained)
+	return deleted, retained, nil
+}
+
+// FindOrphanedNodeGroupENIs returns the ids of the network interfaces that still use the security groups
+// of the given nodegroup's stack, and so commonly block its deletion; it returns an empty list when
+// the stack or its security groups no longer exist
+func (c *StackCollection) FindOrphanedNodeGroupENIs(ctx context.Context, nodeGroupName string) ([]string, error) {
+	stackName := c.makeNodeGroupStackName(nodeGroupName)
+	resources, err := c.cloudformationAPI.DescribeStackResources(ctx, &cfn.DescribeStackResourcesInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		if isStackNotFoundError(err) {
+			return []string{}, nil
+		}
+		return nil, errors.Wrapf(err, "describing resources of stack %q", stackName)
+	}
+
+	var securityGroupIDs []string
+	for _, r := range resources.StackResources {
+		if aws.StringValue(r.ResourceType) == "AWS::EC2::SecurityGroup" && r.PhysicalResourceId != nil {
+			securityGroupIDs = append(securityGroupIDs, *r.PhysicalResourceId)
+		}
+	}
+	eniIDs := []string{}
+	if len(securityGroupIDs) == 0 {
+		return eniIDs, nil
+	}
+
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(c.ec2API, &ec2.DescribeNetworkInterfacesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("group-id"),
+				Values: securityGroupIDs,
+			},
+		},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing network interfaces of nodegroup %q", nodeGroupName)
+		}
+		for _, eni := range output.NetworkInterfaces {
+			eniIDs = append(eniIDs, aws.StringValue(eni.NetworkInterfaceId))
+		}
+	}
+	return eniIDs, nil
+}
+
+// GetNodeGroupStackType returns the nodegroup stack type
+func (c *StackCollection) GetNodeGroupStackType(ctx context.Context, options GetNodegroupOption) (api.NodeGroupType, error) {
+	var (
+		err   error
+		stack *Stack
+	)
+	if options.Stack != nil && options.Stack.Stack != nil {
+		stack = options.Stack.Stack
+	}
+	if stack == nil {
+		stack, err = c.DescribeNodeGroupStack(ctx, options.NodeGroupName)
+		if err != nil {
+			return "", err
+		}
+	}
+	return GetNodeGroupType(stack.Tags)
+}
+
+// GetNodeGroupType returns the nodegroup type
+func GetNodeGroupType(tags []types.Tag) (api.NodeGroupType, error) {
+	var nodeGroupType api.NodeGroupType
+
+	if ngNameTagValue := GetNodegroupTagName(tags); ngNameTagValue == "" {
+		return "", errors.New("failed to find the nodegroup name tag")
+	}
+
+	for _, tag := range tags {
+		switch *tag.Key {
+		case api.NodeGroupTypeTag:
+			nodeGroupType = api.NodeGroupType(*tag.Value)
+		}
+	}
+
+	if nodeGroupType == "" {
+		nodeGroupType = api.NodeGroupTypeUnmanaged
+	}
+
+	return nodeGroupType, nil
+}
+
+// GetEksctlVersionFromTags returns the eksctl version used to create or update the stack
+func GetEksctlVersionFromTags(tags []types.Tag) (semver.Version, bool, error) {
+	for _, tag := range tags {
+		if *tag.Key == api.EksctlVersionTag {
+			v, err := version.ParseEksctlVersion(*tag.Value)
+			if err != nil {
+				return v, false, errors.Wrapf(err, "unexpected error parsing eksctl version %q", *tag.Value)
+			}
+			return v, true, nil
+		}
+	}
+	return semver.Version{}, false, nil
+}
+
+// CheckNodeGroupVersionCompatibility returns the names of nodegroups whose stacks were created or
+// last updated by a newer version of eksctl than the one currently running, logging a warning for each;
+// stacks without a version tag predate it and are considered compatible
+func (c *StackCollection) CheckNodeGroupVersionCompatibility(ctx context.Context) ([]string, error) {
+	currentVersion, err := version.ParseEksctlVersion(version.GetVersion())
+	if err != nil {
+		return nil, errors.Wrap(err, "unexpected error parsing current eksctl version")
+	}
+
+	stacks, err := c.ListNodeGroupStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var incompatible []string
+	for _, s := range stacks {
+		stackVersion, found, err := GetEksctlVersionFromTags(s.Stack.Tags)
+		if err != nil {
+			logger.Warning("unable to determine the eksctl version of nodegroup %q: %v", s.NodeGroupName, err)
+			continue
+		}
+		if found && stackVersion.GT(currentVersion) {
+			logger.Warning("nodegroup %q was created by eksctl version %s which is newer than the running version %s; "+
+				"operating on it may cause unexpected changes", s.NodeGroupName, stackVersion, currentVersion)
+			incompatible = append(incompatible, s.NodeGroupName)
+		}
+	}
+	return incompatible, nil
+}
+
+// BackfillEksctlVersionTag tags the stacks of nodegroups created by versions of eksctl that predate the
+// version tag with the version of eksctl currently running, and returns the names of the nodegroups it tagged.
+// Only the tags of the stacks are updated; stacks that are not in an updatable state are skipped.
+func (c *StackCollection) BackfillEksctlVersionTag(ctx context.Context) ([]string, error) {
+	stacks, err := c.ListNodeGroupStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		updated []string
+		errs    []error
+	)
+	for _, s := range stacks {
+		if getEksctlVersionTag(s.Stack.Tags) != "" {
+			continue
+		}
+		if !isUpdatableStackStatus(s.Stack.StackStatus) {
+			logger.Warning("not adding the eksctl version tag to the stack of nodegroup %q as its status is %s", s.NodeGroupName, s.Stack.StackStatus)
+			continue
+		}
+
+		tags := map[string]string{api.EksctlVersionTag: version.GetVersion()}
+		for _, t := range s.Stack.Tags {
+			tags[*t.Key] = *t.Value
+		}
+		logger.Info("adding the eksctl version tag to the stack of nodegroup %q", s.NodeGroupName)
+		if _, err := c.doUpdateStackTags(ctx, s.Stack, tags); err != nil {
+			errs = append(errs, errors.Wrapf(err, "tagging nodegroup %q", s.NodeGroupName))
+			continue
+		}
+		updated = append(updated, s.NodeGroupName)
+	}
+	return updated, combineErrors(errs)
+}
+
+// isUpdatableStackStatus reports whether a stack with the given status can be updated
+func isUpdatableStackStatus(status types.StackStatus) bool {
+	switch status {
+	case types.StackStatusCreateComplete, types.StackStatusUpdateComplete, types.StackStatusUpdateRollbackComplete,
+		types.StackStatusImportComplete, types.StackStatusImportRollbackComplete:
+		return true
+	}
+	return false
+}
+
+// GetNodeGroupName will return nodegroup name based on tags; the legacy stack name suffixes are only considered
+// for stacks tagged with the name of this cluster, so that stacks of other clusters or of users are never mistaken
+// for legacy nodegroups
+func (c *StackCollection) GetNodeGroupName(s *Stack) string {
+	if tagName := GetNodegroupTagName(s.Tags); tagName != "" {
 		return tagName
 	}
+	if getClusterNameTag(s) != c.spec.Metadata.Name {
+		return ""
+	}
 	if strings.HasSuffix(*s.StackName, "-nodegroup-0") {
 		return "legacy-nodegroup-0"
 	}
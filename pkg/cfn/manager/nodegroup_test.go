@@ -1,15 +1,101 @@
 package manager
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/smithy-go"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/version"
 )
 
+// mockNodeGroupStacks sets up p to return count nodegroup stacks for clusterName
+func mockNodeGroupStacks(p *mockprovider.MockProvider, clusterName string, count int) {
+	var summaries []types.StackSummary
+	for i := 0; i < count; i++ {
+		summaries = append(summaries, types.StackSummary{
+			StackName: aws.String(fmt.Sprintf("eksctl-%s-nodegroup-ng-%d", clusterName, i)),
+		})
+	}
+	p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{
+		StackSummaries: summaries,
+	}, nil)
+	p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, input *cfn.DescribeStacksInput, _ ...func(*cfn.Options)) *cfn.DescribeStacksOutput {
+			ngName := (*input.StackName)[len(fmt.Sprintf("eksctl-%s-nodegroup-", clusterName)):]
+			return &cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{
+					StackName:   input.StackName,
+					StackStatus: types.StackStatusCreateComplete,
+					Tags: []types.Tag{{
+						Key:   aws.String(api.NodeGroupNameTag),
+						Value: aws.String(ngName),
+					}},
+				}},
+			}
+		}, nil)
+}
+
+// mockStackResources sets up p to return a single resource for every stack, taking delay to respond
+func mockStackResources(p *mockprovider.MockProvider, delay time.Duration) {
+	p.MockCloudFormation().On("DescribeStackResources", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, input *cfn.DescribeStackResourcesInput, _ ...func(*cfn.Options)) *cfn.DescribeStackResourcesOutput {
+			time.Sleep(delay)
+			return &cfn.DescribeStackResourcesOutput{
+				StackResources: []types.StackResource{{
+					StackName:         input.StackName,
+					LogicalResourceId: aws.String("NodeGroup"),
+				}},
+			}
+		}, nil)
+}
+
+func benchmarkDescribeNodeGroupStacksAndResources(b *testing.B, concurrency int) {
+	p := mockprovider.NewMockProvider()
+	cfg := api.NewClusterConfig()
+	cfg.Metadata.Name = "bench"
+	mockNodeGroupStacks(p, cfg.Metadata.Name, 50)
+	mockStackResources(p, 5*time.Millisecond)
+	sc := NewStackCollection(p, cfg)
+	if err := sc.SetDescribeConcurrency(concurrency); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := sc.DescribeNodeGroupStacksAndResources(context.TODO()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDescribeNodeGroupStacksAndResourcesSequential(b *testing.B) {
+	benchmarkDescribeNodeGroupStacksAndResources(b, 1)
+}
+
+func BenchmarkDescribeNodeGroupStacksAndResourcesConcurrent(b *testing.B) {
+	benchmarkDescribeNodeGroupStacksAndResources(b, defaultDescribeConcurrency)
+}
+
 var _ = Describe("StackCollection NodeGroup", func() {
 	Describe("GetNodeGroupType", func() {
 		createTags := func(tags map[string]string) []types.Tag {
@@ -106,4 +192,2027 @@ var _ = Describe("StackCollection NodeGroup", func() {
 				api.NodeGroupType("")),
 		)
 	})
+
+	Describe("DescribeNodeGroupStacksAndResources", func() {
+		var (
+			p   *mockprovider.MockProvider
+			cfg *api.ClusterConfig
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+		})
+
+		It("returns the resources of every nodegroup keyed by nodegroup name", func() {
+			mockNodeGroupStacks(p, cfg.Metadata.Name, 50)
+			mockStackResources(p, 0)
+			sm := NewStackCollection(p, cfg)
+
+			stackInfos, err := sm.DescribeNodeGroupStacksAndResources(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stackInfos).To(HaveLen(50))
+			for i := 0; i < 50; i++ {
+				ngName := fmt.Sprintf("ng-%d", i)
+				Expect(stackInfos).To(HaveKey(ngName))
+				Expect(*stackInfos[ngName].Resources[0].StackName).To(Equal(fmt.Sprintf("eksctl-test-cluster-nodegroup-%s", ngName)))
+			}
+		})
+
+		It("returns all errors instead of the first one", func() {
+			mockNodeGroupStacks(p, cfg.Metadata.Name, 3)
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, mock.MatchedBy(func(input *cfn.DescribeStackResourcesInput) bool {
+				return *input.StackName != "eksctl-test-cluster-nodegroup-ng-1"
+			})).Return(nil, errors.New("throttled"))
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, mock.Anything).Return(&cfn.DescribeStackResourcesOutput{}, nil)
+			sm := NewStackCollection(p, cfg)
+
+			_, err := sm.DescribeNodeGroupStacksAndResources(context.TODO())
+			Expect(err).To(MatchError(ContainSubstring("2 errors occurred")))
+			Expect(err.Error()).To(ContainSubstring(`"eksctl-test-cluster-nodegroup-ng-0" stack`))
+			Expect(err.Error()).To(ContainSubstring(`"eksctl-test-cluster-nodegroup-ng-2" stack`))
+		})
+
+		It("describes the stacks one at a time with a concurrency of 1", func() {
+			mockNodeGroupStacks(p, cfg.Metadata.Name, 3)
+			mockStackResources(p, 0)
+			sm := NewStackCollection(p, cfg)
+			Expect(sm.SetDescribeConcurrency(1)).To(Succeed())
+
+			stackInfos, err := sm.DescribeNodeGroupStacksAndResources(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stackInfos).To(HaveLen(3))
+		})
+
+		It("rejects a concurrency below 1", func() {
+			sm := NewStackCollection(p, cfg)
+			Expect(sm.SetDescribeConcurrency(0)).To(MatchError("describe concurrency must be at least 1, got 0"))
+		})
+	})
+
+	Describe("CheckNodeGroupVersionCompatibility", func() {
+		It("returns the nodegroups created by a newer eksctl version", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			stacks := map[string]string{
+				"ng-old":    "0.1.0",
+				"ng-newer":  "999.0.0",
+				"ng-legacy": "",
+			}
+			var summaries []types.StackSummary
+			for ngName, eksctlVersion := range stacks {
+				stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+				summaries = append(summaries, types.StackSummary{StackName: stackName})
+				tags := []types.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)}}
+				if eksctlVersion != "" {
+					tags = append(tags, types.Tag{Key: aws.String(api.EksctlVersionTag), Value: aws.String(eksctlVersion)})
+				}
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, Tags: tags}},
+				}, nil)
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{StackSummaries: summaries}, nil)
+
+			incompatible, err := NewStackCollection(p, cfg).CheckNodeGroupVersionCompatibility(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(incompatible).To(ConsistOf("ng-newer"))
+		})
+	})
+
+	Describe("BackfillEksctlVersionTag", func() {
+		It("tags the updatable nodegroup stacks that lack the eksctl version tag", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			stacks := []types.Stack{
+				{
+					StackName:   aws.String("eksctl-test-cluster-nodegroup-ng-tagged"),
+					StackStatus: types.StackStatusCreateComplete,
+					Tags: []types.Tag{
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-tagged")},
+						{Key: aws.String(api.EksctlVersionTag), Value: aws.String("0.1.0")},
+					},
+				},
+				{
+					StackName:   aws.String("eksctl-test-cluster-nodegroup-ng-legacy"),
+					StackStatus: types.StackStatusCreateComplete,
+					Parameters:  []types.Parameter{{ParameterKey: aws.String("ClusterName"), ParameterValue: aws.String("test-cluster")}},
+					Tags:        []types.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-legacy")}},
+				},
+				{
+					StackName:   aws.String("eksctl-test-cluster-nodegroup-ng-busy"),
+					StackStatus: types.StackStatusUpdateInProgress,
+					Tags:        []types.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-busy")}},
+				},
+			}
+			var summaries []types.StackSummary
+			for _, s := range stacks {
+				summaries = append(summaries, types.StackSummary{StackName: s.StackName})
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: s.StackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{s},
+				}, nil)
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{StackSummaries: summaries}, nil)
+			p.MockCloudFormation().On("UpdateStack", mock.Anything, mock.Anything).Return(&cfn.UpdateStackOutput{}, nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: stacks[1].StackName, StackStatus: types.StackStatusUpdateComplete}},
+			}, nil)
+
+			updated, err := NewStackCollection(p, cfg).BackfillEksctlVersionTag(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(ConsistOf("ng-legacy"))
+
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "UpdateStack", 1)
+			var input *cfn.UpdateStackInput
+			for _, call := range p.MockCloudFormation().Calls {
+				if call.Method == "UpdateStack" {
+					input = call.Arguments.Get(1).(*cfn.UpdateStackInput)
+				}
+			}
+			Expect(*input.StackName).To(Equal("eksctl-test-cluster-nodegroup-ng-legacy"))
+			Expect(*input.UsePreviousTemplate).To(BeTrue())
+			Expect(input.Parameters).To(Equal([]types.Parameter{{ParameterKey: aws.String("ClusterName"), UsePreviousValue: aws.Bool(true)}}))
+			Expect(input.Tags).To(ConsistOf(
+				types.Tag{Key: aws.String(api.EksctlVersionTag), Value: aws.String(version.GetVersion())},
+				types.Tag{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-legacy")},
+			))
+		})
+	})
+
+	Describe("GetAutoScalingGroupCapacity", func() {
+		var p *mockprovider.MockProvider
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+		})
+
+		It("returns the capacity of the autoscaling group", func() {
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, &autoscaling.DescribeAutoScalingGroupsInput{
+				AutoScalingGroupNames: []string{"asg-1"},
+			}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+				AutoScalingGroups: []asgtypes.AutoScalingGroup{{
+					MinSize:         aws.Int32(1),
+					MaxSize:         aws.Int32(5),
+					DesiredCapacity: aws.Int32(3),
+				}},
+			}, nil)
+
+			capacity, err := NewStackCollection(p, api.NewClusterConfig()).GetAutoScalingGroupCapacity(context.TODO(), "asg-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(capacity).To(Equal(ScalingConfig{Min: 1, Max: 5, Desired: 3}))
+		})
+
+		It("returns an error when the autoscaling group is not found", func() {
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+
+			_, err := NewStackCollection(p, api.NewClusterConfig()).GetAutoScalingGroupCapacity(context.TODO(), "asg-1")
+			Expect(err).To(MatchError("couldn't find ASG: asg-1"))
+		})
+	})
+
+	Describe("TotalDesiredCapacity", func() {
+		It("sums the desired capacity of all autoscaling groups of managed and unmanaged nodegroups", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			clusterTag := types.Tag{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")}
+			stacks := []types.Stack{
+				{
+					StackName: aws.String("eksctl-test-cluster-nodegroup-ng"),
+					Tags:      []types.Tag{clusterTag, {Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng")}},
+				},
+				{
+					StackName: aws.String("eksctl-test-cluster-nodegroup-mng"),
+					Tags: []types.Tag{
+						clusterTag,
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("mng")},
+						{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeManaged))},
+					},
+				},
+			}
+			var summaries []types.StackSummary
+			for _, s := range stacks {
+				summaries = append(summaries, types.StackSummary{StackName: s.StackName})
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: s.StackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{s},
+				}, nil)
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{StackSummaries: summaries}, nil)
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything, mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &types.StackResourceDetail{PhysicalResourceId: aws.String("asg-ng")},
+			}, nil)
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{
+					Resources: &eks.NodegroupResources{
+						AutoScalingGroups: []*eks.AutoScalingGroup{{Name: aws.String("asg-mng-1")}, {Name: aws.String("asg-mng-2")}},
+					},
+				},
+			}, nil)
+			for name, desired := range map[string]int32{"asg-ng": 3, "asg-mng-1": 2, "asg-mng-2": 1} {
+				p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, &autoscaling.DescribeAutoScalingGroupsInput{
+					AutoScalingGroupNames: []string{name},
+				}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+					AutoScalingGroups: []asgtypes.AutoScalingGroup{{DesiredCapacity: aws.Int32(desired)}},
+				}, nil)
+			}
+
+			total, err := NewStackCollection(p, cfg).TotalDesiredCapacity(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(Equal(6))
+		})
+
+		It("returns an error when the autoscaling group of a nodegroup can't be described", func() {
+			p := mockprovider.NewMockProvider()
+			stackName := aws.String("eksctl-test-cluster-nodegroup-ng")
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{
+				StackSummaries: []types.StackSummary{{StackName: stackName}},
+			}, nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng")}}}},
+			}, nil)
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything, mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &types.StackResourceDetail{PhysicalResourceId: aws.String("asg-ng")},
+			}, nil)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			_, err := NewStackCollection(p, cfg).TotalDesiredCapacity(context.TODO())
+			Expect(err).To(MatchError(`getting the capacity of nodegroup "ng": couldn't find ASG: asg-ng`))
+		})
+	})
+
+	Describe("SetAutoScalingGroupCapacity", func() {
+		var p *mockprovider.MockProvider
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+		})
+
+		It("updates the capacity of the autoscaling group", func() {
+			p.MockASG().On("UpdateAutoScalingGroup", mock.Anything, &autoscaling.UpdateAutoScalingGroupInput{
+				AutoScalingGroupName: aws.String("asg-1"),
+				MinSize:              aws.Int32(1),
+				MaxSize:              aws.Int32(5),
+				DesiredCapacity:      aws.Int32(3),
+			}).Return(&autoscaling.UpdateAutoScalingGroupOutput{}, nil)
+
+			err := NewStackCollection(p, api.NewClusterConfig()).SetAutoScalingGroupCapacity(context.TODO(), "asg-1", ScalingConfig{Min: 1, Max: 5, Desired: 3})
+			Expect(err).NotTo(HaveOccurred())
+			p.MockASG().AssertNumberOfCalls(GinkgoT(), "UpdateAutoScalingGroup", 1)
+		})
+
+		It("rejects a desired capacity outside of [min, max]", func() {
+			err := NewStackCollection(p, api.NewClusterConfig()).SetAutoScalingGroupCapacity(context.TODO(), "asg-1", ScalingConfig{Min: 1, Max: 5, Desired: 6})
+			Expect(err).To(MatchError(ContainSubstring("desired (6) must be within [1, 5]")))
+			p.MockASG().AssertNotCalled(GinkgoT(), "UpdateAutoScalingGroup", mock.Anything, mock.Anything)
+		})
+	})
+
+	Describe("ListNodeGroupStacksByType", func() {
+		It("returns only the nodegroups of the given type", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			stacks := map[string]string{
+				"mng":    string(api.NodeGroupTypeManaged),
+				"ng":     string(api.NodeGroupTypeUnmanaged),
+				"legacy": "",
+			}
+			var summaries []types.StackSummary
+			for ngName, ngType := range stacks {
+				stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+				summaries = append(summaries, types.StackSummary{StackName: stackName})
+				tags := []types.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)}}
+				if ngType != "" {
+					tags = append(tags, types.Tag{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(ngType)})
+				}
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, Tags: tags}},
+				}, nil)
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{StackSummaries: summaries}, nil)
+			sm := NewStackCollection(p, cfg)
+
+			managed, err := sm.ListNodeGroupStacksByType(context.TODO(), api.NodeGroupTypeManaged)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(managed).To(HaveLen(1))
+			Expect(managed[0].NodeGroupName).To(Equal("mng"))
+
+			unmanaged, err := sm.ListNodeGroupStacksByType(context.TODO(), api.NodeGroupTypeUnmanaged)
+			Expect(err).NotTo(HaveOccurred())
+			var names []string
+			for _, s := range unmanaged {
+				names = append(names, s.NodeGroupName)
+			}
+			Expect(names).To(ConsistOf("ng", "legacy"))
+		})
+	})
+
+	Describe("ForceDeleteNodeGroupStack", func() {
+		const stackName = "eksctl-test-cluster-nodegroup-ng"
+
+		var (
+			p  *mockprovider.MockProvider
+			sm StackManager
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: aws.String(stackName)}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String(stackName), StackId: aws.String("stack-id"), StackStatus: types.StackStatusDeleteFailed}},
+			}, nil).Once()
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, &cfn.DescribeStackResourcesInput{StackName: aws.String(stackName)}).Return(&cfn.DescribeStackResourcesOutput{
+				StackResources: []types.StackResource{
+					{LogicalResourceId: aws.String("SG"), ResourceStatus: types.ResourceStatusDeleteFailed},
+					{LogicalResourceId: aws.String("NodeGroup"), ResourceStatus: types.ResourceStatusDeleteComplete},
+				},
+			}, nil)
+			sm = NewStackCollection(p, cfg)
+		})
+
+		It("deletes the stack retaining the given resources", func() {
+			p.MockCloudFormation().On("DeleteStack", mock.Anything, &cfn.DeleteStackInput{
+				StackName:       aws.String("stack-id"),
+				RetainResources: []string{"SG"},
+			}).Return(&cfn.DeleteStackOutput{}, nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String(stackName), StackStatus: types.StackStatusDeleteComplete}},
+			}, nil)
+
+			Expect(sm.ForceDeleteNodeGroupStack(context.TODO(), "ng", []string{"SG"})).To(Succeed())
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DeleteStack", 1)
+		})
+
+		It("suggests the resources that failed to be deleted when none are given", func() {
+			err := sm.ForceDeleteNodeGroupStack(context.TODO(), "ng", nil)
+			Expect(err).To(MatchError(ContainSubstring("resources that failed to be deleted: SG")))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DeleteStack", mock.Anything, mock.Anything)
+		})
+	})
+
+	Describe("FindOrphanedNodeGroupENIs", func() {
+		const stackName = "eksctl-test-cluster-nodegroup-ng"
+
+		var (
+			p  *mockprovider.MockProvider
+			sm StackManager
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			sm = NewStackCollection(p, cfg)
+		})
+
+		It("returns the network interfaces using the nodegroup's security groups", func() {
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, &cfn.DescribeStackResourcesInput{StackName: aws.String(stackName)}).Return(&cfn.DescribeStackResourcesOutput{
+				StackResources: []types.StackResource{
+					{ResourceType: aws.String("AWS::EC2::SecurityGroup"), PhysicalResourceId: aws.String("sg-1")},
+					{ResourceType: aws.String("AWS::AutoScaling::AutoScalingGroup"), PhysicalResourceId: aws.String("asg-1")},
+				},
+			}, nil)
+			p.MockEC2().On("DescribeNetworkInterfaces", mock.Anything, &ec2.DescribeNetworkInterfacesInput{
+				Filters: []ec2types.Filter{{Name: aws.String("group-id"), Values: []string{"sg-1"}}},
+			}, mock.Anything).Return(&ec2.DescribeNetworkInterfacesOutput{
+				NetworkInterfaces: []ec2types.NetworkInterface{{NetworkInterfaceId: aws.String("eni-1")}, {NetworkInterfaceId: aws.String("eni-2")}},
+			}, nil)
+
+			eniIDs, err := sm.FindOrphanedNodeGroupENIs(context.TODO(), "ng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eniIDs).To(Equal([]string{"eni-1", "eni-2"}))
+		})
+
+		It("returns an empty list when the stack no longer exists", func() {
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, mock.Anything).Return(nil, &smithy.OperationError{
+				Err: &smithy.GenericAPIError{Code: "ValidationError", Message: "Stack with id eksctl-test-cluster-nodegroup-ng does not exist"},
+			})
+
+			eniIDs, err := sm.FindOrphanedNodeGroupENIs(context.TODO(), "ng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eniIDs).To(BeEmpty())
+			p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeNetworkInterfaces", mock.Anything, mock.Anything, mock.Anything)
+		})
+
+		It("returns other validation errors", func() {
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, mock.Anything).Return(nil, &smithy.OperationError{
+				Err: &smithy.GenericAPIError{Code: "ValidationError", Message: "1 validation error detected"},
+			})
+
+			_, err := sm.FindOrphanedNodeGroupENIs(context.TODO(), "ng")
+			Expect(err).To(MatchError(ContainSubstring("1 validation error detected")))
+		})
+	})
+
+	Describe("DescribeNodeGroupStackByID", func() {
+		const stackID = "arn:aws:cloudformation:us-west-2:123456789012:stack/eksctl-test-cluster-nodegroup-ng/id"
+
+		var p *mockprovider.MockProvider
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+		})
+
+		mockStack := func(tags []types.Tag) {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: aws.String(stackID)}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String("eksctl-test-cluster-nodegroup-ng"), StackId: aws.String(stackID), Tags: tags}},
+			}, nil)
+		}
+
+		It("returns the nodegroup stack", func() {
+			mockStack([]types.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng")}})
+
+			stack, err := NewStackCollection(p, api.NewClusterConfig()).DescribeNodeGroupStackByID(context.TODO(), stackID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*stack.StackId).To(Equal(stackID))
+		})
+
+		It("errors when the stack is not a nodegroup stack", func() {
+			mockStack(nil)
+
+			_, err := NewStackCollection(p, api.NewClusterConfig()).DescribeNodeGroupStackByID(context.TODO(), stackID)
+			Expect(err).To(MatchError(`stack "eksctl-test-cluster-nodegroup-ng" is not a nodegroup stack`))
+		})
+	})
+
+	Describe("NodeGroupStacksJSON", func() {
+		It("serializes the nodegroup stacks sorted by name", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			creationTime := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+			var summaries []types.StackSummary
+			for _, ngName := range []string{"ng-b", "ng-a"} {
+				stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+				summaries = append(summaries, types.StackSummary{StackName: stackName})
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{
+						StackName:    stackName,
+						StackStatus:  types.StackStatusCreateComplete,
+						CreationTime: &creationTime,
+						Tags: []types.Tag{
+							{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+							{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeManaged))},
+							{Key: aws.String(api.EksctlVersionTag), Value: aws.String("0.90.0")},
+						},
+					}},
+				}, nil)
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{StackSummaries: summaries}, nil)
+
+			out, err := NewStackCollection(p, cfg).NodeGroupStacksJSON(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(MatchJSON(`[
+				{"name": "ng-a", "type": "managed", "stackName": "eksctl-test-cluster-nodegroup-ng-a", "stackStatus": "CREATE_COMPLETE", "creationTime": "2022-03-01T12:00:00Z", "eksctlVersion": "0.90.0"},
+				{"name": "ng-b", "type": "managed", "stackName": "eksctl-test-cluster-nodegroup-ng-b", "stackStatus": "CREATE_COMPLETE", "creationTime": "2022-03-01T12:00:00Z", "eksctlVersion": "0.90.0"}
+			]`))
+		})
+	})
+
+	Describe("GetManagedNodeGroupLaunchTemplate", func() {
+		var (
+			p     *mockprovider.MockProvider
+			stack *Stack
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			stack = &Stack{
+				StackName: aws.String("eksctl-test-cluster-nodegroup-mng"),
+				Tags: []types.Tag{
+					{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("mng")},
+				},
+			}
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String("mng"),
+			}).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{
+					LaunchTemplate: &eks.LaunchTemplateSpecification{Id: aws.String("lt-1"), Version: aws.String("3")},
+				},
+			}, nil)
+		})
+
+		It("returns the custom launch template of the nodegroup", func() {
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, mock.Anything).Return(&cfn.DescribeStackResourcesOutput{}, nil)
+
+			id, version, err := NewStackCollection(p, api.NewClusterConfig()).GetManagedNodeGroupLaunchTemplate(context.TODO(), stack)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal("lt-1"))
+			Expect(version).To(Equal("3"))
+		})
+
+		It("returns ErrEksctlManagedLaunchTemplate when the launch template is part of the stack", func() {
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, mock.Anything).Return(&cfn.DescribeStackResourcesOutput{
+				StackResources: []types.StackResource{
+					{ResourceType: aws.String("AWS::EC2::LaunchTemplate"), PhysicalResourceId: aws.String("lt-1")},
+				},
+			}, nil)
+
+			id, _, err := NewStackCollection(p, api.NewClusterConfig()).GetManagedNodeGroupLaunchTemplate(context.TODO(), stack)
+			Expect(err).To(Equal(ErrEksctlManagedLaunchTemplate))
+			Expect(id).To(Equal("lt-1"))
+		})
+	})
+
+	Describe("UsesCustomLaunchTemplate", func() {
+		var (
+			p   *mockprovider.MockProvider
+			cfg *api.ClusterConfig
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			stackName := aws.String("eksctl-test-cluster-nodegroup-mng")
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{
+					{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("mng")},
+					{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeManaged))},
+				}}},
+			}, nil)
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{
+					LaunchTemplate: &eks.LaunchTemplateSpecification{Id: aws.String("lt-1"), Version: aws.String("3")},
+				},
+			}, nil)
+		})
+
+		It("reports the launch template set in the nodegroup's config as custom", func() {
+			cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{{
+				NodeGroupBase:  &api.NodeGroupBase{Name: "mng"},
+				LaunchTemplate: &api.LaunchTemplate{ID: "lt-config"},
+			}}
+
+			custom, id, err := NewStackCollection(p, cfg).UsesCustomLaunchTemplate(context.TODO(), "mng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(custom).To(BeTrue())
+			Expect(id).To(Equal("lt-config"))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStacks", mock.Anything, mock.Anything)
+		})
+
+		It("reports a launch template that is not part of the stack as custom", func() {
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, mock.Anything).Return(&cfn.DescribeStackResourcesOutput{}, nil)
+
+			custom, id, err := NewStackCollection(p, cfg).UsesCustomLaunchTemplate(context.TODO(), "mng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(custom).To(BeTrue())
+			Expect(id).To(Equal("lt-1"))
+		})
+
+		It("reports a launch template that is part of the stack as created by eksctl", func() {
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, mock.Anything).Return(&cfn.DescribeStackResourcesOutput{
+				StackResources: []types.StackResource{
+					{ResourceType: aws.String("AWS::EC2::LaunchTemplate"), PhysicalResourceId: aws.String("lt-1")},
+				},
+			}, nil)
+
+			custom, id, err := NewStackCollection(p, cfg).UsesCustomLaunchTemplate(context.TODO(), "mng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(custom).To(BeFalse())
+			Expect(id).To(Equal("lt-1"))
+		})
+	})
+
+	Describe("FindNodeGroupsUsingInstanceTypes", func() {
+		It("returns the managed and unmanaged nodegroups using the given instance types", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			stacks := map[string]api.NodeGroupType{
+				"mng-types": api.NodeGroupTypeManaged,
+				"mng-lt":    api.NodeGroupTypeManaged,
+				"ng":        api.NodeGroupTypeUnmanaged,
+				"ng-mixed":  api.NodeGroupTypeUnmanaged,
+			}
+			var summaries []types.StackSummary
+			for ngName, ngType := range stacks {
+				stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+				summaries = append(summaries, types.StackSummary{StackName: stackName})
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{
+						{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+						{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(ngType))},
+					}}},
+				}, nil)
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{StackSummaries: summaries}, nil)
+
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String("mng-types"),
+			}).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{InstanceTypes: aws.StringSlice([]string{"t3.large", "m4.large"})},
+			}, nil)
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String("mng-lt"),
+			}).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{LaunchTemplate: &eks.LaunchTemplateSpecification{Id: aws.String("lt-1"), Version: aws.String("1")}},
+			}, nil)
+			p.MockEC2().On("DescribeLaunchTemplateVersions", mock.Anything, &ec2.DescribeLaunchTemplateVersionsInput{
+				LaunchTemplateId: aws.String("lt-1"),
+				Versions:         []string{"1"},
+			}).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+				LaunchTemplateVersions: []ec2types.LaunchTemplateVersion{{
+					LaunchTemplateData: &ec2types.ResponseLaunchTemplateData{InstanceType: ec2types.InstanceTypeM5Large},
+				}},
+			}, nil)
+			p.MockCloudFormation().On("GetTemplate", mock.Anything, &cfn.GetTemplateInput{StackName: aws.String("eksctl-test-cluster-nodegroup-ng")}).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(`{"Resources": {"NodeGroupLaunchTemplate": {"Type": "AWS::EC2::LaunchTemplate", "Properties": {"LaunchTemplateData": {"InstanceType": "m5.large"}}}}}`),
+			}, nil)
+			p.MockCloudFormation().On("GetTemplate", mock.Anything, &cfn.GetTemplateInput{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-mixed")}).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(`{"Resources": {"NodeGroup": {"Type": "AWS::AutoScaling::AutoScalingGroup", "Properties": {"MixedInstancesPolicy": {"LaunchTemplate": {"Overrides": [{"InstanceType": "t3.large"}, {"InstanceType": "t3a.large"}]}}}}}}`),
+			}, nil)
+
+			sm := NewStackCollection(p, cfg)
+			names, err := sm.FindNodeGroupsUsingInstanceTypes(context.TODO(), []string{"m5.large"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(ConsistOf("mng-lt", "ng"))
+
+			names, err = sm.FindNodeGroupsUsingInstanceTypes(context.TODO(), []string{"t3a.large", "m4.large"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(ConsistOf("mng-types", "ng-mixed"))
+		})
+	})
+
+	Describe("DeleteNodeGroupStacks", func() {
+		It("deletes unmanaged nodegroups before managed ones and reports all failures", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			for ngName, ngType := range map[string]api.NodeGroupType{
+				"ng":  api.NodeGroupTypeUnmanaged,
+				"mng": api.NodeGroupTypeManaged,
+			} {
+				stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, StackId: stackName, StackStatus: types.StackStatusCreateComplete, Tags: []types.Tag{
+						{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+						{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(ngType))},
+					}}},
+				}, nil).Once()
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}, mock.Anything).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, StackStatus: types.StackStatusDeleteComplete}},
+				}, nil)
+			}
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: aws.String("eksctl-test-cluster-nodegroup-missing")}).
+				Return(nil, errors.New("stack not found"))
+			p.MockCloudFormation().On("DeleteStack", mock.Anything, mock.Anything).Return(&cfn.DeleteStackOutput{}, nil)
+
+			var drained []string
+			err := NewStackCollection(p, cfg).DeleteNodeGroupStacks(context.TODO(), []string{"mng", "missing", "ng"}, DeleteOptions{
+				Drain: func(_ context.Context, nodeGroupName string) error {
+					drained = append(drained, nodeGroupName)
+					return nil
+				},
+			})
+			Expect(err).To(MatchError(ContainSubstring(`deleting nodegroup "missing"`)))
+			Expect(drained).To(Equal([]string{"ng", "mng"}))
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DeleteStack", 2)
+		})
+
+		It("retries a deletion that failed once, retaining the given resources", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			stackName := aws.String("eksctl-test-cluster-nodegroup-ng")
+			describeStack := func(status types.StackStatus) *cfn.DescribeStacksOutput {
+				return &cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, StackId: stackName, StackStatus: status, Tags: []types.Tag{
+						{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng")},
+						{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeUnmanaged))},
+					}}},
+				}
+			}
+			input := &cfn.DescribeStacksInput{StackName: stackName}
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, input).Return(describeStack(types.StackStatusCreateComplete), nil).Once()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, input).Return(describeStack(types.StackStatusDeleteFailed), nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, input, mock.Anything).Return(describeStack(types.StackStatusDeleteFailed), nil).Once()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, input, mock.Anything).Return(describeStack(types.StackStatusDeleteComplete), nil)
+			p.MockCloudFormation().On("DeleteStack", mock.Anything, mock.Anything).Return(&cfn.DeleteStackOutput{}, nil)
+
+			sc := NewStackCollection(p, cfg).(*StackCollection)
+			sc.deleteWaitInterval = time.Millisecond
+			Expect(sc.DeleteNodeGroupStacks(context.TODO(), []string{"ng"}, DeleteOptions{
+				RetainResources: map[string][]string{"ng": {"NodeInstanceRole"}},
+			})).To(Succeed())
+
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DeleteStack", 2)
+			p.MockCloudFormation().AssertCalled(GinkgoT(), "DeleteStack", mock.Anything, &cfn.DeleteStackInput{
+				StackName:       stackName,
+				RetainResources: []string{"NodeInstanceRole"},
+			})
+		})
+
+		It("does not retry a failed deletion when no resources are to be retained", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			stackName := aws.String("eksctl-test-cluster-nodegroup-ng")
+			input := &cfn.DescribeStacksInput{StackName: stackName}
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, input).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: stackName, StackId: stackName, StackStatus: types.StackStatusCreateComplete, Tags: []types.Tag{
+					{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng")},
+					{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeUnmanaged))},
+				}}},
+			}, nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, input, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: stackName, StackStatus: types.StackStatusDeleteFailed}},
+			}, nil)
+			p.MockCloudFormation().On("DeleteStack", mock.Anything, mock.Anything).Return(&cfn.DeleteStackOutput{}, nil)
+
+			sc := NewStackCollection(p, cfg).(*StackCollection)
+			sc.deleteWaitInterval = time.Millisecond
+			err := sc.DeleteNodeGroupStacks(context.TODO(), []string{"ng"}, DeleteOptions{})
+			Expect(err).To(MatchError(ContainSubstring(`deleting nodegroup "ng"`)))
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DeleteStack", 1)
+		})
+	})
+
+	Describe("DescribeNodeGroupStacks", func() {
+		It("returns the nodegroup stacks from all pages of stacks", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			for _, ngName := range []string{"ng-1", "ng-2"} {
+				stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)}}}},
+				}, nil)
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.MatchedBy(func(input *cfn.ListStacksInput) bool {
+				return input.NextToken == nil
+			}), mock.Anything).Return(&cfn.ListStacksOutput{
+				StackSummaries: []types.StackSummary{{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1")}},
+				NextToken:      aws.String("page-2"),
+			}, nil)
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.MatchedBy(func(input *cfn.ListStacksInput) bool {
+				return aws.StringValue(input.NextToken) == "page-2"
+			}), mock.Anything).Return(&cfn.ListStacksOutput{
+				StackSummaries: []types.StackSummary{{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-2")}},
+			}, nil)
+
+			stacks, err := NewStackCollection(p, cfg).DescribeNodeGroupStacks(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			var names []string
+			for _, s := range stacks {
+				names = append(names, *s.StackName)
+			}
+			Expect(names).To(Equal([]string{"eksctl-test-cluster-nodegroup-ng-1", "eksctl-test-cluster-nodegroup-ng-2"}))
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "ListStacks", 2)
+		})
+	})
+
+	Describe("DescribeNodeGroupStacksMatchingTags", func() {
+		It("returns the nodegroup stacks bearing all of the given tags", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			stacks := map[string]types.StackStatus{
+				"ng-prod":    types.StackStatusCreateComplete,
+				"ng-dev":     types.StackStatusCreateComplete,
+				"ng-deleted": types.StackStatusDeleteFailed,
+			}
+			var summaries []types.StackSummary
+			for ngName, status := range stacks {
+				stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+				summaries = append(summaries, types.StackSummary{StackName: stackName})
+				env := "prod"
+				if ngName == "ng-dev" {
+					env = "dev"
+				}
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, StackStatus: status, Tags: []types.Tag{
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+						{Key: aws.String("env"), Value: aws.String(env)},
+						{Key: aws.String("team"), Value: aws.String("platform")},
+					}}},
+				}, nil)
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{StackSummaries: summaries}, nil)
+
+			matching, err := NewStackCollection(p, cfg).DescribeNodeGroupStacksMatchingTags(context.TODO(), map[string]string{
+				"env":  "prod",
+				"team": "platform",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matching).To(HaveLen(1))
+			Expect(*matching[0].StackName).To(Equal("eksctl-test-cluster-nodegroup-ng-prod"))
+		})
+	})
+
+	Describe("DescribeNodeGroupStacksCreatedBetween", func() {
+		It("returns the nodegroup stacks created within the time window", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			start := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+			end := start.Add(time.Hour)
+			stacks := map[string]*time.Time{
+				"ng-before": aws.Time(start.Add(-time.Minute)),
+				"ng-start":  aws.Time(start),
+				"ng-during": aws.Time(start.Add(30 * time.Minute)),
+				"ng-after":  aws.Time(end.Add(time.Second)),
+				"ng-legacy": nil,
+			}
+			var summaries []types.StackSummary
+			for ngName, creationTime := range stacks {
+				stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+				summaries = append(summaries, types.StackSummary{StackName: stackName})
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, CreationTime: creationTime, Tags: []types.Tag{
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+					}}},
+				}, nil)
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{StackSummaries: summaries}, nil)
+
+			matching, err := NewStackCollection(p, cfg).DescribeNodeGroupStacksCreatedBetween(context.TODO(), start, end)
+			Expect(err).NotTo(HaveOccurred())
+			var names []string
+			for _, s := range matching {
+				names = append(names, *s.StackName)
+			}
+			Expect(names).To(ConsistOf("eksctl-test-cluster-nodegroup-ng-start", "eksctl-test-cluster-nodegroup-ng-during"))
+		})
+	})
+
+	Describe("ValidateNodeGroupOwnership", func() {
+		It("reports the nodegroup stacks tagged with the name of another cluster", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test"
+
+			stacks := map[string]string{
+				"eksctl-test-nodegroup-ng":         "test",
+				"eksctl-test-nodegroup-legacy":     "",
+				"eksctl-test-nodegroup-ng-cluster": "test-nodegroup-ng",
+			}
+			var summaries []types.StackSummary
+			for name, clusterName := range stacks {
+				stackName := aws.String(name)
+				summaries = append(summaries, types.StackSummary{StackName: stackName})
+				tags := []types.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(name)}}
+				if clusterName != "" {
+					tags = append(tags, types.Tag{Key: aws.String(api.ClusterNameTag), Value: aws.String(clusterName)})
+				}
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, Tags: tags}},
+				}, nil)
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{StackSummaries: summaries}, nil)
+
+			warnings, err := NewStackCollection(p, cfg).ValidateNodeGroupOwnership(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(OwnershipWarning{
+				StackName:   "eksctl-test-nodegroup-ng-cluster",
+				ClusterName: "test-nodegroup-ng",
+			}))
+		})
+	})
+
+	Describe("IsSpotNodeGroup", func() {
+		newStack := func(ngType api.NodeGroupType) *Stack {
+			return &Stack{
+				StackName: aws.String("eksctl-test-cluster-nodegroup-ng"),
+				Tags: []types.Tag{
+					{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng")},
+					{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(ngType))},
+				},
+			}
+		}
+
+		DescribeTable("unmanaged nodegroups", func(template string, expected bool) {
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("GetTemplate", mock.Anything, mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(template),
+			}, nil)
+
+			spot, err := NewStackCollection(p, api.NewClusterConfig()).IsSpotNodeGroup(context.TODO(), newStack(api.NodeGroupTypeUnmanaged))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spot).To(Equal(expected))
+		},
+			Entry("on-demand", `{"Resources": {"NodeGroup": {"Type": "AWS::AutoScaling::AutoScalingGroup", "Properties": {"MinSize": "1"}}}}`, false),
+			Entry("mixed instances with on-demand only", `{"Resources": {"NodeGroup": {"Type": "AWS::AutoScaling::AutoScalingGroup", "Properties": {"MixedInstancesPolicy": {"InstancesDistribution": {"OnDemandPercentageAboveBaseCapacity": "100"}}}}}}`, false),
+			Entry("mixed instances with spot", `{"Resources": {"NodeGroup": {"Type": "AWS::AutoScaling::AutoScalingGroup", "Properties": {"MixedInstancesPolicy": {"InstancesDistribution": {"OnDemandPercentageAboveBaseCapacity": "0"}}}}}}`, true),
+			Entry("spot market type", `{"Resources": {"NodeGroup": {"Type": "AWS::AutoScaling::AutoScalingGroup", "Properties": {}}, "NodeGroupLaunchTemplate": {"Type": "AWS::EC2::LaunchTemplate", "Properties": {"LaunchTemplateData": {"InstanceMarketOptions": {"MarketType": "spot"}}}}}}`, true),
+		)
+
+		It("errors when the template has no NodeGroup resource", func() {
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("GetTemplate", mock.Anything, mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(`{"Resources": {}}`),
+			}, nil)
+
+			_, err := NewStackCollection(p, api.NewClusterConfig()).IsSpotNodeGroup(context.TODO(), newStack(api.NodeGroupTypeUnmanaged))
+			Expect(err).To(MatchError(ContainSubstring("unable to determine whether nodegroup \"ng\" uses spot instances")))
+		})
+
+		It("uses the capacity type of managed nodegroups", func() {
+			p := mockprovider.NewMockProvider()
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String("ng"),
+			}).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{CapacityType: aws.String(eks.CapacityTypesSpot)},
+			}, nil)
+
+			spot, err := NewStackCollection(p, api.NewClusterConfig()).IsSpotNodeGroup(context.TODO(), newStack(api.NodeGroupTypeManaged))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spot).To(BeTrue())
+		})
+	})
+
+	Describe("HasCNIPolicy", func() {
+		DescribeTable("finds the CNI policy attached to an IAM role", func(managedPolicyARNs string, expected bool) {
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("GetTemplate", mock.Anything, mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(fmt.Sprintf(`{"Resources": {"NodeInstanceRole": {"Type": "AWS::IAM::Role", "Properties": {"ManagedPolicyArns": %s}}}}`, managedPolicyARNs)),
+			}, nil)
+
+			found, err := NewStackCollection(p, api.NewClusterConfig()).HasCNIPolicy(context.TODO(), &Stack{StackName: aws.String("eksctl-test-cluster-nodegroup-ng")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(Equal(expected))
+		},
+			Entry("ARN built with Fn::Sub", `[{"Fn::Sub": "arn:${AWS::Partition}:iam::aws:policy/AmazonEKSWorkerNodePolicy"}, {"Fn::Sub": "arn:${AWS::Partition}:iam::aws:policy/AmazonEKS_CNI_Policy"}]`, true),
+			Entry("literal ARN", `["arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy"]`, true),
+			Entry("no CNI policy", `[{"Fn::Sub": "arn:${AWS::Partition}:iam::aws:policy/AmazonEKSWorkerNodePolicy"}]`, false),
+		)
+	})
+
+	Describe("CreateNodeGroupStacks", func() {
+		It("attempts to create all nodegroups and reports each failure", func() {
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("throttled"))
+
+			err := NewStackCollection(p, api.NewClusterConfig()).CreateNodeGroupStacks(context.TODO(), []NodeGroupSpec{
+				{ManagedNodeGroup: &api.ManagedNodeGroup{NodeGroupBase: &api.NodeGroupBase{Name: "mng-1"}}},
+				{ManagedNodeGroup: &api.ManagedNodeGroup{NodeGroupBase: &api.NodeGroupBase{Name: "mng-2"}}},
+			}, 2)
+			Expect(err).To(MatchError(ContainSubstring("2 errors occurred")))
+			Expect(err).To(MatchError(ContainSubstring(`creating nodegroup "mng-1"`)))
+			Expect(err).To(MatchError(ContainSubstring(`creating nodegroup "mng-2"`)))
+		})
+
+		It("rejects specs without exactly one nodegroup", func() {
+			err := NewStackCollection(mockprovider.NewMockProvider(), api.NewClusterConfig()).CreateNodeGroupStacks(context.TODO(), []NodeGroupSpec{
+				{NodeGroup: api.NewNodeGroup(), ManagedNodeGroup: api.NewManagedNodeGroup()},
+			}, 1)
+			Expect(err).To(MatchError("exactly one of NodeGroup and ManagedNodeGroup must be set for nodegroup spec at index 0"))
+		})
+	})
+
+	Describe("GetNodeGroupName", func() {
+		newTag := func(key, value string) types.Tag {
+			return types.Tag{Key: aws.String(key), Value: aws.String(value)}
+		}
+
+		DescribeTable("determines the nodegroup name", func(stackName string, tags []types.Tag, expected string) {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			sc := NewStackCollection(mockprovider.NewMockProvider(), cfg)
+			Expect(sc.GetNodeGroupName(&Stack{StackName: aws.String(stackName), Tags: tags})).To(Equal(expected))
+		},
+			Entry("nodegroup name tag", "eksctl-test-cluster-nodegroup-ng", []types.Tag{newTag(api.NodeGroupNameTag, "ng")}, "ng"),
+			Entry("legacy nodegroup of this cluster", "eksctl-test-cluster-nodegroup-0", []types.Tag{newTag(api.OldClusterNameTag, "test-cluster")}, "legacy-nodegroup-0"),
+			Entry("legacy default nodegroup of this cluster", "EKS-test-cluster-DefaultNodeGroup", []types.Tag{newTag(api.ClusterNameTag, "test-cluster")}, "legacy-default"),
+			Entry("untagged stack with the legacy suffix", "eksctl-test-cluster-nodegroup-0", nil, ""),
+			Entry("untagged stack of another cluster with the same name prefix", "eksctl-test-cluster-2-nodegroup-0", nil, ""),
+			Entry("untagged stack with the legacy default suffix", "EKS-test-cluster-DefaultNodeGroup", nil, ""),
+			Entry("untagged stack without a legacy suffix", "user-stack", nil, ""),
+			Entry("legacy nodegroup of another cluster", "eksctl-other-nodegroup-0", []types.Tag{newTag(api.ClusterNameTag, "other")}, ""),
+		)
+	})
+
+	Describe("DiffManagedNodeGroupTags", func() {
+		var (
+			p  *mockprovider.MockProvider
+			sc *StackCollection
+		)
+
+		BeforeEach(func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, cfg).(*StackCollection)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{
+					StackName: aws.String("eksctl-test-cluster-nodegroup-mng"),
+					Tags: []types.Tag{
+						{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("mng")},
+					},
+				}},
+			}, nil)
+		})
+
+		It("diffs the autoscaling group tags against the desired tags", func() {
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String("mng"),
+			}).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{Resources: &eks.NodegroupResources{
+					AutoScalingGroups: []*eks.AutoScalingGroup{{Name: aws.String("asg-1")}},
+				}},
+			}, nil)
+			p.MockASG().On("DescribeTags", mock.Anything, &autoscaling.DescribeTagsInput{
+				Filters: []asgtypes.Filter{{Name: aws.String("auto-scaling-group"), Values: []string{"asg-1"}}},
+			}, mock.Anything).Return(&autoscaling.DescribeTagsOutput{
+				Tags: []asgtypes.TagDescription{
+					{Key: aws.String("team"), Value: aws.String("eks")},
+					{Key: aws.String("env"), Value: aws.String("dev")},
+					{Key: aws.String("stale"), Value: aws.String("true")},
+					{Key: aws.String("eks:nodegroup-name"), Value: aws.String("mng")},
+					{Key: aws.String("k8s.io/cluster-autoscaler/enabled"), Value: aws.String("true")},
+				},
+			}, nil)
+
+			toAdd, toUpdate, toRemove, err := sc.DiffManagedNodeGroupTags(context.TODO(), "mng", map[string]string{
+				"team":        "eks",
+				"env":         "prod",
+				"cost-center": "1234",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(toAdd).To(Equal(map[string]string{"cost-center": "1234"}))
+			Expect(toUpdate).To(Equal(map[string]string{"env": "prod"}))
+			Expect(toRemove).To(Equal(map[string]string{"stale": "true"}))
+		})
+
+		It("errors when the nodegroup has no autoscaling groups", func() {
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{},
+			}, nil)
+
+			_, _, _, err := sc.DiffManagedNodeGroupTags(context.TODO(), "mng", nil)
+			Expect(err).To(MatchError(`no autoscaling groups found for managed nodegroup "mng"`))
+		})
+	})
+
+	Describe("FindNodeGroupsWithStaleLaunchTemplate", func() {
+		It("returns the nodegroups using an older version than the latest one of their launch template", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			stacks := map[string]api.NodeGroupType{
+				"mng-pinned":  api.NodeGroupTypeManaged,
+				"mng-current": api.NodeGroupTypeManaged,
+				"mng-no-lt":   api.NodeGroupTypeManaged,
+				"ng-latest":   api.NodeGroupTypeUnmanaged,
+				"ng-default":  api.NodeGroupTypeUnmanaged,
+			}
+			var summaries []types.StackSummary
+			for ngName, ngType := range stacks {
+				stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+				summaries = append(summaries, types.StackSummary{StackName: stackName})
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{
+						{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+						{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(ngType))},
+					}}},
+				}, nil)
+				if ngType == api.NodeGroupTypeUnmanaged {
+					p.MockCloudFormation().On("DescribeStackResource", mock.Anything, &cfn.DescribeStackResourceInput{
+						StackName:         stackName,
+						LogicalResourceId: aws.String("NodeGroup"),
+					}).Return(&cfn.DescribeStackResourceOutput{
+						StackResourceDetail: &types.StackResourceDetail{PhysicalResourceId: aws.String("asg-" + ngName)},
+					}, nil)
+				}
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{StackSummaries: summaries}, nil)
+
+			mockManagedNodeGroup := func(ngName string, lt *eks.LaunchTemplateSpecification) {
+				p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+					ClusterName:   aws.String("test-cluster"),
+					NodegroupName: aws.String(ngName),
+				}).Return(&eks.DescribeNodegroupOutput{
+					Nodegroup: &eks.Nodegroup{LaunchTemplate: lt},
+				}, nil)
+			}
+			mockManagedNodeGroup("mng-pinned", &eks.LaunchTemplateSpecification{Id: aws.String("lt-1"), Version: aws.String("2")})
+			mockManagedNodeGroup("mng-current", &eks.LaunchTemplateSpecification{Id: aws.String("lt-2"), Version: aws.String("3")})
+			mockManagedNodeGroup("mng-no-lt", nil)
+
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, &autoscaling.DescribeAutoScalingGroupsInput{
+				AutoScalingGroupNames: []string{"asg-ng-latest"},
+			}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+				AutoScalingGroups: []asgtypes.AutoScalingGroup{{
+					LaunchTemplate: &asgtypes.LaunchTemplateSpecification{LaunchTemplateId: aws.String("lt-4"), Version: aws.String("$Latest")},
+				}},
+			}, nil)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, &autoscaling.DescribeAutoScalingGroupsInput{
+				AutoScalingGroupNames: []string{"asg-ng-default"},
+			}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+				AutoScalingGroups: []asgtypes.AutoScalingGroup{{
+					MixedInstancesPolicy: &asgtypes.MixedInstancesPolicy{LaunchTemplate: &asgtypes.LaunchTemplate{
+						LaunchTemplateSpecification: &asgtypes.LaunchTemplateSpecification{LaunchTemplateId: aws.String("lt-3"), Version: aws.String("$Default")},
+					}},
+				}},
+			}, nil)
+
+			mockLaunchTemplateVersions := func(id string, defaultVersion, latestVersion int64) {
+				p.MockEC2().On("DescribeLaunchTemplateVersions", mock.Anything, &ec2.DescribeLaunchTemplateVersionsInput{
+					LaunchTemplateId: aws.String(id),
+					Versions:         []string{"$Latest", "$Default"},
+				}).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+					LaunchTemplateVersions: []ec2types.LaunchTemplateVersion{
+						{VersionNumber: aws.Int64(latestVersion), DefaultVersion: aws.Bool(defaultVersion == latestVersion)},
+						{VersionNumber: aws.Int64(defaultVersion), DefaultVersion: aws.Bool(true)},
+					},
+				}, nil)
+			}
+			mockLaunchTemplateVersions("lt-1", 2, 3)
+			mockLaunchTemplateVersions("lt-2", 3, 3)
+			mockLaunchTemplateVersions("lt-3", 2, 4)
+
+			names, err := NewStackCollection(p, cfg).FindNodeGroupsWithStaleLaunchTemplate(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(ConsistOf("mng-pinned", "ng-default"))
+			p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeLaunchTemplateVersions", mock.Anything, mock.MatchedBy(func(input *ec2.DescribeLaunchTemplateVersionsInput) bool {
+				return aws.StringValue(input.LaunchTemplateId) == "lt-4"
+			}))
+		})
+	})
+
+	Describe("WaitForASGInService", func() {
+		var (
+			p  *mockprovider.MockProvider
+			sc *StackCollection
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+			sc.autoScalingGroupPollInterval = time.Millisecond
+		})
+
+		mockASG := func(states ...asgtypes.LifecycleState) *mock.Call {
+			asg := asgtypes.AutoScalingGroup{AutoScalingGroupName: aws.String("asg-1"), DesiredCapacity: aws.Int32(2)}
+			for i, state := range states {
+				asg.Instances = append(asg.Instances, asgtypes.Instance{
+					InstanceId:     aws.String(fmt.Sprintf("i-%d", i+1)),
+					LifecycleState: state,
+					HealthStatus:   aws.String("Healthy"),
+				})
+			}
+			return p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+				AutoScalingGroups: []asgtypes.AutoScalingGroup{asg},
+			}, nil)
+		}
+
+		It("waits until the desired number of instances are in service", func() {
+			mockASG(asgtypes.LifecycleStateInService, asgtypes.LifecycleStatePending).Once()
+			mockASG(asgtypes.LifecycleStateInService, asgtypes.LifecycleStateInService)
+
+			Expect(sc.WaitForASGInService(context.TODO(), "asg-1", time.Minute)).To(Succeed())
+			p.MockASG().AssertNumberOfCalls(GinkgoT(), "DescribeAutoScalingGroups", 2)
+		})
+
+		It("reports the pending instances on timeout", func() {
+			mockASG(asgtypes.LifecycleStateInService, asgtypes.LifecycleStatePending)
+
+			err := sc.WaitForASGInService(context.TODO(), "asg-1", 50*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring(`waiting for instances of ASG "asg-1" to be in service, pending instances: i-2 (Pending)`)))
+		})
+	})
+
+	Describe("DescribeNodeGroupAMI", func() {
+		var (
+			p  *mockprovider.MockProvider
+			sm StackManager
+		)
+
+		BeforeEach(func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			p = mockprovider.NewMockProvider()
+			sm = NewStackCollection(p, cfg)
+		})
+
+		mockNodeGroupStack := func(ngName string, ngType api.NodeGroupType) {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String("eksctl-test-cluster-nodegroup-" + ngName), Tags: []types.Tag{
+					{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+					{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(ngType))},
+				}}},
+			}, nil)
+		}
+
+		It("returns the AMI id of an unmanaged nodegroup", func() {
+			mockNodeGroupStack("ng", api.NodeGroupTypeUnmanaged)
+			p.MockCloudFormation().On("GetTemplate", mock.Anything, mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(`{"Resources": {"NodeGroupLaunchTemplate": {"Type": "AWS::EC2::LaunchTemplate", "Properties": {"LaunchTemplateData": {"ImageId": "ami-123"}}}}}`),
+			}, nil)
+
+			ami, err := sm.DescribeNodeGroupAMI(context.TODO(), "ng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*ami).To(Equal(NodeGroupAMI{ID: "ami-123", Custom: true}))
+
+			amiID, err := sm.GetNodeGroupAMI(context.TODO(), "ng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(amiID).To(Equal("ami-123"))
+		})
+
+		It("returns the release version of a managed nodegroup using the EKS-optimized AMI", func() {
+			mockNodeGroupStack("mng", api.NodeGroupTypeManaged)
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String("mng"),
+			}).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{ReleaseVersion: aws.String("1.22.6-20220317")},
+			}, nil)
+
+			ami, err := sm.DescribeNodeGroupAMI(context.TODO(), "mng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*ami).To(Equal(NodeGroupAMI{ReleaseVersion: "1.22.6-20220317"}))
+
+			amiID, err := sm.GetNodeGroupAMI(context.TODO(), "mng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(amiID).To(Equal("1.22.6-20220317"))
+		})
+
+		It("returns the custom AMI id of a managed nodegroup", func() {
+			mockNodeGroupStack("mng", api.NodeGroupTypeManaged)
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{
+					ReleaseVersion: aws.String("ami-456"),
+					LaunchTemplate: &eks.LaunchTemplateSpecification{Id: aws.String("lt-1"), Version: aws.String("2")},
+				},
+			}, nil)
+			p.MockEC2().On("DescribeLaunchTemplateVersions", mock.Anything, &ec2.DescribeLaunchTemplateVersionsInput{
+				LaunchTemplateId: aws.String("lt-1"),
+				Versions:         []string{"2"},
+			}).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+				LaunchTemplateVersions: []ec2types.LaunchTemplateVersion{{
+					LaunchTemplateData: &ec2types.ResponseLaunchTemplateData{ImageId: aws.String("ami-456")},
+				}},
+			}, nil)
+
+			ami, err := sm.DescribeNodeGroupAMI(context.TODO(), "mng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*ami).To(Equal(NodeGroupAMI{ID: "ami-456", Custom: true}))
+		})
+	})
+
+	Describe("GetNodeGroupKubernetesVersion", func() {
+		var (
+			p  *mockprovider.MockProvider
+			sm StackManager
+		)
+
+		BeforeEach(func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			p = mockprovider.NewMockProvider()
+			sm = NewStackCollection(p, cfg)
+		})
+
+		mockNodeGroupStack := func(ngName string, ngType api.NodeGroupType) {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String("eksctl-test-cluster-nodegroup-" + ngName), Tags: []types.Tag{
+					{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+					{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(ngType))},
+				}}},
+			}, nil)
+		}
+
+		mockImageID := func(imageID string) {
+			p.MockCloudFormation().On("GetTemplate", mock.Anything, mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(fmt.Sprintf(`{"Resources": {"NodeGroupLaunchTemplate": {"Type": "AWS::EC2::LaunchTemplate", "Properties": {"LaunchTemplateData": {"ImageId": %q}}}}}`, imageID)),
+			}, nil)
+		}
+
+		It("returns the version of a managed nodegroup", func() {
+			mockNodeGroupStack("mng", api.NodeGroupTypeManaged)
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String("mng"),
+			}).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{Version: aws.String("1.21")},
+			}, nil)
+
+			version, err := sm.GetNodeGroupKubernetesVersion(context.TODO(), "mng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal("1.21"))
+		})
+
+		DescribeTable("reads the version of an unmanaged nodegroup from the name of its EKS-optimized AMI", func(amiName, expected string) {
+			mockNodeGroupStack("ng", api.NodeGroupTypeUnmanaged)
+			mockImageID("ami-123")
+			p.MockEC2().On("DescribeImages", mock.Anything, &ec2.DescribeImagesInput{ImageIds: []string{"ami-123"}}).Return(&ec2.DescribeImagesOutput{
+				Images: []ec2types.Image{{ImageId: aws.String("ami-123"), Name: aws.String(amiName)}},
+			}, nil)
+
+			version, err := sm.GetNodeGroupKubernetesVersion(context.TODO(), "ng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal(expected))
+		},
+			Entry("AmazonLinux2", "amazon-eks-node-1.21-v20220123", "1.21"),
+			Entry("AmazonLinux2 GPU", "amazon-eks-gpu-node-1.20-v20220123", "1.20"),
+			Entry("Ubuntu", "ubuntu-eks/k8s_1.19/images/hvm-ssd/ubuntu-focal-20.04-amd64-server-20220118", "1.19"),
+			Entry("Bottlerocket", "bottlerocket-aws-k8s-1.21-x86_64-v1.5.2-1602f3a8", "1.21"),
+			Entry("Windows", "Windows_Server-2019-English-Core-EKS_Optimized-1.21-2022.01.12", "1.21"),
+		)
+
+		It("reads the version of an unmanaged nodegroup from the SSM parameter of its AMI", func() {
+			mockNodeGroupStack("ng", api.NodeGroupTypeUnmanaged)
+			mockImageID("resolve:ssm:/aws/service/eks/optimized-ami/1.22/amazon-linux-2/recommended/image_id")
+
+			version, err := sm.GetNodeGroupKubernetesVersion(context.TODO(), "ng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal("1.22"))
+			p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeImages", mock.Anything, mock.Anything)
+		})
+
+		It("returns ErrUnknownNodeGroupVersion when the unmanaged nodegroup uses a custom AMI", func() {
+			mockNodeGroupStack("ng", api.NodeGroupTypeUnmanaged)
+			mockImageID("ami-123")
+			p.MockEC2().On("DescribeImages", mock.Anything, mock.Anything).Return(&ec2.DescribeImagesOutput{
+				Images: []ec2types.Image{{ImageId: aws.String("ami-123"), Name: aws.String("my-golden-image")}},
+			}, nil)
+
+			_, err := sm.GetNodeGroupKubernetesVersion(context.TODO(), "ng")
+			Expect(errors.Is(err, ErrUnknownNodeGroupVersion)).To(BeTrue())
+			Expect(err).To(MatchError(`AMI "ami-123" of nodegroup "ng" is not an EKS-optimized AMI: unknown Kubernetes version`))
+		})
+	})
+
+	Describe("GetNodeGroupInstanceRoleARN", func() {
+		var (
+			p  *mockprovider.MockProvider
+			sm StackManager
+		)
+
+		BeforeEach(func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			p = mockprovider.NewMockProvider()
+			sm = NewStackCollection(p, cfg)
+		})
+
+		mockNodeGroupStack := func(ngName string, ngType api.NodeGroupType, stackOutputs ...types.Output) {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String("eksctl-test-cluster-nodegroup-" + ngName), Tags: []types.Tag{
+					{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+					{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(ngType))},
+				}, Outputs: stackOutputs}},
+			}, nil)
+		}
+
+		mockStackResources := func(logicalIDs ...string) {
+			var resources []types.StackResource
+			for _, id := range logicalIDs {
+				resources = append(resources, types.StackResource{LogicalResourceId: aws.String(id)})
+			}
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, mock.Anything).Return(&cfn.DescribeStackResourcesOutput{
+				StackResources: resources,
+			}, nil)
+		}
+
+		It("returns the role output of an unmanaged nodegroup", func() {
+			mockNodeGroupStack("ng", api.NodeGroupTypeUnmanaged, types.Output{
+				OutputKey:   aws.String("InstanceRoleARN"),
+				OutputValue: aws.String("arn:aws:iam::123456789012:role/node"),
+			})
+			mockStackResources("NodeInstanceRole", "NodeInstanceProfile")
+
+			roleARN, err := sm.GetNodeGroupInstanceRoleARN(context.TODO(), "ng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(roleARN).To(Equal("arn:aws:iam::123456789012:role/node"))
+		})
+
+		It("returns the node role of a managed nodegroup", func() {
+			mockNodeGroupStack("mng", api.NodeGroupTypeManaged)
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String("mng"),
+			}).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{NodeRole: aws.String("arn:aws:iam::123456789012:role/managed")},
+			}, nil)
+			mockStackResources("NodeInstanceRole", "ManagedNodeGroup")
+
+			roleARN, err := sm.GetNodeGroupInstanceRoleARN(context.TODO(), "mng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(roleARN).To(Equal("arn:aws:iam::123456789012:role/managed"))
+		})
+
+		It("returns ErrPreExistingInstanceRole when the role is not part of the stack", func() {
+			mockNodeGroupStack("ng", api.NodeGroupTypeUnmanaged, types.Output{
+				OutputKey:   aws.String("InstanceRoleARN"),
+				OutputValue: aws.String("arn:aws:iam::123456789012:role/existing"),
+			})
+			mockStackResources("NodeInstanceProfile")
+
+			roleARN, err := sm.GetNodeGroupInstanceRoleARN(context.TODO(), "ng")
+			Expect(err).To(Equal(ErrPreExistingInstanceRole))
+			Expect(roleARN).To(Equal("arn:aws:iam::123456789012:role/existing"))
+		})
+	})
+
+	Describe("GroupNodeGroupsBySecurityGroup", func() {
+		It("groups the nodegroups by the security groups they use", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			var summaries []types.StackSummary
+			for ngName, ngType := range map[string]api.NodeGroupType{
+				"ng-1":     api.NodeGroupTypeUnmanaged,
+				"ng-2":     api.NodeGroupTypeUnmanaged,
+				"mng":      api.NodeGroupTypeManaged,
+				"mng-nosg": api.NodeGroupTypeManaged,
+			} {
+				stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+				summaries = append(summaries, types.StackSummary{StackName: stackName})
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{
+						{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+						{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(ngType))},
+					}}},
+				}, nil)
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{StackSummaries: summaries}, nil)
+
+			for ngName, sgID := range map[string]string{"ng-1": "sg-1", "ng-2": "sg-2"} {
+				p.MockCloudFormation().On("DescribeStackResources", mock.Anything, &cfn.DescribeStackResourcesInput{
+					StackName: aws.String("eksctl-test-cluster-nodegroup-" + ngName),
+				}).Return(&cfn.DescribeStackResourcesOutput{
+					StackResources: []types.StackResource{
+						{ResourceType: aws.String("AWS::EC2::SecurityGroup"), PhysicalResourceId: aws.String(sgID)},
+						{ResourceType: aws.String("AWS::AutoScaling::AutoScalingGroup"), PhysicalResourceId: aws.String("asg")},
+					},
+				}, nil)
+			}
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String("mng"),
+			}).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{Resources: &eks.NodegroupResources{RemoteAccessSecurityGroup: aws.String("sg-1")}},
+			}, nil)
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String("mng-nosg"),
+			}).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{Resources: &eks.NodegroupResources{}},
+			}, nil)
+
+			groups, err := NewStackCollection(p, cfg).GroupNodeGroupsBySecurityGroup(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groups).To(Equal(map[string][]string{
+				"sg-1": {"mng", "ng-1"},
+				"sg-2": {"ng-2"},
+			}))
+		})
+	})
+
+	Describe("ListNodeGroupStacksPaged", func() {
+		var p *mockprovider.MockProvider
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			pages := map[string]*cfn.ListStacksOutput{
+				"": {
+					StackSummaries: []types.StackSummary{
+						{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1")},
+						{StackName: aws.String("eksctl-test-cluster-cluster")},
+						{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-2")},
+					},
+					NextToken: aws.String("page-2"),
+				},
+				"page-2": {
+					StackSummaries: []types.StackSummary{{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-3")}},
+				},
+			}
+			for token, page := range pages {
+				token := token
+				p.MockCloudFormation().On("ListStacks", mock.Anything, mock.MatchedBy(func(input *cfn.ListStacksInput) bool {
+					return aws.StringValue(input.NextToken) == token
+				})).Return(page, nil)
+			}
+			for _, ngName := range []string{"ng-1", "ng-2", "ng-3"} {
+				stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{
+						{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+					}}},
+				}, nil)
+			}
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String("eksctl-test-cluster-cluster")}},
+			}, nil)
+		})
+
+		listPages := func(pageSize int) [][]string {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			sm := NewStackCollection(p, cfg)
+
+			var (
+				pages [][]string
+				token string
+			)
+			for {
+				stacks, next, err := sm.ListNodeGroupStacksPaged(context.TODO(), pageSize, token)
+				Expect(err).NotTo(HaveOccurred())
+				var names []string
+				for _, s := range stacks {
+					names = append(names, s.NodeGroupName)
+				}
+				pages = append(pages, names)
+				if next == "" {
+					return pages
+				}
+				token = next
+			}
+		}
+
+		It("returns pages of the given size across stack listing pages", func() {
+			Expect(listPages(1)).To(Equal([][]string{{"ng-1"}, {"ng-2"}, {"ng-3"}}))
+		})
+
+		It("continues on the next stack listing page when a page ends with it", func() {
+			Expect(listPages(2)).To(Equal([][]string{{"ng-1", "ng-2"}, {"ng-3"}}))
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "ListStacks", 2)
+		})
+
+		It("returns an error for an invalid page token", func() {
+			_, _, err := NewStackCollection(p, api.NewClusterConfig()).ListNodeGroupStacksPaged(context.TODO(), 1, "not a token")
+			Expect(err).To(MatchError(ContainSubstring("invalid page token")))
+		})
+	})
+
+	Describe("GroupNodeGroupsByInstanceRole", func() {
+		It("groups the nodegroups by instance role, skipping those whose role can't be resolved", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			var summaries []types.StackSummary
+			for ngName, ngType := range map[string]api.NodeGroupType{
+				"ng-1":   api.NodeGroupTypeUnmanaged,
+				"ng-2":   api.NodeGroupTypeUnmanaged,
+				"mng":    api.NodeGroupTypeManaged,
+				"broken": api.NodeGroupTypeManaged,
+			} {
+				stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+				summaries = append(summaries, types.StackSummary{StackName: stackName})
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{
+						{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+						{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(ngType))},
+					}, Outputs: []types.Output{{
+						OutputKey:   aws.String("InstanceRoleARN"),
+						OutputValue: aws.String("arn:aws:iam::123456789012:role/node"),
+					}}}},
+				}, nil)
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{StackSummaries: summaries}, nil)
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, &cfn.DescribeStackResourcesInput{
+				StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+			}).Return(&cfn.DescribeStackResourcesOutput{
+				StackResources: []types.StackResource{{LogicalResourceId: aws.String("NodeInstanceRole")}},
+			}, nil)
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, mock.Anything).Return(&cfn.DescribeStackResourcesOutput{}, nil)
+
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String("mng"),
+			}).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{NodeRole: aws.String("arn:aws:iam::123456789012:role/node")},
+			}, nil)
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String("broken"),
+			}).Return(nil, errors.New("access denied"))
+
+			groups, err := NewStackCollection(p, cfg).GroupNodeGroupsByInstanceRole(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groups).To(Equal(map[string][]string{
+				"arn:aws:iam::123456789012:role/node": {"mng", "ng-1", "ng-2"},
+			}))
+		})
+	})
+
+	Describe("DescribeNodeGroupDeletionImpact", func() {
+		It("reports the instances, last availability zones and subnets, and deleted and retained resources", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			var summaries []types.StackSummary
+			for ngName, asg := range map[string]asgtypes.AutoScalingGroup{
+				"ng-1": {
+					AvailabilityZones: []string{"us-west-2a", "us-west-2b"},
+					VPCZoneIdentifier: aws.String("subnet-a,subnet-b"),
+					Instances:         []asgtypes.Instance{{InstanceId: aws.String("i-1")}, {InstanceId: aws.String("i-2")}},
+				},
+				"ng-2": {
+					AvailabilityZones: []string{"us-west-2a"},
+					VPCZoneIdentifier: aws.String("subnet-a"),
+				},
+			} {
+				stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+				asgName := aws.String("asg-" + ngName)
+				summaries = append(summaries, types.StackSummary{StackName: stackName})
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{
+						{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+						{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeUnmanaged))},
+					}}},
+				}, nil)
+				p.MockCloudFormation().On("DescribeStackResource", mock.Anything, &cfn.DescribeStackResourceInput{
+					StackName:         stackName,
+					LogicalResourceId: aws.String("NodeGroup"),
+				}).Return(&cfn.DescribeStackResourceOutput{
+					StackResourceDetail: &types.StackResourceDetail{PhysicalResourceId: asgName},
+				}, nil)
+				asg.AutoScalingGroupName = asgName
+				p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, &autoscaling.DescribeAutoScalingGroupsInput{
+					AutoScalingGroupNames: []string{*asgName},
+				}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: []asgtypes.AutoScalingGroup{asg}}, nil)
+			}
+			p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{StackSummaries: summaries}, nil)
+			p.MockCloudFormation().On("GetTemplate", mock.Anything, mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(`{"Resources": {
+					"NodeGroup": {"Type": "AWS::AutoScaling::AutoScalingGroup"},
+					"NodeInstanceRole": {"Type": "AWS::IAM::Role", "DeletionPolicy": "Retain"},
+					"SG": {"Type": "AWS::EC2::SecurityGroup"}
+				}}`),
+			}, nil)
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, mock.Anything).Return(&cfn.DescribeStackResourcesOutput{
+				StackResources: []types.StackResource{
+					{LogicalResourceId: aws.String("SG"), ResourceStatus: types.ResourceStatusCreateComplete},
+					{LogicalResourceId: aws.String("NodeInstanceRole"), ResourceStatus: types.ResourceStatusCreateComplete},
+					{LogicalResourceId: aws.String("NodeGroup"), ResourceStatus: types.ResourceStatusUpdateComplete},
+				},
+			}, nil)
+
+			impact, err := NewStackCollection(p, cfg).DescribeNodeGroupDeletionImpact(context.TODO(), "ng-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(impact).To(Equal(&DeletionImpact{
+				NodeGroupName:           "ng-1",
+				Instances:               2,
+				LastInAvailabilityZones: []string{"us-west-2b"},
+				LastInSubnets:           []string{"subnet-b"},
+				DeletedResources:        []string{"NodeGroup", "SG"},
+				RetainedResources:       []string{"NodeInstanceRole"},
+			}))
+		})
+	})
+
+	Describe("ValidateNodeGroupSubnetAZs", func() {
+		var p *mockprovider.MockProvider
+
+		mockNodeGroupSubnets := func(subnets map[string]string) {
+			stackName := aws.String("eksctl-test-cluster-nodegroup-ng")
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{
+					{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng")},
+					{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeUnmanaged))},
+				}}},
+			}, nil)
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything, mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &types.StackResourceDetail{PhysicalResourceId: aws.String("asg-ng")},
+			}, nil)
+
+			var (
+				subnetIDs  []string
+				ec2Subnets []ec2types.Subnet
+			)
+			for id, zone := range subnets {
+				subnetIDs = append(subnetIDs, id)
+				ec2Subnets = append(ec2Subnets, ec2types.Subnet{SubnetId: aws.String(id), AvailabilityZone: aws.String(zone)})
+			}
+			sort.Strings(subnetIDs)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+				AutoScalingGroups: []asgtypes.AutoScalingGroup{{
+					AutoScalingGroupName: aws.String("asg-ng"),
+					VPCZoneIdentifier:    aws.String(strings.Join(subnetIDs, ",")),
+				}},
+			}, nil)
+			p.MockEC2().On("DescribeSubnets", mock.Anything, &ec2.DescribeSubnetsInput{SubnetIds: subnetIDs}).Return(&ec2.DescribeSubnetsOutput{
+				Subnets: ec2Subnets,
+			}, nil)
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+		})
+
+		validate := func() ([]string, error) {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			return NewStackCollection(p, cfg).ValidateNodeGroupSubnetAZs(context.TODO(), "ng")
+		}
+
+		It("reports no issues when the subnets are in distinct availability zones", func() {
+			mockNodeGroupSubnets(map[string]string{"subnet-a": "us-west-2a", "subnet-b": "us-west-2b"})
+
+			issues, err := validate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issues).To(BeEmpty())
+		})
+
+		It("reports subnets sharing an availability zone", func() {
+			mockNodeGroupSubnets(map[string]string{"subnet-a": "us-west-2a", "subnet-b": "us-west-2a", "subnet-c": "us-west-2c"})
+
+			issues, err := validate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issues).To(ConsistOf(`subnets subnet-a, subnet-b of nodegroup "ng" are all in availability zone "us-west-2a"`))
+		})
+
+		It("reports nodegroups running in a single availability zone", func() {
+			mockNodeGroupSubnets(map[string]string{"subnet-a": "us-west-2a"})
+
+			issues, err := validate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issues).To(ConsistOf(`nodegroup "ng" only runs in availability zone "us-west-2a"`))
+		})
+	})
+
+	Describe("DescribeNodeGroupStackAndResources", func() {
+		var p *mockprovider.MockProvider
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+		})
+
+		It("describes the stack and resources of the nodegroup", func() {
+			stackName := aws.String("eksctl-test-cluster-nodegroup-ng")
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: stackName}},
+			}, nil)
+			resources := []types.StackResource{{LogicalResourceId: aws.String("NodeGroup"), PhysicalResourceId: aws.String("asg")}}
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, &cfn.DescribeStackResourcesInput{StackName: stackName}).Return(&cfn.DescribeStackResourcesOutput{
+				StackResources: resources,
+			}, nil)
+
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			info, err := NewStackCollection(p, cfg).DescribeNodeGroupStackAndResources(context.TODO(), "ng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*info.Stack.StackName).To(Equal(*stackName))
+			Expect(info.Resources).To(Equal(resources))
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStackResources", 1)
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ListStacks", mock.Anything, mock.Anything)
+		})
+
+		It("returns an error when the nodegroup stack does not exist", func() {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(nil, &smithy.OperationError{
+				Err: &smithy.GenericAPIError{Code: "ValidationError", Message: "Stack with id eksctl-test-cluster-nodegroup-ng does not exist"},
+			})
+
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			_, err := NewStackCollection(p, cfg).DescribeNodeGroupStackAndResources(context.TODO(), "ng")
+			Expect(err).To(MatchError(`stack "eksctl-test-cluster-nodegroup-ng" of nodegroup "ng" not found`))
+		})
+	})
+
+	Describe("GetNodeGroupSecurityGroupIDs", func() {
+		var p *mockprovider.MockProvider
+
+		mockNodeGroup := func(ngName string, ngType api.NodeGroupType, resources ...types.StackResource) {
+			stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: stackName, Tags: []types.Tag{
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+					{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(ngType))},
+				}}},
+			}, nil)
+			p.MockCloudFormation().On("DescribeStackResources", mock.Anything, &cfn.DescribeStackResourcesInput{
+				StackName: stackName,
+			}).Return(&cfn.DescribeStackResourcesOutput{StackResources: resources}, nil)
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+		})
+
+		getSecurityGroupIDs := func(ngName string) ([]string, error) {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			return NewStackCollection(p, cfg).GetNodeGroupSecurityGroupIDs(context.TODO(), ngName)
+		}
+
+		It("returns the security groups of an unmanaged nodegroup stack", func() {
+			mockNodeGroup("ng", api.NodeGroupTypeUnmanaged,
+				types.StackResource{ResourceType: aws.String("AWS::EC2::SecurityGroup"), PhysicalResourceId: aws.String("sg-1")},
+				types.StackResource{ResourceType: aws.String("AWS::AutoScaling::AutoScalingGroup"), PhysicalResourceId: aws.String("asg")},
+			)
+
+			ids, err := getSecurityGroupIDs("ng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids).To(Equal([]string{"sg-1"}))
+		})
+
+		It("includes the remote access security group of a managed nodegroup", func() {
+			mockNodeGroup("mng", api.NodeGroupTypeManaged,
+				types.StackResource{ResourceType: aws.String("AWS::EC2::SecurityGroup"), PhysicalResourceId: aws.String("sg-ssh")},
+			)
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String("mng"),
+			}).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{Resources: &eks.NodegroupResources{RemoteAccessSecurityGroup: aws.String("sg-remote")}},
+			}, nil)
+
+			ids, err := getSecurityGroupIDs("mng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids).To(Equal([]string{"sg-ssh", "sg-remote"}))
+		})
+
+		It("returns an empty slice when the nodegroup has no dedicated security groups", func() {
+			mockNodeGroup("mng", api.NodeGroupTypeManaged)
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{Resources: &eks.NodegroupResources{}},
+			}, nil)
+
+			ids, err := getSecurityGroupIDs("mng")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids).NotTo(BeNil())
+			Expect(ids).To(BeEmpty())
+		})
+	})
+
+	Describe("NodeGroupStack timestamps", func() {
+		It("returns the creation and last update times of the stack", func() {
+			created := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+			updated := created.Add(24 * time.Hour)
+			s := NodeGroupStack{Stack: &Stack{CreationTime: &created, LastUpdatedTime: &updated}}
+			Expect(s.CreatedAt()).To(Equal(created))
+			Expect(s.UpdatedAt()).To(Equal(updated))
+		})
+
+		It("returns the zero time for a stack that was never updated", func() {
+			created := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+			s := NodeGroupStack{Stack: &Stack{CreationTime: &created}}
+			Expect(s.CreatedAt()).To(Equal(created))
+			Expect(s.UpdatedAt().IsZero()).To(BeTrue())
+		})
+
+		It("returns the zero time without a stack", func() {
+			Expect(NodeGroupStack{}.CreatedAt().IsZero()).To(BeTrue())
+			Expect(NodeGroupStack{}.UpdatedAt().IsZero()).To(BeTrue())
+		})
+	})
+
+	Describe("makeNodeGroupStackTags", func() {
+		It("uses the nodegroup tags when no stack tags are set", func() {
+			ng := &api.NodeGroupBase{Tags: map[string]string{"team": "eks", api.NodeGroupNameTag: "ng"}}
+			Expect(makeNodeGroupStackTags(ng)).To(Equal(map[string]string{"team": "eks", api.NodeGroupNameTag: "ng"}))
+		})
+
+		It("uses the stack tags along with the tags identifying the nodegroup", func() {
+			ng := &api.NodeGroupBase{
+				Tags: map[string]string{
+					"team":               "eks",
+					api.NodeGroupNameTag: "ng",
+					api.NodeGroupTypeTag: string(api.NodeGroupTypeManaged),
+				},
+				StackTags: map[string]string{"cost-center": "1234"},
+			}
+			Expect(makeNodeGroupStackTags(ng)).To(Equal(map[string]string{
+				"cost-center":        "1234",
+				api.NodeGroupNameTag: "ng",
+				api.NodeGroupTypeTag: string(api.NodeGroupTypeManaged),
+			}))
+		})
+	})
+
+	Describe("GetAutoScalingGroupName", func() {
+		It("describes a managed nodegroup only once", func() {
+			p := mockprovider.NewMockProvider()
+			sc := NewStackCollection(p, api.NewClusterConfig())
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{Status: aws.String(eks.NodegroupStatusCreating), Resources: &eks.NodegroupResources{}},
+			}, nil)
+
+			asgNames, err := sc.GetAutoScalingGroupName(context.TODO(), &Stack{
+				StackName: aws.String("eksctl-test-cluster-nodegroup-mng"),
+				Tags: []types.Tag{
+					{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("mng")},
+					{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeManaged))},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(asgNames).To(BeEmpty())
+			p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeNodegroup", 1)
+		})
+	})
+
+	Describe("waitForManagedNodeGroupAutoScalingGroupNames", func() {
+		var (
+			p     *mockprovider.MockProvider
+			sc    *StackCollection
+			stack = &Stack{
+				StackName: aws.String("eksctl-test-cluster-nodegroup-mng"),
+				Tags: []types.Tag{
+					{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("mng")},
+					{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeManaged))},
+				},
+			}
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+			sc.nodeGroupResourcesPollInterval = time.Millisecond
+		})
+
+		It("waits for EKS to report the autoscaling groups of a new managed nodegroup", func() {
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{Status: aws.String(eks.NodegroupStatusCreating), Resources: &eks.NodegroupResources{}},
+			}, nil).Once()
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{Status: aws.String(eks.NodegroupStatusActive), Resources: &eks.NodegroupResources{
+					AutoScalingGroups: []*eks.AutoScalingGroup{{Name: aws.String("asg-1")}, {Name: aws.String("asg-2")}},
+				}},
+			}, nil).Once()
+
+			asgNames, err := sc.waitForManagedNodeGroupAutoScalingGroupNames(context.TODO(), stack)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(asgNames).To(Equal("asg-1,asg-2"))
+			p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeNodegroup", 2)
+		})
+
+		It("stops waiting when the nodegroup is not being created", func() {
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{Status: aws.String(eks.NodegroupStatusDeleting), Resources: &eks.NodegroupResources{}},
+			}, nil)
+
+			asgNames, err := sc.waitForManagedNodeGroupAutoScalingGroupNames(context.TODO(), stack)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(asgNames).To(BeEmpty())
+			p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeNodegroup", 1)
+		})
+
+		It("returns describe errors immediately", func() {
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(nil, errors.New("access denied"))
+
+			_, err := sc.waitForManagedNodeGroupAutoScalingGroupNames(context.TODO(), stack)
+			Expect(err).To(MatchError(`describing managed nodegroup "mng": access denied`))
+			p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeNodegroup", 1)
+		})
+
+		It("gives up after the wait timeout", func() {
+			sc.nodeGroupResourcesWaitTimeout = 20 * time.Millisecond
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{Status: aws.String(eks.NodegroupStatusCreating), Resources: &eks.NodegroupResources{}},
+			}, nil)
+
+			asgNames, err := sc.waitForManagedNodeGroupAutoScalingGroupNames(context.TODO(), stack)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(asgNames).To(BeEmpty())
+		})
+
+		It("stops waiting when the context is cancelled", func() {
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{Status: aws.String(eks.NodegroupStatusCreating), Resources: &eks.NodegroupResources{}},
+			}, nil)
+			ctx, cancel := context.WithCancel(context.TODO())
+			cancel()
+
+			_, err := sc.waitForManagedNodeGroupAutoScalingGroupNames(ctx, stack)
+			Expect(err).To(MatchError(context.Canceled))
+		})
+	})
 })
@@ -0,0 +1,71 @@
+package manager
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// outputTag is the struct tag naming the stack output a field is collected from
+const outputTag = "cfn"
+
+// CollectStackOutputs sets the fields of the struct pointed to by into from the outputs of the stack.
+// Fields are mapped to outputs with a tag naming the output key, e.g. `cfn:"NodeInstanceRoleARN"`;
+// outputs are required unless the tag carries the optional flag, e.g. `cfn:"SecurityGroup,optional"`.
+// Fields must be of type string, or []string for comma-separated outputs.
+func (*StackCollection) CollectStackOutputs(stack *Stack, into interface{}) error {
+	v := reflect.ValueOf(into)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expected a pointer to a struct, got %T", into)
+	}
+	v = v.Elem()
+
+	outputs := make(map[string]string, len(stack.Outputs))
+	for _, o := range stack.Outputs {
+		if o.OutputKey != nil && o.OutputValue != nil {
+			outputs[*o.OutputKey] = *o.OutputValue
+		}
+	}
+
+	var missing []string
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		tag, ok := field.Tag.Lookup(outputTag)
+		if !ok {
+			continue
+		}
+		key, optional := parseOutputTag(tag)
+
+		value, ok := outputs[key]
+		if !ok {
+			if !optional {
+				missing = append(missing, key)
+			}
+			continue
+		}
+
+		switch f := v.Field(i); {
+		case f.Kind() == reflect.String:
+			f.SetString(value)
+		case f.Kind() == reflect.Slice && f.Type().Elem().Kind() == reflect.String:
+			f.Set(reflect.ValueOf(strings.Split(value, ",")))
+		default:
+			return fmt.Errorf("unsupported type %s of field %s for output %q", f.Type(), field.Name, key)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required outputs %s in stack %q", strings.Join(missing, ", "), *stack.StackName)
+	}
+	return nil
+}
+
+func parseOutputTag(tag string) (key string, optional bool) {
+	parts := strings.Split(tag, ",")
+	for _, option := range parts[1:] {
+		if option == "optional" {
+			optional = true
+		}
+	}
+	return parts[0], optional
+}
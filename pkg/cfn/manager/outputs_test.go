@@ -0,0 +1,70 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection Outputs", func() {
+	type nodeGroupOutputs struct {
+		InstanceRoleARN string   `cfn:"InstanceRoleARN"`
+		SecurityGroups  []string `cfn:"SecurityGroups"`
+		InstanceProfile string   `cfn:"InstanceProfileARN,optional"`
+		Ignored         string
+	}
+
+	var (
+		sc    *StackCollection
+		stack *Stack
+	)
+
+	BeforeEach(func() {
+		sc = NewStackCollection(mockprovider.NewMockProvider(), api.NewClusterConfig()).(*StackCollection)
+		stack = &Stack{StackName: aws.String("eksctl-test-cluster-nodegroup-ng")}
+	})
+
+	newOutput := func(key, value string) types.Output {
+		return types.Output{OutputKey: aws.String(key), OutputValue: aws.String(value)}
+	}
+
+	It("collects the required and optional outputs", func() {
+		stack.Outputs = []types.Output{
+			newOutput("InstanceRoleARN", "arn:aws:iam::123456789012:role/node"),
+			newOutput("SecurityGroups", "sg-1,sg-2"),
+			newOutput("Unrelated", "value"),
+		}
+
+		var outputs nodeGroupOutputs
+		Expect(sc.CollectStackOutputs(stack, &outputs)).To(Succeed())
+		Expect(outputs).To(Equal(nodeGroupOutputs{
+			InstanceRoleARN: "arn:aws:iam::123456789012:role/node",
+			SecurityGroups:  []string{"sg-1", "sg-2"},
+		}))
+	})
+
+	It("lists the missing required outputs", func() {
+		stack.Outputs = []types.Output{newOutput("InstanceProfileARN", "arn")}
+
+		var outputs nodeGroupOutputs
+		err := sc.CollectStackOutputs(stack, &outputs)
+		Expect(err).To(MatchError(`missing required outputs InstanceRoleARN, SecurityGroups in stack "eksctl-test-cluster-nodegroup-ng"`))
+	})
+
+	It("rejects unsupported field types", func() {
+		stack.Outputs = []types.Output{newOutput("Count", "1")}
+
+		var outputs struct {
+			Count int `cfn:"Count"`
+		}
+		Expect(sc.CollectStackOutputs(stack, &outputs)).To(MatchError(ContainSubstring("unsupported type int")))
+	})
+
+	It("rejects a non-pointer", func() {
+		Expect(sc.CollectStackOutputs(stack, nodeGroupOutputs{})).To(MatchError(ContainSubstring("expected a pointer to a struct")))
+	})
+})
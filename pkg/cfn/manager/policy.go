@@ -0,0 +1,85 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+)
+
+// stackPolicy is the subset of a CloudFormation stack policy needed to find protected resources
+type stackPolicy struct {
+	Statement []stackPolicyStatement
+}
+
+type stackPolicyStatement struct {
+	Effect string
+	Action stringOrSlice
+}
+
+// stringOrSlice unmarshals a JSON value that is either a string or a list of strings
+type stringOrSlice []string
+
+func (s *stringOrSlice) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		*s = []string{value}
+		return nil
+	}
+	var values []string
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	*s = values
+	return nil
+}
+
+// GetStackPolicy returns the stack policy of the given stack, or an empty string when it has none
+func (c *StackCollection) GetStackPolicy(ctx context.Context, stackName string) (string, error) {
+	output, err := c.cloudformationAPI.GetStackPolicy(ctx, &cloudformation.GetStackPolicyInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "getting stack policy of stack %q", stackName)
+	}
+	return aws.StringValue(output.StackPolicyBody), nil
+}
+
+// HasProtectedResources reports whether the stack policy of the given stack denies replacing
+// or deleting any of its resources, in which case updates doing so will fail
+func (c *StackCollection) HasProtectedResources(ctx context.Context, stackName string) (bool, error) {
+	policy, err := c.GetStackPolicy(ctx, stackName)
+	if err != nil {
+		return false, err
+	}
+	if policy == "" {
+		return false, nil
+	}
+	protected, err := deniesDestructiveUpdates(policy)
+	if err != nil {
+		return false, errors.Wrapf(err, "parsing stack policy of stack %q", stackName)
+	}
+	return protected, nil
+}
+
+// deniesDestructiveUpdates reports whether the policy has a Deny statement on Update:Replace or Update:Delete
+func deniesDestructiveUpdates(policy string) (bool, error) {
+	var p stackPolicy
+	if err := json.Unmarshal([]byte(policy), &p); err != nil {
+		return false, err
+	}
+	for _, statement := range p.Statement {
+		if statement.Effect != "Deny" {
+			continue
+		}
+		for _, action := range statement.Action {
+			switch action {
+			case "Update:Replace", "Update:Delete", "Update:*":
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,63 @@
+package manager
+
+import (
+	"context"
+
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection Policy", func() {
+	DescribeTable("deniesDestructiveUpdates", func(policy string, expected bool) {
+		protected, err := deniesDestructiveUpdates(policy)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(protected).To(Equal(expected))
+	},
+		Entry("allow all", `{"Statement": [{"Effect": "Allow", "Action": "Update:*", "Principal": "*", "Resource": "*"}]}`, false),
+		Entry("deny replace on a resource", `{"Statement": [
+			{"Effect": "Allow", "Action": "Update:*", "Principal": "*", "Resource": "*"},
+			{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "Resource": "LogicalResourceId/NodeGroup"}
+		]}`, true),
+		Entry("deny a list of actions including delete", `{"Statement": [
+			{"Effect": "Deny", "Action": ["Update:Modify", "Update:Delete"], "Principal": "*", "Resource": "*"}
+		]}`, true),
+		Entry("deny all updates", `{"Statement": [{"Effect": "Deny", "Action": "Update:*", "Principal": "*", "Resource": "*"}]}`, true),
+		Entry("deny modify only", `{"Statement": [{"Effect": "Deny", "Action": "Update:Modify", "Principal": "*", "Resource": "*"}]}`, false),
+	)
+
+	It("rejects an invalid policy", func() {
+		_, err := deniesDestructiveUpdates(`{"Statement": [{"Effect": "Deny", "Action": 1}]}`)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("HasProtectedResources", func() {
+		It("returns false when the stack has no policy", func() {
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("GetStackPolicy", mock.Anything, &cfn.GetStackPolicyInput{StackName: aws.String("stack")}).
+				Return(&cfn.GetStackPolicyOutput{}, nil)
+
+			protected, err := NewStackCollection(p, api.NewClusterConfig()).HasProtectedResources(context.TODO(), "stack")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(protected).To(BeFalse())
+		})
+
+		It("returns true when the stack policy denies replacements", func() {
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("GetStackPolicy", mock.Anything, &cfn.GetStackPolicyInput{StackName: aws.String("stack")}).
+				Return(&cfn.GetStackPolicyOutput{
+					StackPolicyBody: aws.String(`{"Statement": [{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "Resource": "*"}]}`),
+				}, nil)
+
+			protected, err := NewStackCollection(p, api.NewClusterConfig()).HasProtectedResources(context.TODO(), "stack")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(protected).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,128 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+)
+
+// RollbackConfiguration holds the rollback triggers of a stack and the time they are monitored for after an operation
+type RollbackConfiguration = types.RollbackConfiguration
+
+// cloudWatchAlarmType is the type of the rollback triggers that are CloudWatch alarms
+const cloudWatchAlarmType = "AWS::CloudWatch::Alarm"
+
+// GetStackRollbackConfiguration returns the CloudWatch alarms configured as rollback triggers on the given stack
+// along with their monitoring time, or nil when the stack has no rollback configuration
+func (c *StackCollection) GetStackRollbackConfiguration(ctx context.Context, stackName string) (*RollbackConfiguration, error) {
+	stack, err := c.DescribeStack(ctx, &Stack{StackName: &stackName})
+	if err != nil {
+		return nil, err
+	}
+	config := stack.RollbackConfiguration
+	if config == nil || (len(config.RollbackTriggers) == 0 && aws.Int32Value(config.MonitoringTimeInMinutes) == 0) {
+		return nil, nil
+	}
+	return config, nil
+}
+
+// maxRollbackMonitoringTimeInMinutes is the longest time CloudFormation monitors rollback triggers for
+const maxRollbackMonitoringTimeInMinutes = 180
+
+// validateRollbackConfiguration ensures the rollback triggers of config are CloudWatch alarms identified by
+// well-formed ARNs, and that its monitoring time is within the range accepted by CloudFormation; it is called
+// wherever a RollbackConfiguration is passed to CloudFormation, so that all operations accept the same configurations
+func validateRollbackConfiguration(config *RollbackConfiguration) error {
+	if config == nil {
+		return nil
+	}
+	if t := aws.Int32Value(config.MonitoringTimeInMinutes); t < 0 || t > maxRollbackMonitoringTimeInMinutes {
+		return fmt.Errorf("monitoring time must be between 0 and %d minutes, got %d", maxRollbackMonitoringTimeInMinutes, t)
+	}
+	for _, trigger := range config.RollbackTriggers {
+		if triggerType := aws.StringValue(trigger.Type); triggerType != cloudWatchAlarmType {
+			return fmt.Errorf("rollback triggers must be of type %s, got %q", cloudWatchAlarmType, triggerType)
+		}
+		alarmARN, err := arn.Parse(aws.StringValue(trigger.Arn))
+		if err != nil {
+			return errors.Wrapf(err, "invalid alarm ARN %q", aws.StringValue(trigger.Arn))
+		}
+		if alarmARN.Service != "cloudwatch" || !strings.HasPrefix(alarmARN.Resource, "alarm:") {
+			return fmt.Errorf("%q is not the ARN of a CloudWatch alarm", aws.StringValue(trigger.Arn))
+		}
+	}
+	return nil
+}
+
+// RollbackStack rolls back a stack whose update failed to its last known good state and waits
+// for the rollback to complete. Stacks in UPDATE_ROLLBACK_FAILED have their rollback continued,
+// skipping skipResources, which are the logical ids of resources that cannot be rolled back.
+func (c *StackCollection) RollbackStack(ctx context.Context, stackName string, skipResources ...string) error {
+	stack, err := c.DescribeStack(ctx, &Stack{StackName: &stackName})
+	if err != nil {
+		return err
+	}
+
+	var roleARN *string
+	if cfnRole := c.roleARN; cfnRole != "" {
+		roleARN = &cfnRole
+	}
+
+	switch stack.StackStatus {
+	case types.StackStatusUpdateFailed:
+		if len(skipResources) > 0 {
+			return fmt.Errorf("resources can only be skipped when continuing the rollback of a stack in %s state", types.StackStatusUpdateRollbackFailed)
+		}
+		logger.Info("rolling back stack %q", stackName)
+		if _, err := c.cloudformationAPI.RollbackStack(ctx, &cloudformation.RollbackStackInput{
+			StackName: stack.StackName,
+			RoleARN:   roleARN,
+		}); err != nil {
+			return errors.Wrapf(err, "rolling back CloudFormation stack %q", stackName)
+		}
+	case types.StackStatusUpdateRollbackFailed:
+		logger.Info("continuing rollback of stack %q", stackName)
+		if _, err := c.cloudformationAPI.ContinueUpdateRollback(ctx, &cloudformation.ContinueUpdateRollbackInput{
+			StackName:       stack.StackName,
+			ResourcesToSkip: skipResources,
+			RoleARN:         roleARN,
+		}); err != nil {
+			return errors.Wrapf(err, "continuing rollback of CloudFormation stack %q", stackName)
+		}
+	default:
+		return fmt.Errorf("cannot roll back stack %q in %s state, expected one of %s or %s", stackName, stack.StackStatus,
+			types.StackStatusUpdateFailed, types.StackStatusUpdateRollbackFailed)
+	}
+
+	return c.doWaitUntilStackIsRolledBack(ctx, stack)
+}
+
+// CancelStackUpdate cancels the in-progress update of a stack, which is then rolled back to its previous
+// configuration; when wait is true, it waits for the rollback to complete
+func (c *StackCollection) CancelStackUpdate(ctx context.Context, stackName string, wait bool) error {
+	stack, err := c.DescribeStack(ctx, &Stack{StackName: &stackName})
+	if err != nil {
+		return err
+	}
+	if stack.StackStatus != types.StackStatusUpdateInProgress {
+		return fmt.Errorf("cannot cancel the update of stack %q in %s state, expected %s", stackName, stack.StackStatus, types.StackStatusUpdateInProgress)
+	}
+
+	logger.Info("cancelling update of stack %q", stackName)
+	if _, err := c.cloudformationAPI.CancelUpdateStack(ctx, &cloudformation.CancelUpdateStackInput{
+		StackName: stack.StackName,
+	}); err != nil {
+		return errors.Wrapf(err, "cancelling update of CloudFormation stack %q", stackName)
+	}
+	if !wait {
+		return nil
+	}
+	return c.doWaitUntilStackIsRolledBack(ctx, stack)
+}
@@ -0,0 +1,115 @@
+package manager
+
+import (
+	"context"
+
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection Rollback", func() {
+	const stackName = "eksctl-cluster-nodegroup-ng-1"
+
+	var (
+		p  *mockprovider.MockProvider
+		sm StackManager
+	)
+
+	mockStackStatus := func(statuses ...types.StackStatus) {
+		for _, status := range statuses {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{
+					StackName:   aws.String(stackName),
+					StackStatus: status,
+				}},
+			}, nil).Once()
+		}
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		sm = NewStackCollection(p, api.NewClusterConfig())
+	})
+
+	It("rolls back a stack whose update failed", func() {
+		mockStackStatus(types.StackStatusUpdateFailed, types.StackStatusUpdateRollbackComplete)
+		p.MockCloudFormation().On("RollbackStack", mock.Anything, &cfn.RollbackStackInput{StackName: aws.String(stackName)}).Return(&cfn.RollbackStackOutput{}, nil)
+
+		Expect(sm.RollbackStack(context.TODO(), stackName)).To(Succeed())
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ContinueUpdateRollback", mock.Anything, mock.Anything)
+	})
+
+	It("continues the rollback of a stack whose rollback failed, skipping the given resources", func() {
+		mockStackStatus(types.StackStatusUpdateRollbackFailed, types.StackStatusUpdateRollbackComplete)
+		p.MockCloudFormation().On("ContinueUpdateRollback", mock.Anything, &cfn.ContinueUpdateRollbackInput{
+			StackName:       aws.String(stackName),
+			ResourcesToSkip: []string{"NodeGroup"},
+		}).Return(&cfn.ContinueUpdateRollbackOutput{}, nil)
+
+		Expect(sm.RollbackStack(context.TODO(), stackName, "NodeGroup")).To(Succeed())
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "RollbackStack", mock.Anything, mock.Anything)
+	})
+
+	It("returns an error when the stack cannot be rolled back", func() {
+		mockStackStatus(types.StackStatusCreateComplete)
+
+		err := sm.RollbackStack(context.TODO(), stackName)
+		Expect(err).To(MatchError(ContainSubstring("cannot roll back stack")))
+	})
+
+	Context("CancelStackUpdate", func() {
+		It("cancels the update of a stack being updated and waits for the rollback", func() {
+			mockStackStatus(types.StackStatusUpdateInProgress, types.StackStatusUpdateRollbackComplete)
+			p.MockCloudFormation().On("CancelUpdateStack", mock.Anything, &cfn.CancelUpdateStackInput{StackName: aws.String(stackName)}).Return(&cfn.CancelUpdateStackOutput{}, nil)
+
+			Expect(sm.CancelStackUpdate(context.TODO(), stackName, true)).To(Succeed())
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 2)
+		})
+
+		It("does not cancel the update of a stack that is not being updated", func() {
+			mockStackStatus(types.StackStatusUpdateComplete)
+
+			err := sm.CancelStackUpdate(context.TODO(), stackName, false)
+			Expect(err).To(MatchError(ContainSubstring("cannot cancel the update of stack")))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CancelUpdateStack", mock.Anything, mock.Anything)
+		})
+	})
+
+	Context("GetStackRollbackConfiguration", func() {
+		mockRollbackConfiguration := func(config *types.RollbackConfiguration) {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: aws.String(stackName)}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String(stackName), RollbackConfiguration: config}},
+			}, nil)
+		}
+
+		It("returns the rollback triggers and monitoring time of the stack", func() {
+			config := &types.RollbackConfiguration{
+				MonitoringTimeInMinutes: aws.Int32(10),
+				RollbackTriggers: []types.RollbackTrigger{{
+					Arn:  aws.String("arn:aws:cloudwatch:us-west-2:123456789012:alarm:nodes-unhealthy"),
+					Type: aws.String("AWS::CloudWatch::Alarm"),
+				}},
+			}
+			mockRollbackConfiguration(config)
+
+			actual, err := sm.GetStackRollbackConfiguration(context.TODO(), stackName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(Equal(config))
+		})
+
+		It("returns nil when the stack has no rollback configuration", func() {
+			mockRollbackConfiguration(&types.RollbackConfiguration{})
+
+			actual, err := sm.GetStackRollbackConfiguration(context.TODO(), stackName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,216 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// StackSetInstanceStatus describes the stack of a nodegroup stack set in a single region
+type StackSetInstanceStatus struct {
+	Region       string
+	Status       types.StackInstanceStatus
+	StatusReason string
+}
+
+// CreateNodeGroupStackSet deploys the unmanaged nodegroup ng as a CloudFormation stack set with a stack instance in
+// each of the given regions, and waits until all of them are created. The nodegroup template imports the outputs of
+// the cluster stack, so a cluster stack of the same name must exist in every region. When the stacks fail to be
+// created, the stack set is deleted along with any stacks it created.
+func (c *StackCollection) CreateNodeGroupStackSet(ctx context.Context, ng *api.NodeGroup, regions []string) error {
+	if len(regions) == 0 {
+		return errors.New("at least one region is required")
+	}
+	accountID, err := c.getClusterStackAccountID(ctx)
+	if err != nil {
+		return err
+	}
+
+	name := c.makeNodeGroupStackName(ng.Name)
+	logger.Info("building nodegroup stack set %q", name)
+	bootstrapper, err := nodebootstrap.NewBootstrapper(c.spec, ng)
+	if err != nil {
+		return errors.Wrap(err, "error creating bootstrapper")
+	}
+	resourceSet := builder.NewNodeGroupResourceSet(c.ec2API, c.iamAPI, c.spec, ng, bootstrapper, false, vpc.NewStackConfigImporter(c.MakeClusterStackName()))
+	if err := resourceSet.AddAllResources(ctx); err != nil {
+		return err
+	}
+	templateBody, err := resourceSet.RenderJSON()
+	if err != nil {
+		return errors.Wrapf(err, "rendering template for %q stack set", name)
+	}
+
+	input := &cloudformation.CreateStackSetInput{
+		StackSetName: aws.String(name),
+		TemplateBody: aws.String(string(templateBody)),
+		Capabilities: makeStackCapabilities(resourceSet.WithIAM(), resourceSet.WithNamedIAM(), nil),
+		Tags: append([]types.Tag{
+			newTag(api.NodeGroupNameTag, ng.Name),
+			newTag(api.NodeGroupTypeTag, string(api.NodeGroupTypeUnmanaged)),
+		}, c.sharedTags...),
+	}
+	if _, err := c.cloudformationAPI.CreateStackSet(ctx, input); err != nil {
+		return errors.Wrapf(err, "creating CloudFormation stack set %q", name)
+	}
+
+	out, err := c.cloudformationAPI.CreateStackInstances(ctx, &cloudformation.CreateStackInstancesInput{
+		StackSetName: aws.String(name),
+		Accounts:     []string{accountID},
+		Regions:      regions,
+	})
+	if err != nil {
+		c.deleteFailedNodeGroupStackSet(ctx, ng.Name)
+		return errors.Wrapf(err, "creating stack instances of stack set %q", name)
+	}
+	logger.Info("deploying stack set %q to regions %v", name, regions)
+	if err := c.waitForStackSetOperation(ctx, name, aws.StringValue(out.OperationId)); err != nil {
+		c.deleteFailedNodeGroupStackSet(ctx, ng.Name)
+		return errors.Wrapf(err, "deploying stack set %q, which requires cluster stack %q in each of the regions %v",
+			name, c.MakeClusterStackName(), regions)
+	}
+	return nil
+}
+
+// deleteFailedNodeGroupStackSet deletes the stack set of the given nodegroup after its creation failed;
+// a failure to delete it is only logged, as it is not the cause of the error reported to the caller
+func (c *StackCollection) deleteFailedNodeGroupStackSet(ctx context.Context, ngName string) {
+	name := c.makeNodeGroupStackName(ngName)
+	logger.Info("deleting stack set %q as its stacks failed to be created", name)
+	if err := c.DeleteNodeGroupStackSet(ctx, ngName); err != nil {
+		logger.Warning("failed to delete stack set %q: %v", name, err)
+	}
+}
+
+// DescribeNodeGroupStackSetInstances returns the status of the stack of the given nodegroup stack set in each region
+func (c *StackCollection) DescribeNodeGroupStackSetInstances(ctx context.Context, ngName string) ([]StackSetInstanceStatus, error) {
+	name := c.makeNodeGroupStackName(ngName)
+	paginator := cloudformation.NewListStackInstancesPaginator(c.cloudformationAPI, &cloudformation.ListStackInstancesInput{
+		StackSetName: aws.String(name),
+	})
+
+	var instances []StackSetInstanceStatus
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "listing stack instances of stack set %q", name)
+		}
+		for _, s := range out.Summaries {
+			instances = append(instances, StackSetInstanceStatus{
+				Region:       aws.StringValue(s.Region),
+				Status:       s.Status,
+				StatusReason: aws.StringValue(s.StatusReason),
+			})
+		}
+	}
+	return instances, nil
+}
+
+// DeleteNodeGroupStackSet deletes the stacks of the given nodegroup stack set in all regions, waits until they are
+// deleted, and then deletes the stack set itself
+func (c *StackCollection) DeleteNodeGroupStackSet(ctx context.Context, ngName string) error {
+	name := c.makeNodeGroupStackName(ngName)
+	instances, err := c.DescribeNodeGroupStackSetInstances(ctx, ngName)
+	if err != nil {
+		return err
+	}
+
+	if len(instances) > 0 {
+		accountID, err := c.getClusterStackAccountID(ctx)
+		if err != nil {
+			return err
+		}
+		regions := make([]string, 0, len(instances))
+		for _, i := range instances {
+			regions = append(regions, i.Region)
+		}
+		out, err := c.cloudformationAPI.DeleteStackInstances(ctx, &cloudformation.DeleteStackInstancesInput{
+			StackSetName: aws.String(name),
+			Accounts:     []string{accountID},
+			Regions:      regions,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "deleting stack instances of stack set %q", name)
+		}
+		logger.Info("deleting stacks of stack set %q in regions %v", name, regions)
+		if err := c.waitForStackSetOperation(ctx, name, aws.StringValue(out.OperationId)); err != nil {
+			return err
+		}
+	}
+
+	if _, err := c.cloudformationAPI.DeleteStackSet(ctx, &cloudformation.DeleteStackSetInput{
+		StackSetName: aws.String(name),
+	}); err != nil {
+		return errors.Wrapf(err, "deleting CloudFormation stack set %q", name)
+	}
+	return nil
+}
+
+// getClusterStackAccountID returns the id of the AWS account the cluster stack belongs to
+func (c *StackCollection) getClusterStackAccountID(ctx context.Context) (string, error) {
+	clusterStack, err := c.DescribeClusterStack(ctx)
+	if err != nil {
+		return "", err
+	}
+	if clusterStack == nil {
+		return "", &StackNotFoundErr{ClusterName: c.spec.Metadata.Name}
+	}
+	stackARN, err := arn.Parse(aws.StringValue(clusterStack.StackId))
+	if err != nil {
+		return "", errors.Wrapf(err, "parsing id of stack %q", *clusterStack.StackName)
+	}
+	return stackARN.AccountID, nil
+}
+
+// waitForStackSetOperation polls the given stack set operation until it is no longer in progress
+func (c *StackCollection) waitForStackSetOperation(ctx context.Context, stackSetName, operationID string) error {
+	w := &waiter.Waiter{
+		NextDelay: func(attempts int) time.Duration {
+			if attempts == 1 {
+				return 0
+			}
+			return c.stackSetOperationPollInterval
+		},
+		Operation: func() (bool, error) {
+			out, err := c.cloudformationAPI.DescribeStackSetOperation(ctx, &cloudformation.DescribeStackSetOperationInput{
+				StackSetName: aws.String(stackSetName),
+				OperationId:  aws.String(operationID),
+			})
+			if err != nil {
+				return false, errors.Wrapf(err, "describing operation %q of stack set %q", operationID, stackSetName)
+			}
+			switch status := out.StackSetOperation.Status; status {
+			case types.StackSetOperationStatusSucceeded:
+				return true, nil
+			case types.StackSetOperationStatusFailed, types.StackSetOperationStatusStopped:
+				return false, fmt.Errorf("operation %q of stack set %q finished with status %s", operationID, stackSetName, status)
+			default:
+				logger.Info("waiting for operation %q of stack set %q", operationID, stackSetName)
+				return false, nil
+			}
+		},
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, c.waitTimeout)
+	defer cancel()
+	if err := w.Wait(timeoutCtx); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("operation %q of stack set %q is still in progress after %v", operationID, stackSetName, c.waitTimeout)
+		}
+		return err
+	}
+	return nil
+}
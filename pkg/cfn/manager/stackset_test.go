@@ -0,0 +1,180 @@
+package manager
+
+import (
+	"context"
+	"errors"
+
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection StackSets", func() {
+	const stackSetName = "eksctl-test-cluster-nodegroup-ng"
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg).(*StackCollection)
+		sc.stackSetOperationPollInterval = 0
+
+		p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.ListStacksOutput{
+			StackSummaries: []types.StackSummary{{StackName: aws.String("eksctl-test-cluster-cluster")}},
+		}, nil)
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+			Stacks: []types.Stack{{
+				StackName:   aws.String("eksctl-test-cluster-cluster"),
+				StackId:     aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/eksctl-test-cluster-cluster/1"),
+				StackStatus: types.StackStatusCreateComplete,
+				Tags:        []types.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")}},
+			}},
+		}, nil)
+		p.MockCloudFormation().On("ListStackInstances", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.ListStackInstancesOutput{
+			Summaries: []types.StackInstanceSummary{
+				{Region: aws.String("us-west-2"), Status: types.StackInstanceStatusCurrent},
+				{Region: aws.String("eu-west-1"), Status: types.StackInstanceStatusInoperable, StatusReason: aws.String("failed")},
+			},
+		}, nil)
+	})
+
+	It("reports the status of the stack set in each region", func() {
+		instances, err := sc.DescribeNodeGroupStackSetInstances(context.TODO(), "ng")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instances).To(Equal([]StackSetInstanceStatus{
+			{Region: "us-west-2", Status: types.StackInstanceStatusCurrent},
+			{Region: "eu-west-1", Status: types.StackInstanceStatusInoperable, StatusReason: "failed"},
+		}))
+	})
+
+	It("deletes the stacks in all regions before deleting the stack set", func() {
+		p.MockCloudFormation().On("DeleteStackInstances", mock.Anything, &cfn.DeleteStackInstancesInput{
+			StackSetName: aws.String(stackSetName),
+			Accounts:     []string{"123456789012"},
+			Regions:      []string{"us-west-2", "eu-west-1"},
+		}).Return(&cfn.DeleteStackInstancesOutput{OperationId: aws.String("op-1")}, nil)
+		p.MockCloudFormation().On("DescribeStackSetOperation", mock.Anything, mock.Anything).Return(&cfn.DescribeStackSetOperationOutput{
+			StackSetOperation: &types.StackSetOperation{Status: types.StackSetOperationStatusRunning},
+		}, nil).Once()
+		p.MockCloudFormation().On("DescribeStackSetOperation", mock.Anything, mock.Anything).Return(&cfn.DescribeStackSetOperationOutput{
+			StackSetOperation: &types.StackSetOperation{Status: types.StackSetOperationStatusSucceeded},
+		}, nil)
+		p.MockCloudFormation().On("DeleteStackSet", mock.Anything, &cfn.DeleteStackSetInput{
+			StackSetName: aws.String(stackSetName),
+		}).Return(&cfn.DeleteStackSetOutput{}, nil)
+
+		Expect(sc.DeleteNodeGroupStackSet(context.TODO(), "ng")).To(Succeed())
+		p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStackSetOperation", 2)
+		p.MockCloudFormation().AssertCalled(GinkgoT(), "DeleteStackSet", mock.Anything, mock.Anything)
+	})
+
+	It("keeps the stack set when deleting its stacks fails", func() {
+		p.MockCloudFormation().On("DeleteStackInstances", mock.Anything, mock.Anything).Return(&cfn.DeleteStackInstancesOutput{OperationId: aws.String("op-1")}, nil)
+		p.MockCloudFormation().On("DescribeStackSetOperation", mock.Anything, mock.Anything).Return(&cfn.DescribeStackSetOperationOutput{
+			StackSetOperation: &types.StackSetOperation{Status: types.StackSetOperationStatusFailed},
+		}, nil)
+
+		err := sc.DeleteNodeGroupStackSet(context.TODO(), "ng")
+		Expect(err).To(MatchError(`operation "op-1" of stack set "eksctl-test-cluster-nodegroup-ng" finished with status FAILED`))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DeleteStackSet", mock.Anything, mock.Anything)
+	})
+
+	It("requires at least one region", func() {
+		Expect(sc.CreateNodeGroupStackSet(context.TODO(), api.NewNodeGroup(), nil)).To(MatchError("at least one region is required"))
+	})
+
+	newNodeGroup := func() *api.NodeGroup {
+		sc.spec.Status = &api.ClusterStatus{Endpoint: "https://test-cluster.example.com", CertificateAuthorityData: []byte("CA")}
+		ng := sc.spec.NewNodeGroup()
+		ng.Name = "ng"
+		ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+		ng.AMI = "ami-123"
+		ng.InstanceType = "m5.large"
+		ng.VolumeType = aws.String(api.NodeVolumeTypeGP2)
+		ng.VolumeName = aws.String("/dev/xvda")
+		ng.VolumeEncrypted = api.Disabled()
+		return ng
+	}
+
+	It("deploys the nodegroup template to each region", func() {
+		ng := newNodeGroup()
+
+		p.MockCloudFormation().On("CreateStackSet", mock.Anything, mock.Anything).Return(&cfn.CreateStackSetOutput{}, nil)
+		p.MockCloudFormation().On("CreateStackInstances", mock.Anything, &cfn.CreateStackInstancesInput{
+			StackSetName: aws.String(stackSetName),
+			Accounts:     []string{"123456789012"},
+			Regions:      []string{"us-west-2", "eu-west-1"},
+		}).Return(&cfn.CreateStackInstancesOutput{OperationId: aws.String("op-1")}, nil)
+		p.MockCloudFormation().On("DescribeStackSetOperation", mock.Anything, &cfn.DescribeStackSetOperationInput{
+			StackSetName: aws.String(stackSetName),
+			OperationId:  aws.String("op-1"),
+		}).Return(&cfn.DescribeStackSetOperationOutput{
+			StackSetOperation: &types.StackSetOperation{Status: types.StackSetOperationStatusSucceeded},
+		}, nil)
+
+		Expect(sc.CreateNodeGroupStackSet(context.TODO(), ng, []string{"us-west-2", "eu-west-1"})).To(Succeed())
+
+		var input *cfn.CreateStackSetInput
+		for _, call := range p.MockCloudFormation().Calls {
+			if call.Method == "CreateStackSet" {
+				input = call.Arguments.Get(1).(*cfn.CreateStackSetInput)
+			}
+		}
+		Expect(input).NotTo(BeNil())
+		Expect(*input.StackSetName).To(Equal(stackSetName))
+		Expect(input.Capabilities).To(ContainElement(types.CapabilityCapabilityIam))
+		Expect(*input.TemplateBody).To(ContainSubstring("AWS::AutoScaling::AutoScalingGroup"))
+		Expect(input.Tags).To(ContainElement(types.Tag{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng")}))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DeleteStackSet", mock.Anything, mock.Anything)
+	})
+
+	It("deletes the stack set when its stacks can't be created", func() {
+		p.MockCloudFormation().On("CreateStackSet", mock.Anything, mock.Anything).Return(&cfn.CreateStackSetOutput{}, nil)
+		p.MockCloudFormation().On("CreateStackInstances", mock.Anything, mock.Anything).Return(nil, errors.New("invalid region"))
+		p.MockCloudFormation().On("DeleteStackInstances", mock.Anything, mock.Anything).Return(&cfn.DeleteStackInstancesOutput{OperationId: aws.String("op-2")}, nil)
+		p.MockCloudFormation().On("DescribeStackSetOperation", mock.Anything, mock.Anything).Return(&cfn.DescribeStackSetOperationOutput{
+			StackSetOperation: &types.StackSetOperation{Status: types.StackSetOperationStatusSucceeded},
+		}, nil)
+		p.MockCloudFormation().On("DeleteStackSet", mock.Anything, &cfn.DeleteStackSetInput{
+			StackSetName: aws.String(stackSetName),
+		}).Return(&cfn.DeleteStackSetOutput{}, nil)
+
+		err := sc.CreateNodeGroupStackSet(context.TODO(), newNodeGroup(), []string{"us-west-2", "eu-west-1"})
+		Expect(err).To(MatchError(ContainSubstring("invalid region")))
+		p.MockCloudFormation().AssertCalled(GinkgoT(), "DeleteStackSet", mock.Anything, mock.Anything)
+	})
+
+	It("deletes the stack set and its stacks when they fail to be created", func() {
+		p.MockCloudFormation().On("CreateStackSet", mock.Anything, mock.Anything).Return(&cfn.CreateStackSetOutput{}, nil)
+		p.MockCloudFormation().On("CreateStackInstances", mock.Anything, mock.Anything).Return(&cfn.CreateStackInstancesOutput{OperationId: aws.String("op-1")}, nil)
+		p.MockCloudFormation().On("DescribeStackSetOperation", mock.Anything, &cfn.DescribeStackSetOperationInput{
+			StackSetName: aws.String(stackSetName),
+			OperationId:  aws.String("op-1"),
+		}).Return(&cfn.DescribeStackSetOperationOutput{
+			StackSetOperation: &types.StackSetOperation{Status: types.StackSetOperationStatusFailed},
+		}, nil)
+		p.MockCloudFormation().On("DeleteStackInstances", mock.Anything, mock.Anything).Return(&cfn.DeleteStackInstancesOutput{OperationId: aws.String("op-2")}, nil)
+		p.MockCloudFormation().On("DescribeStackSetOperation", mock.Anything, &cfn.DescribeStackSetOperationInput{
+			StackSetName: aws.String(stackSetName),
+			OperationId:  aws.String("op-2"),
+		}).Return(&cfn.DescribeStackSetOperationOutput{
+			StackSetOperation: &types.StackSetOperation{Status: types.StackSetOperationStatusSucceeded},
+		}, nil)
+		p.MockCloudFormation().On("DeleteStackSet", mock.Anything, mock.Anything).Return(&cfn.DeleteStackSetOutput{}, nil)
+
+		err := sc.CreateNodeGroupStackSet(context.TODO(), newNodeGroup(), []string{"us-west-2", "eu-west-1"})
+		Expect(err).To(MatchError(ContainSubstring(`requires cluster stack "eksctl-test-cluster-cluster" in each of the regions [us-west-2 eu-west-1]`)))
+		p.MockCloudFormation().AssertCalled(GinkgoT(), "DeleteStackInstances", mock.Anything, mock.Anything)
+		p.MockCloudFormation().AssertCalled(GinkgoT(), "DeleteStackSet", mock.Anything, mock.Anything)
+	})
+})
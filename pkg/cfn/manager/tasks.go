@@ -43,7 +43,7 @@ type nodeGroupTask struct {
 
 func (t *nodeGroupTask) Describe() string { return t.info }
 func (t *nodeGroupTask) Do(errs chan error) error {
-	return t.stackCollection.createNodeGroupTask(t.ctx, errs, t.nodeGroup, t.forceAddCNIPolicy, t.vpcImporter)
+	return t.stackCollection.createNodeGroupTask(t.ctx, errs, t.nodeGroup, t.forceAddCNIPolicy, t.vpcImporter, false)
 }
 
 type managedNodeGroupTask struct {
@@ -88,24 +88,26 @@ func (t *taskWithClusterIAMServiceAccountSpec) Do(errs chan error) error {
 
 type taskWithStackSpec struct {
 	info  string
+	ctx   context.Context
 	stack *Stack
 	call  func(context.Context, *Stack, chan error) error
 }
 
 func (t *taskWithStackSpec) Describe() string { return t.info }
 func (t *taskWithStackSpec) Do(errs chan error) error {
-	return t.call(context.TODO(), t.stack, errs)
+	return t.call(t.ctx, t.stack, errs)
 }
 
 type asyncTaskWithStackSpec struct {
 	info  string
+	ctx   context.Context
 	stack *Stack
 	call  func(context.Context, *Stack) (*Stack, error)
 }
 
 func (t *asyncTaskWithStackSpec) Describe() string { return t.info + " [async]" }
 func (t *asyncTaskWithStackSpec) Do(errs chan error) error {
-	_, err := t.call(context.TODO(), t.stack)
+	_, err := t.call(t.ctx, t.stack)
 	close(errs)
 	return err
 }
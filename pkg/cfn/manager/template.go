@@ -1,12 +1,19 @@
 package manager
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
 	"github.com/weaveworks/goformation/v4"
+	"sigs.k8s.io/yaml"
 )
 
 // GetStackTemplate gets the Cloudformation template for a stack
@@ -24,6 +31,77 @@ func (c *StackCollection) GetStackTemplate(ctx context.Context, stackName string
 	return ensureJSONResponse([]byte(*output.TemplateBody))
 }
 
+// GetNodeGroupStackTemplate returns the template body of a nodegroup's stack as it was deployed,
+// in the JSON or YAML format it was submitted in
+func (c *StackCollection) GetNodeGroupStackTemplate(ctx context.Context, nodeGroupName string) (string, error) {
+	stackName := c.makeNodeGroupStackName(nodeGroupName)
+	output, err := c.cloudformationAPI.GetTemplate(ctx, &cloudformation.GetTemplateInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "getting template of nodegroup %q stack %q", nodeGroupName, stackName)
+	}
+
+	return aws.StringValue(output.TemplateBody), nil
+}
+
+// ExportNodeGroupTemplate writes the template the stack of the given nodegroup was deployed with to path, as JSON or
+// YAML depending on its extension, creating its parent directories as needed. JSON is pretty-printed, while YAML
+// templates exported as YAML are written unchanged to preserve their formatting.
+func (c *StackCollection) ExportNodeGroupTemplate(ctx context.Context, ngName, path string) error {
+	template, err := c.GetNodeGroupStackTemplate(ctx, ngName)
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		data, err = formatTemplateJSON([]byte(template))
+	case ".yaml", ".yml":
+		data, err = formatTemplateYAML([]byte(template))
+	default:
+		return fmt.Errorf("cannot export template of nodegroup %q to %q: unsupported extension %q, expected .json, .yaml or .yml", ngName, path, ext)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "formatting template of nodegroup %q", ngName)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "creating directory for template of nodegroup %q", ngName)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return errors.Wrapf(err, "writing template of nodegroup %q to %q", ngName, path)
+	}
+	return nil
+}
+
+// formatTemplateJSON returns the given JSON or YAML template as indented JSON
+func formatTemplateJSON(template []byte) ([]byte, error) {
+	if !json.Valid(template) {
+		// YAML templates may use the short form of intrinsic functions, which goformation resolves
+		converted, err := ensureJSONResponse(template)
+		if err != nil {
+			return nil, err
+		}
+		template = []byte(converted)
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, template, "", "  "); err != nil {
+		return nil, err
+	}
+	out.WriteByte('\n')
+	return out.Bytes(), nil
+}
+
+// formatTemplateYAML returns the given JSON or YAML template as YAML, leaving YAML templates as they are
+func formatTemplateYAML(template []byte) ([]byte, error) {
+	if json.Valid(template) {
+		return yaml.JSONToYAML(template)
+	}
+	return template, nil
+}
+
 func ensureJSONResponse(templateBody []byte) (string, error) {
 	//since json is valid yaml we just need to check the response is valid yaml
 	template, err := goformation.ParseYAML(templateBody)
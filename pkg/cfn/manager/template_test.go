@@ -2,6 +2,8 @@ package manager
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 
 	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go/aws"
@@ -148,4 +150,113 @@ Outputs:
 			})
 		})
 	})
+
+	Describe("GetNodeGroupStackTemplate", func() {
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cc = newClusterConfig("test-cluster")
+			sc = NewStackCollection(p, cc)
+		})
+
+		It("returns the template as deployed", func() {
+			p.MockCloudFormation().On("GetTemplate", mock.Anything, &cfn.GetTemplateInput{
+				StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+			}).Return(&cfn.GetTemplateOutput{
+				TemplateBody: &rawYamlTemplate,
+			}, nil)
+
+			out, err := sc.GetNodeGroupStackTemplate(context.TODO(), "ng-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(rawYamlTemplate))
+		})
+
+		It("returns an error when the stack does not exist", func() {
+			p.MockCloudFormation().On("GetTemplate", mock.Anything, mock.Anything).Return(nil, errors.New("Stack with id eksctl-test-cluster-nodegroup-ng-1 does not exist"))
+
+			_, err := sc.GetNodeGroupStackTemplate(context.TODO(), "ng-1")
+			Expect(err).To(MatchError(ContainSubstring(`getting template of nodegroup "ng-1" stack "eksctl-test-cluster-nodegroup-ng-1"`)))
+		})
+	})
+
+	Describe("ExportNodeGroupTemplate", func() {
+		var dir string
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cc = newClusterConfig("test-cluster")
+			sc = NewStackCollection(p, cc)
+
+			var err error
+			dir, err = os.MkdirTemp("", "export-template")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		mockTemplate := func(template string) {
+			p.MockCloudFormation().On("GetTemplate", mock.Anything, &cfn.GetTemplateInput{
+				StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+			}).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(template),
+			}, nil)
+		}
+
+		It("writes a YAML template as JSON, creating the parent directories", func() {
+			mockTemplate(rawYamlTemplate)
+			path := filepath.Join(dir, "nodegroups", "ng-1.json")
+
+			Expect(sc.ExportNodeGroupTemplate(context.TODO(), "ng-1", path)).To(Succeed())
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(MatchJSON(expectedJSONResponse))
+		})
+
+		It("pretty-prints JSON templates", func() {
+			mockTemplate(rawJSONTemplate)
+			path := filepath.Join(dir, "ng-1.json")
+
+			Expect(sc.ExportNodeGroupTemplate(context.TODO(), "ng-1", path)).To(Succeed())
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(Equal(expectedJSONResponse + "\n"))
+		})
+
+		It("writes YAML templates exported as YAML unchanged", func() {
+			mockTemplate(rawYamlTemplate)
+			path := filepath.Join(dir, "ng-1.yaml")
+
+			Expect(sc.ExportNodeGroupTemplate(context.TODO(), "ng-1", path)).To(Succeed())
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(Equal(rawYamlTemplate))
+		})
+
+		It("writes JSON templates as YAML", func() {
+			mockTemplate(rawJSONTemplate)
+			path := filepath.Join(dir, "ng-1.yml")
+
+			Expect(sc.ExportNodeGroupTemplate(context.TODO(), "ng-1", path)).To(Succeed())
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(MatchYAML(rawJSONTemplate))
+		})
+
+		It("rejects unsupported extensions", func() {
+			mockTemplate(rawJSONTemplate)
+
+			err := sc.ExportNodeGroupTemplate(context.TODO(), "ng-1", filepath.Join(dir, "ng-1.txt"))
+			Expect(err).To(MatchError(ContainSubstring(`unsupported extension ".txt", expected .json, .yaml or .yml`)))
+		})
+
+		It("returns an error when the template can't be written", func() {
+			mockTemplate(rawJSONTemplate)
+			path := filepath.Join(dir, "ng-1.json")
+			Expect(os.Mkdir(path, 0755)).To(Succeed())
+
+			err := sc.ExportNodeGroupTemplate(context.TODO(), "ng-1", path)
+			Expect(err).To(MatchError(ContainSubstring(`writing template of nodegroup "ng-1" to "` + path + `"`)))
+		})
+	})
 })
@@ -0,0 +1,168 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/smithy-go"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+)
+
+// maxTemplateBodySize is the size of the largest template CloudFormation accepts inline as TemplateBody;
+// larger templates are uploaded to S3 and passed as TemplateURL
+const maxTemplateBodySize = 51200
+
+// templateStore holds the bucket used to store templates too large to be passed inline;
+// mu guards the initialisation of the bucket only
+type templateStore struct {
+	mu            sync.Mutex
+	bucket        string
+	bucketReady   bool
+	defaultBucket bool
+}
+
+// SetTemplateBucket sets the S3 bucket templates too large to be passed inline are uploaded to; uploading
+// and deleting templates requires s3:PutObject and s3:DeleteObject on the bucket
+func (c *StackCollection) SetTemplateBucket(bucket string) {
+	c.templateStore.mu.Lock()
+	defer c.templateStore.mu.Unlock()
+
+	c.templateStore.bucket = bucket
+	c.templateStore.bucketReady = bucket != ""
+}
+
+// EnableDefaultTemplateBucket opts in to uploading templates too large to be passed inline to the bucket
+// eksctl manages for the account and region, eksctl-templates-<account>-<region>, when no bucket is set.
+// The bucket is created if needed, which in addition to s3:PutObject and s3:DeleteObject requires
+// s3:CreateBucket, s3:PutBucketPublicAccessBlock and s3:PutEncryptionConfiguration
+func (c *StackCollection) EnableDefaultTemplateBucket() {
+	c.templateStore.mu.Lock()
+	defer c.templateStore.mu.Unlock()
+
+	c.templateStore.defaultBucket = true
+}
+
+// resolveTemplateData returns templateData unchanged unless it is a template body too large to be passed inline,
+// in which case the template is uploaded to S3 and its URL is returned instead. The returned function deletes the
+// uploaded template, and must be called once CloudFormation has been passed its URL
+func (c *StackCollection) resolveTemplateData(ctx context.Context, stackName string, templateData TemplateData) (TemplateData, func(), error) {
+	body, ok := templateData.(TemplateBody)
+	if !ok || len(body) <= maxTemplateBodySize {
+		return templateData, func() {}, nil
+	}
+	logger.Debug("template of stack %q is %d bytes, uploading it to S3", stackName, len(body))
+	bucket, err := c.getTemplateBucket(ctx, stackName, len(body))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := fmt.Sprintf("%s/%d.json", stackName, time.Now().UnixNano())
+	if _, err := c.s3API.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(body),
+	}); err != nil {
+		return nil, nil, errors.Wrapf(err, "uploading template of stack %q to bucket %q", stackName, bucket)
+	}
+	deleteTemplate := func() {
+		if _, err := c.s3API.DeleteObject(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}); err != nil {
+			logger.Warning("failed to delete template %q from bucket %q: %v", key, bucket, err)
+		}
+	}
+
+	dnsSuffix := "amazonaws.com"
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.region); ok {
+		dnsSuffix = p.DNSSuffix()
+	}
+	return TemplateURL(fmt.Sprintf("https://%s.s3.%s.%s/%s", bucket, c.region, dnsSuffix, key)), deleteTemplate, nil
+}
+
+// getTemplateBucket returns the bucket templates are uploaded to, ensuring the eksctl-managed bucket exists
+// when no bucket was configured and it was opted in to
+func (c *StackCollection) getTemplateBucket(ctx context.Context, stackName string, templateSize int) (string, error) {
+	c.templateStore.mu.Lock()
+	defer c.templateStore.mu.Unlock()
+
+	if !c.templateStore.bucketReady {
+		if !c.templateStore.defaultBucket {
+			return "", fmt.Errorf("template of stack %q is %d bytes, larger than the %d bytes CloudFormation accepts inline; configure a bucket to upload templates to", stackName, templateSize, maxTemplateBodySize)
+		}
+		bucket, err := c.ensureDefaultTemplateBucket(ctx)
+		if err != nil {
+			return "", err
+		}
+		c.templateStore.bucket = bucket
+		c.templateStore.bucketReady = true
+	}
+	return c.templateStore.bucket, nil
+}
+
+// ensureDefaultTemplateBucket creates the eksctl-managed template bucket of the account and region
+// unless it already exists, blocks public access to it and enables its default encryption, and returns its name
+func (c *StackCollection) ensureDefaultTemplateBucket(ctx context.Context) (string, error) {
+	identity, err := c.stsAPI.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", errors.Wrap(err, "getting the account to store templates in")
+	}
+	bucket := fmt.Sprintf("eksctl-templates-%s-%s", aws.StringValue(identity.Account), c.region)
+
+	logger.Info("ensuring bucket %q exists to store CloudFormation templates larger than %d bytes", bucket, maxTemplateBodySize)
+	input := &s3.CreateBucketInput{Bucket: aws.String(bucket)}
+	if c.region != endpoints.UsEast1RegionID {
+		input.CreateBucketConfiguration = &s3types.CreateBucketConfiguration{
+			LocationConstraint: s3types.BucketLocationConstraint(c.region),
+		}
+	}
+	if _, err := c.s3API.CreateBucket(ctx, input); err != nil {
+		var apiErr smithy.APIError
+		if !errors.As(err, &apiErr) {
+			return "", errors.Wrapf(err, "creating template bucket %q", bucket)
+		}
+		switch apiErr.ErrorCode() {
+		case "BucketAlreadyOwnedByYou":
+		case "BucketAlreadyExists":
+			return "", fmt.Errorf("template bucket %q is owned by another account; configure a bucket to upload templates to instead", bucket)
+		default:
+			return "", errors.Wrapf(err, "creating template bucket %q", bucket)
+		}
+	} else {
+		logger.Info("created bucket %q to store CloudFormation templates", bucket)
+	}
+
+	if _, err := c.s3API.PutPublicAccessBlock(ctx, &s3.PutPublicAccessBlockInput{
+		Bucket: aws.String(bucket),
+		PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       true,
+			BlockPublicPolicy:     true,
+			IgnorePublicAcls:      true,
+			RestrictPublicBuckets: true,
+		},
+	}); err != nil {
+		return "", errors.Wrapf(err, "blocking public access to template bucket %q", bucket)
+	}
+	if _, err := c.s3API.PutBucketEncryption(ctx, &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+		ServerSideEncryptionConfiguration: &s3types.ServerSideEncryptionConfiguration{
+			Rules: []s3types.ServerSideEncryptionRule{{
+				ApplyServerSideEncryptionByDefault: &s3types.ServerSideEncryptionByDefault{
+					SSEAlgorithm: s3types.ServerSideEncryptionAes256,
+				},
+			}},
+		},
+	}); err != nil {
+		return "", errors.Wrapf(err, "enabling encryption of template bucket %q", bucket)
+	}
+	return bucket, nil
+}
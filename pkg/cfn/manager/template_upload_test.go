@@ -0,0 +1,148 @@
+package manager
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Template upload", func() {
+	var (
+		p         *mockprovider.MockProvider
+		sc        *StackCollection
+		objects   map[string]string
+		stackName = "eksctl-test-nodegroup-ng"
+		bucket    = "eksctl-templates-123456789012-" + api.DefaultRegion
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		p.MockCloudFormation().On("CreateStack", mock.Anything, mock.Anything).Return(&cloudformation.CreateStackOutput{StackId: aws.String("stack-id")}, nil)
+		p.MockSTS().On("GetCallerIdentity", mock.Anything, mock.Anything).Return(&sts.GetCallerIdentityOutput{Account: aws.String("123456789012")}, nil)
+		p.MockS3().On("PutPublicAccessBlock", mock.Anything, mock.Anything).Return(&s3.PutPublicAccessBlockOutput{}, nil)
+		p.MockS3().On("PutBucketEncryption", mock.Anything, mock.Anything).Return(&s3.PutBucketEncryptionOutput{}, nil)
+
+		objects = map[string]string{}
+		p.MockS3().On("PutObject", mock.Anything, mock.Anything).Return(func(_ context.Context, input *s3.PutObjectInput, _ ...func(*s3.Options)) *s3.PutObjectOutput {
+			body, err := ioutil.ReadAll(input.Body)
+			Expect(err).NotTo(HaveOccurred())
+			objects[*input.Bucket+"/"+*input.Key] = string(body)
+			return &s3.PutObjectOutput{}
+		}, nil)
+		p.MockS3().On("DeleteObject", mock.Anything, mock.Anything).Return(&s3.DeleteObjectOutput{}, nil)
+		sc = NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+	})
+
+	createStack := func(templateBody string) *cloudformation.CreateStackInput {
+		Expect(sc.DoCreateStackRequest(context.TODO(), &Stack{StackName: aws.String(stackName)}, TemplateBody(templateBody), nil, nil, false, false)).To(Succeed())
+		return p.MockCloudFormation().Calls[0].Arguments.Get(1).(*cloudformation.CreateStackInput)
+	}
+
+	It("passes small templates inline", func() {
+		input := createStack("{}")
+		Expect(*input.TemplateBody).To(Equal("{}"))
+		Expect(input.TemplateURL).To(BeNil())
+		p.MockS3().AssertNotCalled(GinkgoT(), "PutObject", mock.Anything, mock.Anything)
+	})
+
+	It("uploads large templates to the default bucket, passes their URL and deletes them afterwards", func() {
+		sc.EnableDefaultTemplateBucket()
+		p.MockS3().On("CreateBucket", mock.Anything, mock.Anything).Return(&s3.CreateBucketOutput{}, nil)
+		template := strings.Repeat(" ", maxTemplateBodySize) + "{}"
+		input := createStack(template)
+
+		Expect(input.TemplateBody).To(BeNil())
+		p.MockS3().AssertCalled(GinkgoT(), "CreateBucket", mock.Anything, mock.MatchedBy(func(input *s3.CreateBucketInput) bool {
+			return *input.Bucket == bucket
+		}))
+		p.MockS3().AssertCalled(GinkgoT(), "PutPublicAccessBlock", mock.Anything, mock.MatchedBy(func(input *s3.PutPublicAccessBlockInput) bool {
+			c := input.PublicAccessBlockConfiguration
+			return *input.Bucket == bucket && c.BlockPublicAcls && c.BlockPublicPolicy && c.IgnorePublicAcls && c.RestrictPublicBuckets
+		}))
+		p.MockS3().AssertCalled(GinkgoT(), "PutBucketEncryption", mock.Anything, mock.MatchedBy(func(input *s3.PutBucketEncryptionInput) bool {
+			return *input.Bucket == bucket
+		}))
+		Expect(objects).To(HaveLen(1))
+		for key, body := range objects {
+			Expect(key).To(HavePrefix(bucket + "/" + stackName + "/"))
+			Expect(body).To(Equal(template))
+			objectKey := strings.TrimPrefix(key, bucket+"/")
+			Expect(*input.TemplateURL).To(Equal("https://" + bucket + ".s3." + api.DefaultRegion + ".amazonaws.com/" + objectKey))
+			p.MockS3().AssertCalled(GinkgoT(), "DeleteObject", mock.Anything, &s3.DeleteObjectInput{Bucket: aws.String(bucket), Key: aws.String(objectKey)})
+		}
+	})
+
+	It("reuses the default bucket when it already exists", func() {
+		sc.EnableDefaultTemplateBucket()
+		p.MockS3().On("CreateBucket", mock.Anything, mock.Anything).Return(nil, &smithy.GenericAPIError{Code: "BucketAlreadyOwnedByYou"})
+		input := createStack(strings.Repeat(" ", maxTemplateBodySize+1))
+		Expect(*input.TemplateURL).To(HavePrefix("https://" + bucket + ".s3."))
+	})
+
+	It("fails when the default bucket name is taken by another account", func() {
+		sc.EnableDefaultTemplateBucket()
+		p.MockS3().On("CreateBucket", mock.Anything, mock.Anything).Return(nil, &smithy.GenericAPIError{Code: "BucketAlreadyExists"})
+		err := sc.DoCreateStackRequest(context.TODO(), &Stack{StackName: aws.String(stackName)}, TemplateBody(strings.Repeat(" ", maxTemplateBodySize+1)), nil, nil, false, false)
+		Expect(err).To(MatchError(ContainSubstring("configure a bucket to upload templates to instead")))
+		p.MockS3().AssertNotCalled(GinkgoT(), "PutObject", mock.Anything, mock.Anything)
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateStack", mock.Anything, mock.Anything)
+	})
+
+	It("fails to pass large templates when no bucket is configured and the default bucket is not enabled", func() {
+		err := sc.DoCreateStackRequest(context.TODO(), &Stack{StackName: aws.String(stackName)}, TemplateBody(strings.Repeat(" ", maxTemplateBodySize+1)), nil, nil, false, false)
+		Expect(err).To(MatchError(ContainSubstring("configure a bucket to upload templates to")))
+		p.MockS3().AssertNotCalled(GinkgoT(), "CreateBucket", mock.Anything, mock.Anything)
+		p.MockS3().AssertNotCalled(GinkgoT(), "PutObject", mock.Anything, mock.Anything)
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateStack", mock.Anything, mock.Anything)
+	})
+
+	It("uploads large templates to the configured bucket", func() {
+		sc.SetTemplateBucket("my-templates")
+		input := createStack(strings.Repeat(" ", maxTemplateBodySize+1))
+
+		Expect(*input.TemplateURL).To(HavePrefix("https://my-templates.s3." + api.DefaultRegion + ".amazonaws.com/" + stackName + "/"))
+		p.MockS3().AssertNotCalled(GinkgoT(), "CreateBucket", mock.Anything, mock.Anything)
+		p.MockSTS().AssertNotCalled(GinkgoT(), "GetCallerIdentity", mock.Anything, mock.Anything)
+	})
+
+	It("uploads a large template once when validating it before creating its stack", func() {
+		sc.SetTemplateBucket("my-templates")
+		p.MockCloudFormation().On("ValidateTemplate", mock.Anything, mock.Anything).Return(&cloudformation.ValidateTemplateOutput{}, nil)
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.DescribeStacksOutput{
+			Stacks: []cfntypes.Stack{{StackName: aws.String(stackName), StackStatus: cfntypes.StackStatusCreateComplete}},
+		}, nil)
+
+		errs := make(chan error)
+		resourceSet := &stubResourceSet{template: strings.Repeat(" ", maxTemplateBodySize+1)}
+		Expect(sc.createValidatedStack(context.TODO(), stackName, resourceSet, nil, nil, errs)).To(Succeed())
+		Expect(<-errs).NotTo(HaveOccurred())
+
+		p.MockS3().AssertNumberOfCalls(GinkgoT(), "PutObject", 1)
+		p.MockS3().AssertNumberOfCalls(GinkgoT(), "DeleteObject", 1)
+		var validateInput *cloudformation.ValidateTemplateInput
+		var createInput *cloudformation.CreateStackInput
+		for _, call := range p.MockCloudFormation().Calls {
+			switch input := call.Arguments.Get(1).(type) {
+			case *cloudformation.ValidateTemplateInput:
+				validateInput = input
+			case *cloudformation.CreateStackInput:
+				createInput = input
+			}
+		}
+		Expect(validateInput.TemplateURL).NotTo(BeNil())
+		Expect(createInput.TemplateURL).To(Equal(validateInput.TemplateURL))
+	})
+})
@@ -4,13 +4,19 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
 )
 
 func (c *StackCollection) troubleshootStackFailureCause(ctx context.Context, i *Stack, desiredStatus string) {
@@ -61,10 +67,19 @@ func (e *noChangeError) Error() string {
 // DoWaitUntilStackIsCreated blocks until the given stack's
 // creation has completed.
 func (c *StackCollection) DoWaitUntilStackIsCreated(ctx context.Context, i *Stack) error {
+	return c.doWaitUntilStackIsCreated(ctx, i, nil)
+}
+
+// doWaitUntilStackIsCreated blocks until the given stack's creation has completed,
+// reporting new stack events via streamer when it is non-nil
+func (c *StackCollection) doWaitUntilStackIsCreated(ctx context.Context, i *Stack, streamer *stackEventStreamer) error {
 	setCustomRetryer := func(o *cloudformation.StackCreateCompleteWaiterOptions) {
 		defaultRetryer := o.Retryable
 		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
 			logger.Info("waiting for CloudFormation stack %q", *i.StackName)
+			if streamer != nil {
+				c.streamStackEvents(ctx, streamer)
+			}
 			return defaultRetryer(ctx, in, out, err)
 		}
 	}
@@ -75,10 +90,10 @@ func (c *StackCollection) DoWaitUntilStackIsCreated(ctx context.Context, i *Stac
 	}, c.waitTimeout, setCustomRetryer)
 }
 
-func (c *StackCollection) waitUntilStackIsCreated(ctx context.Context, i *Stack, stack builder.ResourceSetReader, errs chan error) {
+func (c *StackCollection) waitUntilStackIsCreated(ctx context.Context, i *Stack, stack builder.ResourceSetReader, streamer *stackEventStreamer, errs chan error) {
 	defer close(errs)
 
-	if err := c.DoWaitUntilStackIsCreated(ctx, i); err != nil {
+	if err := c.doWaitUntilStackIsCreated(ctx, i, streamer); err != nil {
 		errs <- err
 		return
 	}
@@ -94,8 +109,13 @@ func (c *StackCollection) waitUntilStackIsCreated(ctx context.Context, i *Stack,
 	errs <- nil
 }
 
+// doWaitUntilStackIsDeleted blocks until the given stack has been deleted; the SDK waiter considers a stack that
+// no longer exists, e.g. because it was deleted concurrently, deleted
 func (c *StackCollection) doWaitUntilStackIsDeleted(ctx context.Context, i *Stack) error {
 	setCustomRetryer := func(o *cloudformation.StackDeleteCompleteWaiterOptions) {
+		if c.deleteWaitInterval > 0 {
+			o.MinDelay, o.MaxDelay = c.deleteWaitInterval, c.deleteWaitInterval
+		}
 		defaultRetryer := o.Retryable
 		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
 			logger.Info("waiting for CloudFormation stack %q", *i.StackName)
@@ -119,30 +139,59 @@ func (c *StackCollection) waitUntilStackIsDeleted(ctx context.Context, i *Stack,
 	errs <- nil
 }
 
-func (c *StackCollection) doWaitUntilStackIsUpdated(ctx context.Context, i *Stack) error {
+// doWaitUntilStackIsUpdated blocks until the given stack's update has completed,
+// reporting new stack events via streamer when it is non-nil
+func (c *StackCollection) doWaitUntilStackIsUpdated(ctx context.Context, i *Stack, streamer *stackEventStreamer, interval, timeout time.Duration) error {
 	setCustomRetryer := func(o *cloudformation.StackUpdateCompleteWaiterOptions) {
+		if interval > 0 {
+			o.MinDelay, o.MaxDelay = interval, interval
+		}
 		defaultRetryer := o.Retryable
 		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
 			logger.Info("waiting for CloudFormation stack %q", *i.StackName)
+			if streamer != nil {
+				c.streamStackEvents(ctx, streamer)
+			}
 			return defaultRetryer(ctx, in, out, err)
 		}
 	}
 
 	waiter := cloudformation.NewStackUpdateCompleteWaiter(c.cloudformationAPI)
+	return waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
+		StackName: i.StackName,
+	}, c.waitTimeoutOrDefault(timeout), setCustomRetryer)
+}
+
+func (c *StackCollection) doWaitUntilStackIsRolledBack(ctx context.Context, i *Stack) error {
+	setCustomRetryer := func(o *cloudformation.StackRollbackCompleteWaiterOptions) {
+		defaultRetryer := o.Retryable
+		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
+			logger.Info("waiting for CloudFormation stack %q to roll back", *i.StackName)
+			return defaultRetryer(ctx, in, out, err)
+		}
+	}
+
+	waiter := cloudformation.NewStackRollbackCompleteWaiter(c.cloudformationAPI)
 	return waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
 		StackName: i.StackName,
 	}, c.waitTimeout, setCustomRetryer)
 }
 
-func (c *StackCollection) doWaitUntilChangeSetIsCreated(ctx context.Context, i *Stack, changesetName string) error {
+func (c *StackCollection) doWaitUntilChangeSetIsCreated(ctx context.Context, i *Stack, changesetName string, interval, timeout time.Duration) error {
 	setCustomRetryer := func(o *cloudformation.ChangeSetCreateCompleteWaiterOptions) {
+		if interval > 0 {
+			o.MinDelay, o.MaxDelay = interval, interval
+		}
 		defaultRetryer := o.Retryable
 		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeChangeSetInput, out *cloudformation.DescribeChangeSetOutput, err error) (bool, error) {
 			logger.Info("waiting for CloudFormation changeset %q for stack %q", changesetName, *i.StackName)
-			if out.StatusReason != nil && strings.Contains(*out.StatusReason, "The submitted information didn't contain changes") {
+			if out != nil && out.StatusReason != nil && strings.Contains(*out.StatusReason, "The submitted information didn't contain changes") {
 				logger.Info("nothing to update")
 				return false, &noChangeError{*out.StatusReason}
 			}
+			if out != nil && out.Status == types.ChangeSetStatusFailed {
+				return false, fmt.Errorf("creating CloudFormation changeset %q for stack %q failed: %s", changesetName, *i.StackName, aws.StringValue(out.StatusReason))
+			}
 			return defaultRetryer(ctx, in, out, err)
 		}
 	}
@@ -151,5 +200,145 @@ func (c *StackCollection) doWaitUntilChangeSetIsCreated(ctx context.Context, i *
 	return waiter.Wait(ctx, &cloudformation.DescribeChangeSetInput{
 		StackName:     i.StackName,
 		ChangeSetName: &changesetName,
-	}, c.waitTimeout)
+	}, c.waitTimeoutOrDefault(timeout))
+}
+
+// waitTimeoutOrDefault returns timeout, or the StackCollection's wait timeout when it is zero
+func (c *StackCollection) waitTimeoutOrDefault(timeout time.Duration) time.Duration {
+	if timeout > 0 {
+		return timeout
+	}
+	return c.waitTimeout
+}
+
+// NodeGroupStackResult holds the outcome of waiting for a nodegroup stack
+type NodeGroupStackResult struct {
+	NodeGroupName string
+	Stack         *Stack
+	Err           error
+}
+
+// WaitForNodeGroupStacks concurrently waits for the stacks of the given nodegroups to be created or updated,
+// and returns the outcome for each nodegroup in the order they were given; the returned error describes
+// all nodegroups that failed. Nodegroups are given by name, or by the id of their stack as returned by
+// CreateStackNoWait
+func (c *StackCollection) WaitForNodeGroupStacks(ctx context.Context, names []string, timeout time.Duration) ([]NodeGroupStackResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	results := make([]NodeGroupStackResult, len(names))
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			stackID := c.makeNodeGroupStackName(name)
+			stackName := stackID
+			if stackARN, err := arn.Parse(name); err == nil {
+				stackID = name
+				if parts := strings.Split(stackARN.Resource, "/"); len(parts) > 1 {
+					stackName = parts[1]
+				}
+			}
+			stack, err := waiter.WaitForStack(ctx, c.cloudformationAPI, stackID, stackName, func(attempts int) time.Duration {
+				if attempts == 1 {
+					return 0
+				}
+				return c.nodeGroupStacksPollInterval
+			})
+			if err != nil {
+				err = errors.Wrapf(err, "waiting for nodegroup %q", name)
+			}
+			if stackID == name && stack != nil {
+				if ngName := c.GetNodeGroupName(stack); ngName != "" {
+					name = ngName
+				}
+			}
+			results[i] = NodeGroupStackResult{
+				NodeGroupName: name,
+				Stack:         stack,
+				Err:           err,
+			}
+		}(i, name)
+	}
+	wg.Wait()
+
+	errs := make([]error, len(results))
+	for i, r := range results {
+		errs[i] = r.Err
+	}
+	return results, combineErrors(errs)
+}
+
+// stackEventStreamer reports the events of a stack that occurred after a point in time,
+// skipping events that have already been reported
+type stackEventStreamer struct {
+	stack   *Stack
+	since   time.Time
+	seen    map[string]bool
+	handler func(StackEvent)
+}
+
+func newStackEventStreamer(stack *Stack, since time.Time, handler func(StackEvent)) *stackEventStreamer {
+	return &stackEventStreamer{
+		stack:   stack,
+		since:   since,
+		seen:    make(map[string]bool),
+		handler: handler,
+	}
+}
+
+// streamStackEvents passes any new events of the stack to the streamer's handler, oldest first
+func (c *StackCollection) streamStackEvents(ctx context.Context, s *stackEventStreamer) {
+	stackName := *s.stack.StackName
+	if api.IsSetAndNonEmptyString(s.stack.StackId) {
+		stackName = *s.stack.StackId
+	}
+	err := c.iterateStackEvents(ctx, stackName, s.since, func(e StackEvent) {
+		if e.EventId == nil || s.seen[*e.EventId] {
+			return
+		}
+		s.seen[*e.EventId] = true
+		s.handler(e)
+	})
+	if err != nil {
+		logger.Debug("unable to stream events: %v", err)
+	}
+}
+
+// iterateStackEvents calls fn for each event of the stack that did not occur before since, oldest first.
+// As CloudFormation returns events newest first, all pages of events back to since are fetched before fn is called
+func (c *StackCollection) iterateStackEvents(ctx context.Context, stackName string, since time.Time, fn func(StackEvent)) error {
+	paginator := cloudformation.NewDescribeStackEventsPaginator(c.cloudformationAPI, &cloudformation.DescribeStackEventsInput{
+		StackName: aws.String(stackName),
+	})
+
+	var events []StackEvent
+	done := false
+	for !done && paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "describing CloudFormation stack %q events", stackName)
+		}
+		for _, e := range out.StackEvents {
+			if e.Timestamp != nil && e.Timestamp.Before(since) {
+				done = true
+				break
+			}
+			events = append(events, e)
+		}
+	}
+
+	for i := len(events) - 1; i >= 0; i-- {
+		fn(events[i])
+	}
+	return nil
+}
+
+func logStackEvent(e types.StackEvent) {
+	msg := fmt.Sprintf("%s/%s: %s", aws.StringValue(e.ResourceType), aws.StringValue(e.LogicalResourceId), e.ResourceStatus)
+	if e.ResourceStatusReason != nil {
+		msg = fmt.Sprintf("%s – %s", msg, *e.ResourceStatusReason)
+	}
+	logger.Info(msg)
 }
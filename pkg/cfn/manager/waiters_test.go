@@ -0,0 +1,172 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection Waiters", func() {
+	Context("streamStackEvents", func() {
+		var (
+			p        *mockprovider.MockProvider
+			sc       *StackCollection
+			start    time.Time
+			newEvent func(id string, offset time.Duration) types.StackEvent
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+			start = time.Now()
+			newEvent = func(id string, offset time.Duration) types.StackEvent {
+				return types.StackEvent{
+					EventId:           aws.String(id),
+					LogicalResourceId: aws.String(id),
+					ResourceType:      aws.String("AWS::EC2::LaunchTemplate"),
+					ResourceStatus:    types.ResourceStatusUpdateComplete,
+					Timestamp:         aws.Time(start.Add(offset)),
+				}
+			}
+		})
+
+		It("reports new events once, oldest first, ignoring events before the start time", func() {
+			p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, mock.Anything).Return(&cfn.DescribeStackEventsOutput{
+				StackEvents: []types.StackEvent{newEvent("2", 2*time.Second), newEvent("1", time.Second), newEvent("0", -time.Minute)},
+			}, nil).Once()
+			p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, mock.Anything).Return(&cfn.DescribeStackEventsOutput{
+				StackEvents: []types.StackEvent{newEvent("3", 3*time.Second), newEvent("2", 2*time.Second), newEvent("1", time.Second), newEvent("0", -time.Minute)},
+			}, nil).Once()
+
+			var reported []string
+			streamer := newStackEventStreamer(&Stack{StackName: aws.String("stack")}, start, func(e types.StackEvent) {
+				reported = append(reported, *e.EventId)
+			})
+			sc.streamStackEvents(context.TODO(), streamer)
+			Expect(reported).To(Equal([]string{"1", "2"}))
+			sc.streamStackEvents(context.TODO(), streamer)
+			Expect(reported).To(Equal([]string{"1", "2", "3"}))
+		})
+
+		It("iterates over events across pages, oldest first, stopping at the start time", func() {
+			p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, &cfn.DescribeStackEventsInput{
+				StackName: aws.String("stack"),
+			}, mock.Anything).Return(&cfn.DescribeStackEventsOutput{
+				StackEvents: []types.StackEvent{newEvent("4", 4*time.Second), newEvent("3", 3*time.Second)},
+				NextToken:   aws.String("page-2"),
+			}, nil)
+			p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, &cfn.DescribeStackEventsInput{
+				StackName: aws.String("stack"),
+				NextToken: aws.String("page-2"),
+			}, mock.Anything).Return(&cfn.DescribeStackEventsOutput{
+				StackEvents: []types.StackEvent{newEvent("2", 2*time.Second), newEvent("1", time.Second), newEvent("0", -time.Minute)},
+				NextToken:   aws.String("page-3"),
+			}, nil)
+
+			var iterated []string
+			Expect(sc.iterateStackEvents(context.TODO(), "stack", start, func(e types.StackEvent) {
+				iterated = append(iterated, *e.EventId)
+			})).To(Succeed())
+			Expect(iterated).To(Equal([]string{"1", "2", "3", "4"}))
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStackEvents", 2)
+		})
+	})
+
+	Context("WaitForNodeGroupStacks", func() {
+		It("reports the outcome of each nodegroup", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			for ngName, status := range map[string]types.StackStatus{
+				"ng-1": types.StackStatusCreateComplete,
+				"ng-2": types.StackStatusRollbackComplete,
+			} {
+				stackName := aws.String("eksctl-test-cluster-nodegroup-" + ngName)
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: stackName}).Return(&cfn.DescribeStacksOutput{
+					Stacks: []types.Stack{{StackName: stackName, StackStatus: status}},
+				}, nil)
+			}
+
+			sm := NewStackCollection(p, cfg)
+			results, err := sm.WaitForNodeGroupStacks(context.TODO(), []string{"ng-1", "ng-2"}, time.Minute)
+			Expect(err).To(MatchError(ContainSubstring(`waiting for nodegroup "ng-2"`)))
+			Expect(results).To(HaveLen(2))
+			Expect(results[0].NodeGroupName).To(Equal("ng-1"))
+			Expect(results[0].Err).NotTo(HaveOccurred())
+			Expect(*results[0].Stack.StackName).To(Equal("eksctl-test-cluster-nodegroup-ng-1"))
+			Expect(results[1].NodeGroupName).To(Equal("ng-2"))
+			Expect(results[1].Err).To(HaveOccurred())
+		})
+	})
+
+	Context("WaitForNodeGroupStacks with stacks in progress", func() {
+		It("polls the stacks at the configured interval until they complete", func() {
+			p := mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			stackName := aws.String("eksctl-test-cluster-nodegroup-ng-1")
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: stackName, StackStatus: types.StackStatusCreateInProgress}},
+			}, nil).Twice()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: stackName, StackStatus: types.StackStatusCreateComplete}},
+			}, nil)
+
+			sc := NewStackCollection(p, cfg).(*StackCollection)
+			sc.nodeGroupStacksPollInterval = time.Millisecond
+			results, err := sc.WaitForNodeGroupStacks(context.TODO(), []string{"ng-1"}, 5*time.Second)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Stack.StackStatus).To(Equal(types.StackStatusCreateComplete))
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 3)
+		})
+	})
+
+	Context("WaitForNodeGroupStacks with stack ids", func() {
+		It("waits for the stack with the given id", func() {
+			p := mockprovider.NewMockProvider()
+			stackID := "arn:aws:cloudformation:us-west-2:123456789012:stack/eksctl-test-cluster-nodegroup-ng-1/1"
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cfn.DescribeStacksInput{StackName: aws.String(stackID)}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{
+					StackName:   aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+					StackStatus: types.StackStatusCreateComplete,
+					Tags:        []types.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")}},
+				}},
+			}, nil)
+
+			results, err := NewStackCollection(p, api.NewClusterConfig()).WaitForNodeGroupStacks(context.TODO(), []string{stackID}, time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].NodeGroupName).To(Equal("ng-1"))
+			Expect(*results[0].Stack.StackName).To(Equal("eksctl-test-cluster-nodegroup-ng-1"))
+		})
+	})
+
+	Context("doWaitUntilStackIsDeleted", func() {
+		It("treats a stack that no longer exists as deleted, as the SDK waiter does", func() {
+			p := mockprovider.NewMockProvider()
+			stackName := aws.String("eksctl-test-cluster-nodegroup-ng")
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: stackName, StackStatus: types.StackStatusDeleteInProgress}},
+			}, nil).Once()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(nil, &smithy.OperationError{
+				Err: &smithy.GenericAPIError{Code: "ValidationError", Message: "Stack with id eksctl-test-cluster-nodegroup-ng does not exist"},
+			})
+
+			sc := NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+			sc.deleteWaitInterval = time.Millisecond
+			Expect(sc.doWaitUntilStackIsDeleted(context.TODO(), &Stack{StackName: stackName})).To(Succeed())
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 2)
+		})
+	})
+})
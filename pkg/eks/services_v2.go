@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 
@@ -34,6 +35,7 @@ type ServicesV2 struct {
 	ssm                    *ssm.Client
 	iam                    *iam.Client
 	ec2                    *ec2.Client
+	s3                     *s3.Client
 }
 
 // STS implements the AWS STS service.
@@ -135,3 +137,13 @@ func (s *ServicesV2) EC2() awsapi.EC2 {
 	}
 	return s.ec2
 }
+
+// S3 implements the AWS S3 service.
+func (s *ServicesV2) S3() awsapi.S3 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.s3 == nil {
+		s.s3 = s3.NewFromConfig(s.config)
+	}
+	return s.s3
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -184,6 +185,7 @@ func makeKeyValues(kv map[string]string, separator string) string {
 	for k, v := range kv {
 		params = append(params, fmt.Sprintf("%s=%s", k, v))
 	}
+	sort.Strings(params)
 
 	return strings.Join(params, separator)
 }
@@ -59,6 +59,7 @@ type MockProvider struct {
 	ssm              *mocksv2.SSM
 	iam              *mocksv2.IAM
 	ec2              *mocksv2.EC2
+	s3               *mocksv2.S3
 }
 
 // NewMockProvider returns a new MockProvider
@@ -80,6 +81,7 @@ func NewMockProvider() *MockProvider {
 		ssm:          &mocksv2.SSM{},
 		iam:          &mocksv2.IAM{},
 		ec2:          &mocksv2.EC2{},
+		s3:           &mocksv2.S3{},
 	}
 }
 
@@ -168,6 +170,12 @@ func (m MockProvider) IAM() awsapi.IAM { return m.iam }
 // MockIAM returns a mocked IAM API
 func (m MockProvider) MockIAM() *mocksv2.IAM { return m.iam }
 
+// S3 returns a representation of the S3 API
+func (m MockProvider) S3() awsapi.S3 { return m.s3 }
+
+// MockS3 returns a mocked S3 API
+func (m MockProvider) MockS3() *mocksv2.S3 { return m.s3 }
+
 // CloudTrail returns a representation of the CloudTrail API
 func (m MockProvider) CloudTrail() awsapi.CloudTrail { return m.cloudtrail }
 